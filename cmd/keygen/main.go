@@ -0,0 +1,52 @@
+package main
+
+import (
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"os"
+
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	logger "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/logger"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	location             = "cmd.keygen."
+	privateKeyFile       = "private_key.pem"
+	publicKeyFile        = "public_key.pem"
+	privateKeyBase64File = "private_key_base64.txt"
+	publicKeyBase64File  = "public_key_base64.txt"
+	keyFilePermission    = 0600
+	algorithmUsage       = "signing algorithm of the keys: %s, %s or %s"
+	keySizeUsage         = "size of the RSA keys in bits"
+)
+
+// The key generator writes the same files as the openssl commands it replaced in the Makefile,
+// the base64 files hold the values of the Private_Key and Public_Key settings of a token type.
+func main() {
+	algorithm := flag.String("algorithm", constants.DefaultSigningAlgorithm, fmt.Sprintf(algorithmUsage, constants.RS256, constants.ES256, constants.EdDSA))
+	keySize := flag.Int("bits", constants.DefaultRSAKeySize, keySizeUsage)
+	flag.Parse()
+
+	logger := logger.NewZerolog()
+	keyPair := utility.GenerateKeyPair(logger, location+"main", *algorithm, *keySize)
+	if validator.IsError(keyPair.Error) {
+		logger.Panic(keyPair.Error)
+	}
+
+	writeKeyFile(logger, privateKeyFile, keyPair.Data.PrivateKey)
+	writeKeyFile(logger, publicKeyFile, keyPair.Data.PublicKey)
+	writeKeyFile(logger, privateKeyBase64File, []byte(base64.StdEncoding.EncodeToString(keyPair.Data.PrivateKey)+"\n"))
+	writeKeyFile(logger, publicKeyBase64File, []byte(base64.StdEncoding.EncodeToString(keyPair.Data.PublicKey)+"\n"))
+}
+
+func writeKeyFile(logger interfaces.Logger, name string, data []byte) {
+	writeFileError := os.WriteFile(name, data, keyFilePermission)
+	if validator.IsError(writeFileError) {
+		logger.Panic(domain.NewInternalError(location+"writeKeyFile.WriteFile", writeFileError.Error()))
+	}
+}
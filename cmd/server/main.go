@@ -24,6 +24,9 @@ func main() {
 		container.Delivery.LaunchServer(ctx, container.Repository)
 	}()
 
+	// Start the background jobs, like publishing the scheduled posts.
+	container.Scheduler.Start(ctx)
+
 	// Set up a channel to listen for OS signals (e.g., SIGINT, SIGTERM).
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -32,5 +35,6 @@ func main() {
 	<-quit
 
 	// Perform a graceful shutdown when a signal is received.
-	model.GracefulShutdown(ctx, container.Logger, container.Repository, container.Delivery)
+	// The scheduler is stopped first, so no background job is left running against a closed database.
+	model.GracefulShutdown(ctx, container.Logger, container.Scheduler, container.Repository, container.Delivery)
 }
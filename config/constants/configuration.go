@@ -40,15 +40,60 @@ const (
 
 // Token-related constants.
 const (
-	AccessTokenValue                            = "access_token"                          // Access token value.
-	RefreshTokenValue                           = "refresh_token"                         // Refresh token value.
-	LoggedInValue                               = "logged_in"                             // Logged in status value.
-	LogoutMaxAgeValue                           = -1                                      // Logout max age value.
-	User                             contextKey = "user"                                  // User context key.
-	ID                               contextKey = "id"                                    // ID context key.
-	UserRole                         contextKey = "userRole"                              // User role context key.
-	IDContextMissing                            = "ID context value is missing or empty." // ID context missing error message.
-	PasswordResetTokenExpirationTime            = time.Hour * 24                          // PasswordResetTokenExpirationTime represents the duration after which a password reset token expires.
+	AccessTokenValue                               = "access_token"                          // Access token value.
+	RefreshTokenValue                              = "refresh_token"                         // Refresh token value.
+	LoggedInValue                                  = "logged_in"                             // Logged in status value.
+	LogoutMaxAgeValue                              = -1                                      // Logout max age value.
+	User                                contextKey = "user"                                  // User context key.
+	ID                                  contextKey = "id"                                    // ID context key.
+	UserRole                            contextKey = "userRole"                              // User role context key.
+	TokenID                             contextKey = "tokenID"                               // Token ID (jti) context key.
+	SessionID                           contextKey = "sessionID"                             // Session ID context key.
+	Scopes                              contextKey = "scopes"                                // Scopes of a personal access token context key.
+	PersonalAccessTokenPrefix                      = "pat_"                                  // Prefix telling personal access tokens apart from JWTs.
+	IDContextMissing                               = "ID context value is missing or empty." // ID context missing error message.
+	PasswordResetTokenExpirationTime               = time.Hour * 24                          // PasswordResetTokenExpirationTime represents the duration after which a password reset token expires.
+	EmailVerificationCodeExpirationTime            = time.Hour * 24                          // EmailVerificationCodeExpirationTime represents the duration after which an email verification code expires.
+	EmailChangeTokenExpirationTime                 = time.Hour * 24                          // EmailChangeTokenExpirationTime represents the duration after which a pending email change expires.
+	MagicLinkTokenExpirationTime                   = time.Minute * 15                        // MagicLinkTokenExpirationTime represents the duration after which a magic login link expires.
+	MFATokenExpirationTime                         = time.Minute * 5                         // MFATokenExpirationTime represents the duration the second login step has to be completed in.
+	InvitationExpirationTime                       = time.Hour * 24 * 7                      // InvitationExpirationTime represents the duration after which an invitation without an explicit expiry expires.
+	TwoFactorIssuer                                = "golang-mongo-grpc"                     // Issuer shown by authenticator apps.
+)
+
+// Registration modes.
+const (
+	OpenRegistration             = "Open"             // Anyone can register.
+	InviteOnlyRegistration       = "InviteOnly"       // Registration requires an invitation code issued by an administrator.
+	DomainRestrictedRegistration = "DomainRestricted" // Registration is limited to the allowed email domains.
+)
+
+// JWT signing algorithms.
+const (
+	RS256                   = "RS256" // RSA PKCS #1 v1.5 signature with SHA-256.
+	ES256                   = "ES256" // ECDSA signature on the P-256 curve with SHA-256.
+	EdDSA                   = "EdDSA" // Ed25519 signature.
+	DefaultSigningAlgorithm = RS256   // Algorithm used when a token type does not configure one.
+	DefaultRSAKeySize       = 2048    // Size in bits of the generated RSA keys.
+)
+
+// User roles.
+const (
+	UserRoleValue      = "user"      // Default role assigned on registration.
+	ModeratorRoleValue = "moderator" // Role for moderating content and users.
+	AdminRoleValue     = "admin"     // Role with full access.
+)
+
+// Permissions granted to roles, in the "resource:action[:scope]" format.
+const (
+	PostCreatePermission    = "post:create"     // Create posts.
+	PostUpdateOwnPermission = "post:update:own" // Update own posts.
+	PostUpdateAnyPermission = "post:update:any" // Update posts of any user.
+	PostDeleteOwnPermission = "post:delete:own" // Delete own posts.
+	PostDeleteAnyPermission = "post:delete:any" // Delete posts of any user.
+	UserReadEmailPermission = "user:read:email" // Read email addresses of other users.
+	UserSuspendPermission   = "user:suspend"    // Suspend and unsuspend users.
+	UserManagePermission    = "user:manage"     // Manage users (roles, verification, deletion).
 )
 
 // Common routes used in the application.
@@ -60,35 +105,98 @@ const (
 
 // Domain-specific routes.
 const (
-	HealthGroupPath = "/health" // Health domain route.
-	UsersGroupPath  = "/users"  // Users domain route.
-	PostsGroupPath  = "/posts"  // Posts domain route.
+	HealthGroupPath = "/health"                // Health domain route.
+	UsersGroupPath  = "/users"                 // Users domain route.
+	PostsGroupPath  = "/posts"                 // Posts domain route.
+	JWKSPath        = "/.well-known/jwks.json" // Public keys that verify the access tokens (RFC 7517).
 	// Initialize other routes here.
 )
 
+// Post route paths.
+const (
+	GetPostBySlugPath = "/by-slug/:slug" // Permalink route path with the post slug.
+	SlugParam         = "slug"           // Parameter name for the post slug.
+	PublishPostPath   = "/:id/publish"   // Publish or schedule post route path.
+	UnpublishPostPath = "/:id/unpublish" // Move post back to drafts route path.
+	ArchivePostPath   = "/:id/archive"   // Archive post route path.
+)
+
+// Post statuses.
+const (
+	PostStatusDraft     = "draft"     // Post visible only to its author.
+	PostStatusScheduled = "scheduled" // Post published by the scheduler once its publish time has come.
+	PostStatusPublished = "published" // Post visible to everyone.
+	PostStatusArchived  = "archived"  // Post taken out of the listings, visible only to its author.
+)
+
 // Email subjects and URLs.
 const (
-	EmailConfirmationUrl = "users/verifyemail/"    // Email confirmation URL.
-	ForgottenPasswordUrl = "users/reset-password/" // Forgotten password URL.
+	EmailConfirmationUrl       = "users/verifyemail/"       // Email confirmation URL.
+	ForgottenPasswordUrl       = "users/reset-password/"    // Forgotten password URL.
+	AccountUnlockUrl           = "users/unlock/"            // Account unlock URL.
+	PasswordChangedUrl         = "users/forgotten-password" // Password changed URL, pointing to the password reset in case the change was not made by the user.
+	EmailChangeConfirmationUrl = "users/email/confirm/"     // Email change confirmation URL.
+	EmailChangeCancelUrl       = "users/email/cancel/"      // Email change cancellation URL.
+	MagicLinkUrl               = "users/magic-link/"        // Magic login link URL.
 )
 
 // User route paths.
 const (
-	RegisterPath          = "/register"           // Registration route path.
-	ForgottenPasswordPath = "/forgotten-password" // Forgotten password route path.
-	ResetPasswordPath     = "/reset-password/:id" // Reset password route path with token.
-	LoginPath             = "/login"              // Login route path.
-	GetCurrentUserPath    = "/current_user"       // Get current user route path.
-	UpdateCurrentUserPath = "/update"             // Update current user route path.
-	DeleteCurrentUserPath = "/delete"             // Delete current user route path.
-	RefreshTokenPath      = "/refresh"            // Refresh token route path.
-	LogoutPath            = "/logout"             // Logout route path.
+	RegisterPath               = "/register"                 // Registration route path.
+	ForgottenPasswordPath      = "/forgotten-password"       // Forgotten password route path.
+	ResetPasswordPath          = "/reset-password/:id"       // Reset password route path with token.
+	VerifyEmailPath            = "/verifyemail/:id"          // Email verification route path with verification code.
+	ResendVerificationCodePath = "/resend-verification-code" // Resend email verification code route path.
+	UnlockAccountPath          = "/unlock/:id"               // Account unlock route path with unlock token.
+	LoginPath                  = "/login"                    // Login route path.
+	TwoFactorLoginPath         = "/login/2fa"                // Second login step route path for users with two-factor authentication.
+	MagicLinkPath              = "/login/magic-link"         // Magic login link request route path.
+	MagicLinkLoginPath         = "/login/magic-link/:id"     // Magic login link route path with login token.
+	TwoFactorEnrollPath        = "/2fa/enroll"               // Two-factor authentication enrollment route path.
+	TwoFactorConfirmPath       = "/2fa/confirm"              // Two-factor authentication enrollment confirmation route path.
+	TwoFactorDisablePath       = "/2fa/disable"              // Two-factor authentication disabling route path.
+	GetCurrentUserPath         = "/current_user"             // Get current user route path.
+	UpdateCurrentUserPath      = "/update"                   // Update current user route path.
+	UpdatePasswordPath         = "/password"                 // Change password of the current user route path.
+	UpdateEmailPath            = "/email"                    // Change email of the current user route path.
+	ConfirmEmailChangePath     = "/email/confirm/:id"        // Email change confirmation route path with confirmation token.
+	CancelEmailChangePath      = "/email/cancel/:id"         // Email change cancellation route path with cancellation token.
+	DeleteCurrentUserPath      = "/delete"                   // Delete current user route path.
+	RefreshTokenPath           = "/refresh"                  // Refresh token route path.
+	LogoutPath                 = "/logout"                   // Logout route path.
+	SessionsPath               = "/sessions"                 // Sessions route path.
+	SessionPath                = "/sessions/:id"             // Single session route path with session ID.
+	PersonalAccessTokensPath   = "/tokens"                   // Personal access tokens route path.
+	PersonalAccessTokenPath    = "/tokens/:id"               // Single personal access token route path with token ID.
+	OAuthLoginPath             = "/oauth/:provider"          // Social login route path, redirecting to the provider.
+	OAuthCallbackPath          = "/oauth/:provider/callback" // Social login route path the provider redirects back to.
+	ProviderParam              = "provider"                  // Parameter name for the OAuth provider.
+	GetUserByHandlePath        = "/by-handle/:handle"        // Public profile route path with the user handle.
+	HandleParam                = "handle"                    // Parameter name for the user handle.
+)
+
+// Admin route paths.
+const (
+	AdminGroupPath         = "/admin"                    // Admin domain route.
+	UserRolePath           = "/:id/role"                 // Change user role route path.
+	VerifyUserPath         = "/:id/verify"               // Verify user route path.
+	UnverifyUserPath       = "/:id/unverify"             // Unverify user route path.
+	SuspendUserPath        = "/:id/suspend"              // Suspend user route path.
+	UnsuspendUserPath      = "/:id/unsuspend"            // Unsuspend user route path.
+	ForcePasswordResetPath = "/:id/force-password-reset" // Force password reset route path.
+	InvitationsGroupPath   = "/invitations"              // Invitations route.
 )
 
 // Database table names.
 const (
-	UsersTable = "users" // Users table name in the database.
-	PostsTable = "posts" // Posts table name in the database.
+	UsersTable                = "users"                  // Users table name in the database.
+	PostsTable                = "posts"                  // Posts table name in the database.
+	RefreshTokensTable        = "refresh_tokens"         // Refresh tokens table name in the database.
+	LoginAttemptsTable        = "login_attempts"         // Failed login attempts table name in the database.
+	PersonalAccessTokensTable = "personal_access_tokens" // Personal access tokens table name in the database.
+	OAuthStatesTable          = "oauth_states"           // Pending social login authorizations table name in the database.
+	UserIdentitiesTable       = "user_identities"        // External identities linked to users table name in the database.
+	InvitationsTable          = "invitations"            // Registration invitations table name in the database.
 )
 
 // Schemes used in the application.
@@ -99,10 +207,11 @@ const (
 
 // HTTP Headers used in the application.
 const (
-	RequestIDHeader = "X-Request-ID"  // Request-ID header for tracking requests across systems.
-	ContentType     = "Content-Type"  // Content-Type header.
-	Authorization   = "Authorization" // Authorization header.
-	Bearer          = "Bearer "       // Bearer token prefix.
+	RequestIDHeader  = "X-Request-ID"  // Request-ID header for tracking requests across systems.
+	ContentType      = "Content-Type"  // Content-Type header.
+	Authorization    = "Authorization" // Authorization header.
+	Bearer           = "Bearer "       // Bearer token prefix.
+	RetryAfterHeader = "Retry-After"   // Retry-After header for throttled requests.
 )
 
 // Operation status messages.
@@ -45,3 +45,16 @@ const (
 const (
 	Gin = "Gin" // Gin delivery name.
 )
+
+// OAuth providers used in the application.
+const (
+	GitHub = "GitHub" // GitHub OAuth 2.0 provider.
+	Google = "Google" // Google OpenID Connect provider.
+	OIDC   = "OIDC"   // Generic OpenID Connect provider with discovery.
+)
+
+// Password hashing algorithms used in the application.
+const (
+	Argon2id = "Argon2id" // Argon2id password hashing (RFC 9106).
+	Bcrypt   = "Bcrypt"   // Bcrypt password hashing.
+)
@@ -2,13 +2,16 @@ package constants
 
 // Unsupported Types.
 const (
-	UnsupportedConfig     = "Unsupported config type: %s"     // Unsupported config type error message.
-	UnsupportedLogger     = "Unsupported logger type: %s"     // Unsupported logger type error message.
-	UnsupportedEmail      = "Unsupported email type: %s"      // Unsupported email type error message.
-	UnsupportedRepository = "Unsupported repository type: %s" // Unsupported repository type error message.
-	UnsupportedUsecase    = "Unsupported use case type: %s"   // Unsupported use case type error message.
-	UnsupportedDelivery   = "Unsupported delivery type: %s"   // Unsupported delivery type error message.
-	UnsupportedController = "Unsupported controller type: %s" // Unsupported controller type error message.
+	UnsupportedConfig       = "Unsupported config type: %s"       // Unsupported config type error message.
+	UnsupportedLogger       = "Unsupported logger type: %s"       // Unsupported logger type error message.
+	UnsupportedEmail        = "Unsupported email type: %s"        // Unsupported email type error message.
+	UnsupportedRepository   = "Unsupported repository type: %s"   // Unsupported repository type error message.
+	UnsupportedUsecase      = "Unsupported use case type: %s"     // Unsupported use case type error message.
+	UnsupportedDelivery     = "Unsupported delivery type: %s"     // Unsupported delivery type error message.
+	UnsupportedController   = "Unsupported controller type: %s"   // Unsupported controller type error message.
+	UnsupportedOAuth        = "Unsupported OAuth type: %s"        // Unsupported OAuth provider type error message.
+	UnsupportedHasher       = "Unsupported hasher type: %s"       // Unsupported password hashing algorithm error message.
+	UnsupportedRegistration = "Unsupported registration mode: %s" // Unsupported registration mode error message.
 )
 
 // Server Notifications.
@@ -22,32 +25,56 @@ const (
 
 // User Notifications.
 const (
-	LogoutNotificationMessage                = "You are successfully logged out."                                               // Logout success message.
-	SendingEmailNotification                 = "We have sent an email with a verification code to the provided address: %s."    // Email sent notification.
-	SendingEmailWithInstructionsNotification = "You will receive an email with detailed instructions shortly."                  // Email with instructions sent notification.
-	EmailConfirmationSubject                 = "Your account verification code"                                                 // Email confirmation subject.
-	ForgottenPasswordSubject                 = "Your password reset token (it is valid for 24 hours)"                           // Forgotten password subject.
-	PasswordResetSuccessNotification         = "Congratulations! Your password was updated successfully! Please sign in again." // Password reset success message.
+	LogoutNotificationMessage                = "You are successfully logged out."                                                              // Logout success message.
+	SendingEmailNotification                 = "We have sent an email with a verification code to the provided address: %s."                   // Email sent notification.
+	ResendVerificationCodeNotification       = "If an unverified account with the address %s exists, we have sent it a new verification code." // Verification code resent notification.
+	SendingEmailWithInstructionsNotification = "You will receive an email with detailed instructions shortly."                                 // Email with instructions sent notification.
+	EmailConfirmationSubject                 = "Your account verification code"                                                                // Email confirmation subject.
+	ForgottenPasswordSubject                 = "Your password reset token (it is valid for 24 hours)"                                          // Forgotten password subject.
+	PasswordResetSuccessNotification         = "Congratulations! Your password was updated successfully! Please sign in again."                // Password reset success message.
+	EmailVerificationSuccessNotification     = "Your email address has been verified successfully. You can now sign in."                       // Email verification success message.
+	ForcePasswordResetNotification           = "The user has been logged out and will receive an email with password reset instructions."      // Forced password reset message.
+	AccountUnlockSubject                     = "Your account has been temporarily locked"                                                      // Account unlock subject.
+	AccountUnlockSuccessNotification         = "Your account has been unlocked. You can now sign in."                                          // Account unlock success message.
+	TwoFactorEnabledNotification             = "Two-factor authentication has been enabled for your account."                                  // Two-factor authentication enabled message.
+	TwoFactorDisabledNotification            = "Two-factor authentication has been disabled for your account."                                 // Two-factor authentication disabled message.
+	PasswordChangedSubject                   = "Your password has been changed"                                                                // Password changed subject.
+	PasswordUpdateSuccessNotification        = "Your password was updated successfully. All your other sessions have been signed out."         // Password update success message.
+	EmailChangeConfirmationSubject           = "Confirm your new email address"                                                                // Email change confirmation subject.
+	EmailChangeCancelSubject                 = "Your email address is about to be changed"                                                     // Email change cancellation subject.
+	EmailChangeRequestedNotification         = "We have sent an email with a confirmation link to the new address: %s."                        // Email change requested message.
+	EmailChangeConfirmedNotification         = "Your email address has been changed successfully."                                             // Email change confirmed message.
+	EmailChangeCancelledNotification         = "The change of your email address has been cancelled."                                          // Email change cancelled message.
+	MagicLinkSubject                         = "Your sign-in link"                                                                             // Magic login link subject.
 )
 
 // Error Messages.
 const (
-	StringAllowedLength            = "Can be between %d and %d characters long."                                                                                                    // Allowed string length message.
-	StringOptionalAllowedLength    = "Can be empty or between %d and %d characters long."                                                                                           // Optional string length message.
-	StringAllowedCharacters        = "Sorry, only letters (a-z), numbers (0-9), and spaces are allowed."                                                                            // Allowed string character message.
-	EmailAlreadyExists             = "An account with this email address already exists."                                                                                           // Email already exists message.
-	EmailTemplateNotFound          = "Email template not found."                                                                                                                    // Email template not found message.
-	AuthorizationErrorNotification = "Access denied. You do not have the required permissions to perform this action. Please try again or contact our support team for assistance." // Authorization error message.
-	LoggingErrorNotification       = "You are not logged in."                                                                                                                       // Not logged in message.
-	MethodNotAllowedNotification   = "Method %s is not allowed."                                                                                                                    // Method not allowed message.
-	RouteNotFoundNotification      = "The requested URL '%s' was not found on this server."                                                                                         // Route not found message.
-	AlreadyLoggedInNotification    = "Already logged in. This action is not allowed."                                                                                               // Already logged in message.
-	ItemNotFoundErrorNotification  = "Sorry, the requested item does not exist in our records."                                                                                     // Item not found message.
-	TimeExpiredErrorNotification   = "Sorry, the time is expired and not valid anymore"                                                                                             // Time expired message.
-	PaginationErrorNotification    = "Sorry, there was an issue with the pagination request. Please check your parameters and try again."                                           // Pagination error message.
-	InternalErrorNotification      = "Oops! Something went wrong on our end. Please try again later or contact our support team for assistance."                                    // Internal error message.
-	InvalidHTTPMethodNotification  = "Invalid HTTP method. You can only use the methods from the following list: "                                                                  // Invalid HTTP method message.
-	InvalidContentTypeNotification = "Invalid content type. You can use only them from the following list: "                                                                        // Invalid content type message.
-	InvalidHeaderFormat            = "Invalid header format."                                                                                                                       // Invalid header format message.
-	InvalidTokenErrorMessage       = "The token is invalid. Please use the correct token."                                                                                          // Error message for invalid tokens.
+	StringAllowedLength              = "Can be between %d and %d characters long."                                                                                                    // Allowed string length message.
+	StringOptionalAllowedLength      = "Can be empty or between %d and %d characters long."                                                                                           // Optional string length message.
+	StringAllowedCharacters          = "Sorry, only letters (a-z), numbers (0-9), and spaces are allowed."                                                                            // Allowed string character message.
+	EmailAlreadyExists               = "An account with this email address already exists."                                                                                           // Email already exists message.
+	UsernameAlreadyExists            = "An account with this username already exists."                                                                                                // Username already exists message.
+	PostSlugAlreadyExists            = "Sorry, the permalink of the title has just been taken, please try again."                                                                     // Post slug already exists message.
+	PostStatusChangeNotAllowed       = "Sorry, the post can't be moved to the %s status from its current status."                                                                     // Post status change not allowed message.
+	EmailTemplateNotFound            = "Email template not found."                                                                                                                    // Email template not found message.
+	AuthorizationErrorNotification   = "Access denied. You do not have the required permissions to perform this action. Please try again or contact our support team for assistance." // Authorization error message.
+	LoggingErrorNotification         = "You are not logged in."                                                                                                                       // Not logged in message.
+	MethodNotAllowedNotification     = "Method %s is not allowed."                                                                                                                    // Method not allowed message.
+	RouteNotFoundNotification        = "The requested URL '%s' was not found on this server."                                                                                         // Route not found message.
+	AlreadyLoggedInNotification      = "Already logged in. This action is not allowed."                                                                                               // Already logged in message.
+	ItemNotFoundErrorNotification    = "Sorry, the requested item does not exist in our records."                                                                                     // Item not found message.
+	TimeExpiredErrorNotification     = "Sorry, the time is expired and not valid anymore"                                                                                             // Time expired message.
+	PaginationErrorNotification      = "Sorry, there was an issue with the pagination request. Please check your parameters and try again."                                           // Pagination error message.
+	InternalErrorNotification        = "Oops! Something went wrong on our end. Please try again later or contact our support team for assistance."                                    // Internal error message.
+	InvalidHTTPMethodNotification    = "Invalid HTTP method. You can only use the methods from the following list: "                                                                  // Invalid HTTP method message.
+	InvalidContentTypeNotification   = "Invalid content type. You can use only them from the following list: "                                                                        // Invalid content type message.
+	InvalidHeaderFormat              = "Invalid header format."                                                                                                                       // Invalid header format message.
+	InvalidTokenErrorMessage         = "The token is invalid. Please use the correct token."                                                                                          // Error message for invalid tokens.
+	EmailNotVerifiedNotification     = "Your email address is not verified yet. Please follow the link we have sent to your email or request a new one."                              // Email not verified message.
+	EmailAlreadyVerifiedNotification = "This email address is already verified."                                                                                                      // Email already verified message.
+	UserSuspendedNotification        = "Your account has been suspended. Please contact our support team for assistance."                                                             // User suspended message.
+	MustResetPasswordNotification    = "Your password has to be changed. Please follow the link we have sent to your email to choose a new one."                                      // Must reset password message.
+	TooManyLoginAttemptsNotification = "Too many failed login attempts. Please wait a moment before trying again."                                                                    // Login backoff message.
+	LoginLockedNotification          = "Too many failed login attempts, sign-in has been temporarily locked. Please try again later or follow the link we have sent to your email."   // Login lockout message.
 )
@@ -1,70 +1,89 @@
 package model
 
 import (
-	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
 	post "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
 	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/data/repository/mongo"
 	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
 )
 
-const (
-	location = "post.data.depository.mongo."
-)
+func PostRepositoryToPostsRepositoryMapper(postsRepository []PostRepository) PostsRepository {
+	return NewPostsRepository(
+		append([]PostRepository{}, postsRepository...),
+	)
+}
 
-func PostsRepositoryToPostsMapper(postsRepository []*PostRepository) []*post.Post {
-	posts := make([]*post.Post, len(postsRepository))
-	for index, postRepository := range postsRepository {
+func PostsRepositoryToPostsMapper(postsRepository PostsRepository) post.Posts {
+	posts := make([]post.Post, len(postsRepository.Posts))
+	for index, postRepository := range postsRepository.Posts {
 		posts[index] = PostRepositoryToPostMapper(postRepository)
 	}
 
-	return posts
+	return post.NewPosts(
+		posts,
+		postsRepository.PaginationResponse,
+	)
 }
 
-func PostRepositoryToPostMapper(postRepository *PostRepository) *post.Post {
-	return &post.Post{
-		PostID:    postRepository.PostID.Hex(),
-		UserID:    postRepository.UserID.Hex(),
-		Title:     postRepository.Title,
-		Content:   postRepository.Content,
-		Image:     postRepository.Image,
-		Username:  postRepository.Username,
-		CreatedAt: postRepository.CreatedAt,
-		UpdatedAt: postRepository.UpdatedAt,
-	}
+func PostRepositoryToPostMapper(postRepository PostRepository) post.Post {
+	return post.NewPost(
+		postRepository.ID.Hex(),
+		postRepository.UserID.Hex(),
+		postRepository.Username,
+		postRepository.Title,
+		postRepository.Slug,
+		postRepository.Content,
+		postRepository.Image,
+		postRepository.Status,
+		postRepository.PublishAt,
+		postRepository.CreatedAt,
+		postRepository.UpdatedAt,
+	)
 }
 
-func PostCreateToPostCreateRepositoryMapper(logger interfaces.Logger, postCreate *post.PostCreate) (*PostCreateRepository, error) {
-	userObjectID := model.HexToObjectIDMapper(logger, location+"PostCreateToPostCreateRepositoryMapper", postCreate.UserID)
+func PostCreateToPostCreateRepositoryMapper(logger interfaces.Logger, location string, postCreate post.PostCreate) common.Result[PostCreateRepository] {
+	userObjectID := model.HexToObjectIDMapper(logger, location+".PostCreateToPostCreateRepositoryMapper", postCreate.UserID)
 	if validator.IsError(userObjectID.Error) {
-		return &PostCreateRepository{}, userObjectID.Error
+		return common.NewResultOnFailure[PostCreateRepository](userObjectID.Error)
 	}
 
-	return &PostCreateRepository{
-		UserID:    userObjectID.Data,
-		Title:     postCreate.Title,
-		Content:   postCreate.Content,
-		Image:     postCreate.Image,
-		CreatedAt: postCreate.CreatedAt,
-		UpdatedAt: postCreate.UpdatedAt,
-	}, nil
+	return common.NewResultOnSuccess(NewPostCreateRepository(
+		userObjectID.Data,
+		postCreate.Title,
+		postCreate.Slug,
+		postCreate.Content,
+		postCreate.Image,
+		constants.PostStatusDraft,
+	))
 }
 
-func PostUpdateToPostUpdateRepositoryMapper(logger interfaces.Logger, postUpdate *post.PostUpdate) (*PostUpdateRepository, error) {
-	postObjectID := model.HexToObjectIDMapper(logger, location+"PostUpdateToPostUpdateRepositoryMapper.postObjectID", postUpdate.PostID)
+func PostUpdateToPostUpdateRepositoryMapper(logger interfaces.Logger, location string, postUpdate post.PostUpdate) common.Result[PostUpdateRepository] {
+	postObjectID := model.HexToObjectIDMapper(logger, location+".PostUpdateToPostUpdateRepositoryMapper", postUpdate.ID)
 	if validator.IsError(postObjectID.Error) {
-		return &PostUpdateRepository{}, postObjectID.Error
+		return common.NewResultOnFailure[PostUpdateRepository](postObjectID.Error)
 	}
 
-	userObjectID := model.HexToObjectIDMapper(logger, location+"PostUpdateToPostUpdateRepositoryMapper.userObjectID", postUpdate.UserID)
-	if validator.IsError(userObjectID.Error) {
-		return &PostUpdateRepository{}, userObjectID.Error
+	return common.NewResultOnSuccess(NewPostUpdateRepository(
+		postObjectID.Data,
+		postUpdate.Title,
+		postUpdate.Slug,
+		postUpdate.Content,
+		postUpdate.Image,
+	))
+}
+
+func PostStatusUpdateToPostStatusUpdateRepositoryMapper(logger interfaces.Logger, location string, postStatusUpdate post.PostStatusUpdate) common.Result[PostStatusUpdateRepository] {
+	postObjectID := model.HexToObjectIDMapper(logger, location+".PostStatusUpdateToPostStatusUpdateRepositoryMapper", postStatusUpdate.ID)
+	if validator.IsError(postObjectID.Error) {
+		return common.NewResultOnFailure[PostStatusUpdateRepository](postObjectID.Error)
 	}
 
-	return &PostUpdateRepository{
-		PostID:  postObjectID.Data,
-		UserID:  userObjectID.Data,
-		Title:   postUpdate.Title,
-		Content: postUpdate.Content,
-		Image:   postUpdate.Image,
-	}, nil
+	return common.NewResultOnSuccess(NewPostStatusUpdateRepository(
+		postObjectID.Data,
+		postStatusUpdate.Status,
+		postStatusUpdate.PublishAt,
+		postStatusUpdate.PreviousStatuses,
+	))
 }
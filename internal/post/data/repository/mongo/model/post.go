@@ -3,36 +3,93 @@ package model
 import (
 	"time"
 
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	mongoModel "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/data/repository/mongo"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+type PostsRepository struct {
+	Posts              []PostRepository
+	PaginationResponse common.PaginationResponse
+}
+
 type PostRepository struct {
-	PostID    primitive.ObjectID `bson:"_id"`
-	UserID    primitive.ObjectID `bson:"user_id"`
-	Title     string             `bson:"title"`
-	Content   string             `bson:"content"`
-	Image     string             `bson:"image"`
-	Username  string             `bson:"username"`
-	CreatedAt time.Time          `bson:"created_at"`
-	UpdatedAt time.Time          `bson:"updated_at"`
+	mongoModel.BaseEntity `bson:",inline"`
+	UserID                primitive.ObjectID `bson:"user_id"`
+	Username              string             `bson:"username"`
+	Title                 string             `bson:"title"`
+	Slug                  string             `bson:"slug"`
+	PreviousSlugs         []string           `bson:"previous_slugs"`
+	Content               string             `bson:"content"`
+	Image                 string             `bson:"image"`
+	Status                string             `bson:"status"`
+	PublishAt             time.Time          `bson:"publish_at,omitempty"`
 }
 
 type PostCreateRepository struct {
 	UserID    primitive.ObjectID `bson:"user_id"`
+	Username  string             `bson:"username"`
 	Title     string             `bson:"title"`
+	Slug      string             `bson:"slug"`
 	Content   string             `bson:"content"`
 	Image     string             `bson:"image"`
-	Username  string             `bson:"username"`
+	Status    string             `bson:"status"`
 	CreatedAt time.Time          `bson:"created_at"`
 	UpdatedAt time.Time          `bson:"updated_at"`
 }
 
+// PostUpdateRepository keeps the slugs the post had before, so the old permalinks keep leading to it.
 type PostUpdateRepository struct {
-	PostID    primitive.ObjectID `bson:"_id"`
-	UserID    primitive.ObjectID `bson:"user_id"`
-	Title     string             `bson:"title"`
-	Content   string             `bson:"content"`
-	Image     string             `bson:"image"`
-	Username  string             `bson:"username"`
-	UpdatedAt time.Time          `bson:"updated_at"`
+	PostID        primitive.ObjectID `bson:"_id"`
+	Title         string             `bson:"title"`
+	Slug          string             `bson:"slug"`
+	PreviousSlugs []string           `bson:"previous_slugs"`
+	Content       string             `bson:"content"`
+	Image         string             `bson:"image"`
+	UpdatedAt     time.Time          `bson:"updated_at"`
+}
+
+// PostStatusUpdateRepository leaves the publish time out when it is empty, the repository unsets it then.
+type PostStatusUpdateRepository struct {
+	PostID           primitive.ObjectID `bson:"_id"`
+	Status           string             `bson:"status"`
+	PublishAt        time.Time          `bson:"publish_at,omitempty"`
+	PreviousStatuses []string           `bson:"-"`
+	UpdatedAt        time.Time          `bson:"updated_at"`
+}
+
+func NewPostsRepository(posts []PostRepository) PostsRepository {
+	return PostsRepository{
+		Posts: posts,
+	}
+}
+
+func NewPostCreateRepository(userID primitive.ObjectID, title, slug, content, image, status string) PostCreateRepository {
+	return PostCreateRepository{
+		UserID:  userID,
+		Title:   title,
+		Slug:    slug,
+		Content: content,
+		Image:   image,
+		Status:  status,
+	}
+}
+
+func NewPostUpdateRepository(postID primitive.ObjectID, title, slug, content, image string) PostUpdateRepository {
+	return PostUpdateRepository{
+		PostID:  postID,
+		Title:   title,
+		Slug:    slug,
+		Content: content,
+		Image:   image,
+	}
+}
+
+func NewPostStatusUpdateRepository(postID primitive.ObjectID, status string, publishAt time.Time, previousStatuses []string) PostStatusUpdateRepository {
+	return PostStatusUpdateRepository{
+		PostID:           postID,
+		Status:           status,
+		PublishAt:        publishAt,
+		PreviousStatuses: previousStatuses,
+	}
 }
@@ -4,218 +4,592 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
-	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
 	repository "github.com/yachnytskyi/golang-mongo-grpc/internal/post/data/repository/mongo/model"
 	post "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/model"
+	domainUtility "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/utility"
 	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/data/repository/mongo/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
 	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/data/repository/mongo"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/data/repository/mongo"
 	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 const (
-	location = "post.data.repository.mongo."
+	location         = "post.data.repository.mongo."
+	titleKey         = "title"
+	titleField       = "title"
+	slugKey          = "slug"
+	previousSlugsKey = "previous_slugs"
+	usernameKey      = "username"
+	userIDKey        = "user_id"
+	createdAtKey     = "created_at"
+	updatedAtKey     = "updated_at"
+	statusKey        = "status"
+	statusField      = "status"
+	publishAtKey     = "publish_at"
+
+	slugIndexName  = "slug_unique"
+	titleIndexName = "title_1" // The unique index on titles, which slugs have replaced.
+
+	// Mongo error codes of an index or a collection that doesn't exist.
+	indexNotFoundCode     = 27
+	namespaceNotFoundCode = 26
+
+	// How many times a post is inserted with the next free slug after another post has taken the slug in the meantime.
+	maxSlugAttempts = 3
+
+	postNotFound = "There is no post with the ID."
+	userNotFound = "The author of the post does not exist."
 )
 
 type PostRepository struct {
 	Logger interfaces.Logger
-	posts  *mongo.Collection
-	users  *mongo.Collection
+	Posts  *mongo.Collection
+	Users  *mongo.Collection
 }
 
-func NewPostRepository(logger interfaces.Logger, db *mongo.Database) interfaces.PostRepository {
-	return &PostRepository{
+func NewPostRepository(logger interfaces.Logger, database *mongo.Database) PostRepository {
+	repository := PostRepository{
 		Logger: logger,
-		posts:  db.Collection(constants.PostsTable),
-		users:  db.Collection(constants.UsersTable),
+		Posts:  database.Collection(constants.PostsTable),
+		Users:  database.Collection(constants.UsersTable),
 	}
-}
 
-func (postRepository *PostRepository) GetAllPosts(ctx context.Context, page int, limit int) (*post.Posts, error) {
-	if page == 0 {
-		page = 1
-	}
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultContextTimer)
+	defer cancel()
 
-	if limit == 0 {
-		limit = 100
+	// Posts are told apart by their slugs, so they may share a title.
+	dropUniqueTitleIndexError := repository.dropUniqueTitleIndex(ctx, location+"NewPostRepository")
+	if validator.IsError(dropUniqueTitleIndexError) {
+		logger.Panic(domain.NewInternalError(location+"NewPostRepository.dropUniqueTitleIndex", dropUniqueTitleIndexError.Error()))
 	}
 
-	skip := (page - 1) * limit
-
-	option := options.FindOptions{}
-	option.SetLimit(int64(limit))
-	option.SetSkip(int64(skip))
+	// Give the existing posts a slug before the unique index on slugs is created.
+	migratePostSlugsError := repository.migratePostSlugs(ctx, location+"NewPostRepository")
+	if validator.IsError(migratePostSlugsError) {
+		logger.Panic(domain.NewInternalError(location+"NewPostRepository.migratePostSlugs", migratePostSlugsError.Error()))
+	}
 
-	query := bson.M{}
-	cursor, err := postRepository.posts.Find(ctx, query, &option)
+	ensureSlugIndexesError := repository.ensureSlugIndexes(ctx, location+"NewPostRepository")
+	if validator.IsError(ensureSlugIndexesError) {
+		logger.Panic(domain.NewInternalError(location+"NewPostRepository.ensureSlugIndexes", ensureSlugIndexesError.Error()))
+	}
 
-	if validator.IsError(err) {
-		return nil, err
+	// The posts created before statuses were introduced have been visible to everyone, so they are published.
+	migratePostStatusesError := repository.migratePostStatuses(ctx, location+"NewPostRepository")
+	if validator.IsError(migratePostStatusesError) {
+		logger.Panic(domain.NewInternalError(location+"NewPostRepository.migratePostStatuses", migratePostStatusesError.Error()))
 	}
 
-	defer cursor.Close(ctx)
+	ensureStatusIndexesError := repository.ensureStatusIndexes(ctx, location+"NewPostRepository")
+	if validator.IsError(ensureStatusIndexesError) {
+		logger.Panic(domain.NewInternalError(location+"NewPostRepository.ensureStatusIndexes", ensureStatusIndexesError.Error()))
+	}
 
-	var fetchedPosts []*repository.PostRepository
+	return repository
+}
 
-	for cursor.Next(ctx) {
-		post := &repository.PostRepository{}
-		err := cursor.Decode(post)
+// GetAllPosts retrieves a page of the posts matching the filter from the database based on pagination parameters.
+func (postRepository PostRepository) GetAllPosts(ctx context.Context, paginationQuery common.PaginationQuery, postFilter post.PostFilter) common.Result[post.Posts] {
+	query := postRepository.postFilterQuery(location+"GetAllPosts", postFilter)
+	if validator.IsError(query.Error) {
+		return common.NewResultOnFailure[post.Posts](query.Error)
+	}
 
-		if validator.IsError(err) {
-			return nil, err
-		}
+	// Count the total number of posts to set up pagination.
+	totalPosts, countDocumentsError := postRepository.Posts.CountDocuments(ctx, query.Data)
+	if validator.IsError(countDocumentsError) {
+		internalError := domain.NewInternalError(location+"GetAllPosts.Posts.CountDocuments", countDocumentsError.Error())
+		postRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[post.Posts](internalError)
+	}
 
-		fetchedPosts = append(fetchedPosts, post)
+	// Set up pagination and sorting options using provided parameters.
+	// The ID breaks ties, so posts with the same value of the sorted field keep their place between pages.
+	paginationQuery.TotalItems = int(totalPosts)
+	paginationQuery = common.SetCorrectPage(paginationQuery)
+	sortOrder := utility.SetSortOrder(paginationQuery.SortOrder)
+	option := options.FindOptions{}
+	option.SetLimit(int64(paginationQuery.Limit))
+	option.SetSkip(int64(paginationQuery.Skip))
+	option.SetSort(bson.D{{Key: paginationQuery.OrderBy, Value: sortOrder}, {Key: model.ID, Value: sortOrder}})
+
+	// Query the database to fetch posts.
+	cursor, postsFindError := postRepository.Posts.Find(ctx, query.Data, &option)
+	if validator.IsError(postsFindError) {
+		internalError := domain.NewInternalError(location+"GetAllPosts.Find", postsFindError.Error())
+		postRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[post.Posts](internalError)
 	}
+	defer cursor.Close(ctx)
 
-	err = cursor.Err()
-	if validator.IsError(err) {
-		return nil, err
+	// Process the results and map them to the repository model.
+	fetchedPosts := make([]repository.PostRepository, 0, paginationQuery.Limit)
+	for cursor.Next(ctx) {
+		postInstance := repository.PostRepository{}
+		decodeError := cursor.Decode(&postInstance)
+		if validator.IsError(decodeError) {
+			internalError := domain.NewInternalError(location+"GetAllPosts.cursor.Decode", decodeError.Error())
+			postRepository.Logger.Error(internalError)
+			return common.NewResultOnFailure[post.Posts](internalError)
+		}
+		fetchedPosts = append(fetchedPosts, postInstance)
 	}
 
-	if len(fetchedPosts) == 0 {
-		return &post.Posts{
-			Posts: make([]*post.Post, 0),
-		}, nil
+	cursorError := cursor.Err()
+	if validator.IsError(cursorError) {
+		internalError := domain.NewInternalError(location+"GetAllPosts.cursor.Err", cursorError.Error())
+		postRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[post.Posts](internalError)
 	}
 
-	return &post.Posts{
-		Posts: repository.PostsRepositoryToPostsMapper(fetchedPosts),
-	}, nil
+	postsRepository := repository.PostRepositoryToPostsRepositoryMapper(fetchedPosts)
+	postsRepository.PaginationResponse = common.NewPaginationResponse(paginationQuery)
+	return common.NewResultOnSuccess[post.Posts](repository.PostsRepositoryToPostsMapper(postsRepository))
 }
 
-func (postRepository *PostRepository) GetPostById(ctx context.Context, postID string) (*post.Post, error) {
-	postObjectID := model.HexToObjectIDMapper(postRepository.Logger, location+"GetPostById", postID)
+// GetPostById retrieves a post by its ID from the database.
+func (postRepository PostRepository) GetPostById(ctx context.Context, postID string) common.Result[post.Post] {
+	postObjectID := postRepository.postIDToObjectID(location+"GetPostById", postID)
 	if validator.IsError(postObjectID.Error) {
-		return nil, postObjectID.Error
+		return common.NewResultOnFailure[post.Post](postObjectID.Error)
 	}
 
-	var fetchedPost *repository.PostRepository
 	query := bson.M{model.ID: postObjectID.Data}
-	err := postRepository.posts.FindOne(ctx, query).Decode(&fetchedPost)
-	if validator.IsError(err) {
-		if err == mongo.ErrNoDocuments {
-			return nil, errors.New("no document with that Id exists")
+	return postRepository.getPostByQuery(location+"GetPostById", ctx, query)
+}
+
+// GetPostBySlug retrieves a post by its current or one of its previous slugs from the database.
+// The slugs are compared as they are, the use case normalizes them.
+func (postRepository PostRepository) GetPostBySlug(ctx context.Context, slug string) common.Result[post.Post] {
+	query := bson.M{model.Or: bson.A{
+		bson.M{slugKey: slug},
+		bson.M{previousSlugsKey: slug},
+	}}
+	return postRepository.getPostByQuery(location+"GetPostBySlug", ctx, query)
+}
+
+// CreatePost stores a post of the user in the database, together with the username of the author.
+// The post gets the first free candidate of its slug, the unique index stays authoritative,
+// so when another post takes the slug in the meantime the next free one is tried.
+func (postRepository PostRepository) CreatePost(ctx context.Context, postCreate post.PostCreate) common.Result[post.Post] {
+	postCreateRepository := repository.PostCreateToPostCreateRepositoryMapper(postRepository.Logger, location+"CreatePost", postCreate)
+	if validator.IsError(postCreateRepository.Error) {
+		return common.NewResultOnFailure[post.Post](postCreateRepository.Error)
+	}
+
+	username := postRepository.getUsername(location+"CreatePost", ctx, postCreateRepository.Data.UserID)
+	if validator.IsError(username.Error) {
+		return common.NewResultOnFailure[post.Post](username.Error)
+	}
+
+	postCreateRepository.Data.Username = username.Data
+	postCreateRepository.Data.CreatedAt = time.Now()
+	postCreateRepository.Data.UpdatedAt = time.Now()
+	baseSlug := postCreateRepository.Data.Slug
+	for attempt := 1; attempt <= maxSlugAttempts; attempt++ {
+		slug := postRepository.findFreeSlug(ctx, location+"CreatePost", primitive.NilObjectID, baseSlug)
+		if validator.IsError(slug.Error) {
+			return common.NewResultOnFailure[post.Post](slug.Error)
 		}
 
-		return nil, err
+		postCreateRepository.Data.Slug = slug.Data
+		insertOneResult, insertOneResultError := postRepository.Posts.InsertOne(ctx, &postCreateRepository.Data)
+		if validator.IsError(insertOneResultError) {
+			if mongo.IsDuplicateKeyError(insertOneResultError) && strings.Contains(insertOneResultError.Error(), slugIndexName) {
+				continue
+			}
+			internalError := domain.NewInternalError(location+"CreatePost.InsertOne", insertOneResultError.Error())
+			postRepository.Logger.Error(internalError)
+			return common.NewResultOnFailure[post.Post](internalError)
+		}
+
+		query := bson.M{model.ID: insertOneResult.InsertedID}
+		return postRepository.getPostByQuery(location+"CreatePost", ctx, query)
 	}
 
-	return repository.PostRepositoryToPostMapper(fetchedPost), nil
+	return common.NewResultOnFailure[post.Post](postRepository.slugConflictError(location + "CreatePost.InsertOne"))
 }
 
-func (postRepository *PostRepository) CreatePost(ctx context.Context, post *post.PostCreate) (*post.Post, error) {
-	// Map PostCreate to PostCreateRepository
-	postMappedToRepository, postCreateToPostCreateRepositoryMapperError := repository.PostCreateToPostCreateRepositoryMapper(postRepository.Logger, post)
-	if validator.IsError(postCreateToPostCreateRepositoryMapperError) {
-		return nil, postCreateToPostCreateRepositoryMapperError
+// UpdatePostById updates the title, the content and the image of the post, the author is kept.
+// When the title leads to another slug, the post gets the first free candidate of it and the current slug
+// is kept among the previous ones, so the old permalinks keep leading to the post.
+func (postRepository PostRepository) UpdatePostById(ctx context.Context, postUpdate post.PostUpdate) common.Result[post.Post] {
+	postUpdateRepository := repository.PostUpdateToPostUpdateRepositoryMapper(postRepository.Logger, location+"UpdatePostById", postUpdate)
+	if validator.IsError(postUpdateRepository.Error) {
+		return common.NewResultOnFailure[post.Post](postUpdateRepository.Error)
 	}
 
-	// Fetch the username from the users collection.
-	var user user.UserRepository
-	query := bson.M{model.ID: postMappedToRepository.UserID}
-	err := postRepository.users.FindOne(ctx, query).Decode(&user)
-	if validator.IsError(err) {
-		fmt.Println(err)
-		if err == mongo.ErrNoDocuments {
-			return nil, errors.New("user not found")
-		}
-		return nil, errors.New("error fetching user details")
+	currentPost := postRepository.getPostRepositoryByQuery(location+"UpdatePostById", ctx, bson.M{model.ID: postUpdateRepository.Data.PostID})
+	if validator.IsError(currentPost.Error) {
+		return common.NewResultOnFailure[post.Post](currentPost.Error)
 	}
 
-	postMappedToRepository.Username = user.Username
-	postMappedToRepository.CreatedAt = time.Now()
-	postMappedToRepository.UpdatedAt = time.Now()
+	slug := currentPost.Data.Slug
+	previousSlugs := append(make([]string, 0, len(currentPost.Data.PreviousSlugs)+1), currentPost.Data.PreviousSlugs...)
+	if !domainUtility.IsSlugCandidate(slug, postUpdateRepository.Data.Slug) {
+		freeSlug := postRepository.findFreeSlug(ctx, location+"UpdatePostById", postUpdateRepository.Data.PostID, postUpdateRepository.Data.Slug)
+		if validator.IsError(freeSlug.Error) {
+			return common.NewResultOnFailure[post.Post](freeSlug.Error)
+		}
 
-	// Insert the post into the collection
-	result, err := postRepository.posts.InsertOne(ctx, postMappedToRepository)
-	if validator.IsError(err) {
-		er, ok := err.(mongo.WriteException)
-		if ok && len(er.WriteErrors) > 0 && er.WriteErrors[0].Code == 11000 {
-			return nil, errors.New("post with that title already exists")
+		// A post that gets one of its previous slugs back doesn't keep it among the previous ones.
+		previousSlugs = slices.DeleteFunc(previousSlugs, func(previousSlug string) bool { return previousSlug == freeSlug.Data })
+		if validator.IsValueNotEmpty(slug) {
+			previousSlugs = append(previousSlugs, slug)
 		}
-		return nil, err
+		slug = freeSlug.Data
 	}
 
-	// Create an index for the title field
-	option := options.Index()
-	option.SetUnique(true)
-	index := mongo.IndexModel{Keys: bson.M{"title": 1}, Options: option}
-	_, err = postRepository.posts.Indexes().CreateOne(ctx, index)
-	if validator.IsError(err) {
-		return nil, errors.New("could not create an index for the title")
+	postUpdateRepository.Data.Slug = slug
+	postUpdateRepository.Data.PreviousSlugs = previousSlugs
+	postUpdateRepository.Data.UpdatedAt = time.Now()
+	postUpdateBSON := model.DataToMongoDocumentMapper(postRepository.Logger, location+"UpdatePostById", postUpdateRepository.Data)
+	if validator.IsError(postUpdateBSON.Error) {
+		return common.NewResultOnFailure[post.Post](postUpdateBSON.Error)
 	}
 
-	// Retrieve the created post
-	var createdPost *repository.PostRepository
-	query = bson.M{model.ID: result.InsertedID}
-	err = postRepository.posts.FindOne(ctx, query).Decode(&createdPost)
-	if validator.IsError(err) {
-		return nil, err
+	query := bson.D{{Key: model.ID, Value: postUpdateRepository.Data.PostID}}
+	update := bson.D{{Key: model.Set, Value: postUpdateBSON.Data}}
+	result := postRepository.Posts.FindOneAndUpdate(ctx, query, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
+	updatedPost := repository.PostRepository{}
+	decodeError := result.Decode(&updatedPost)
+	if validator.IsError(decodeError) {
+		if mongo.IsDuplicateKeyError(decodeError) {
+			return common.NewResultOnFailure[post.Post](postRepository.slugConflictError(location + "UpdatePostById.Decode"))
+		}
+		if decodeError == mongo.ErrNoDocuments {
+			itemNotFoundError := domain.NewItemNotFoundError(location+"UpdatePostById.Decode", utility.BSONToStringMapper(bson.M{model.ID: postUpdateRepository.Data.PostID}), postNotFound)
+			postRepository.Logger.Error(itemNotFoundError)
+			return common.NewResultOnFailure[post.Post](itemNotFoundError)
+		}
+		internalError := domain.NewInternalError(location+"UpdatePostById.Decode", decodeError.Error())
+		postRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[post.Post](internalError)
 	}
 
-	return repository.PostRepositoryToPostMapper(createdPost), nil
+	return common.NewResultOnSuccess[post.Post](repository.PostRepositoryToPostMapper(updatedPost))
 }
 
-func (postRepository *PostRepository) UpdatePostById(ctx context.Context, postID string, postUpdate *post.PostUpdate) (*post.Post, error) {
-	postUpdateRepository, postUpdateToPostUpdateRepositoryMapper := repository.PostUpdateToPostUpdateRepositoryMapper(postRepository.Logger, postUpdate)
-	if validator.IsError(postUpdateToPostUpdateRepositoryMapper) {
-		return nil, postUpdateToPostUpdateRepositoryMapper
+// ChangePostStatus moves the post to the status and sets or unsets its publish time.
+// The post is only changed while its status is one of the previous statuses, so a post the scheduler
+// has just published or another request has just changed is reported as a conflict instead of being overwritten.
+func (postRepository PostRepository) ChangePostStatus(ctx context.Context, postStatusUpdate post.PostStatusUpdate) common.Result[post.Post] {
+	postStatusUpdateRepository := repository.PostStatusUpdateToPostStatusUpdateRepositoryMapper(postRepository.Logger, location+"ChangePostStatus", postStatusUpdate)
+	if validator.IsError(postStatusUpdateRepository.Error) {
+		return common.NewResultOnFailure[post.Post](postStatusUpdateRepository.Error)
 	}
 
-	// Fetch the username from the users collection.
-	var user user.UserRepository
-	userQuery := bson.M{model.ID: postUpdateRepository.UserID}
-	err := postRepository.users.FindOne(ctx, userQuery).Decode(&user)
-	if validator.IsError(err) {
-		fmt.Println(err)
-		if err == mongo.ErrNoDocuments {
-			return nil, errors.New("user not found")
-		}
-		return nil, errors.New("error fetching user details")
+	postStatusUpdateRepository.Data.UpdatedAt = time.Now()
+	postStatusUpdateBSON := model.DataToMongoDocumentMapper(postRepository.Logger, location+"ChangePostStatus", postStatusUpdateRepository.Data)
+	if validator.IsError(postStatusUpdateBSON.Error) {
+		return common.NewResultOnFailure[post.Post](postStatusUpdateBSON.Error)
 	}
 
-	postUpdateRepository.Username = user.Username
-	postUpdateRepository.UpdatedAt = time.Now()
-
-	// Map the user update repository to a BSON document for MongoDB update.
-	postUpdateBson := model.DataToMongoDocumentMapper(postRepository.Logger, location+"UpdatePostById", postUpdateRepository)
-	if validator.IsError(postUpdateBson.Error) {
-		return nil, postUpdateBson.Error
+	query := bson.D{
+		{Key: model.ID, Value: postStatusUpdateRepository.Data.PostID},
+		{Key: statusKey, Value: bson.M{model.In: postStatusUpdateRepository.Data.PreviousStatuses}},
+	}
+	update := bson.D{{Key: model.Set, Value: postStatusUpdateBSON.Data}}
+	if postStatusUpdateRepository.Data.PublishAt.IsZero() {
+		update = append(update, bson.E{Key: model.Unset, Value: bson.M{publishAtKey: ""}})
 	}
 
-	query := bson.D{{Key: model.ID, Value: postUpdateRepository.PostID}}
-	update := bson.D{{Key: model.Set, Value: postUpdateBson.Data}}
-	result := postRepository.posts.FindOneAndUpdate(ctx, query, update, options.FindOneAndUpdate().SetReturnDocument(1))
-	updatedPost := &repository.PostRepository{}
+	result := postRepository.Posts.FindOneAndUpdate(ctx, query, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
+	updatedPost := repository.PostRepository{}
 	decodeError := result.Decode(&updatedPost)
-	if validator.IsError(err) {
-		fmt.Println(decodeError)
-		return nil, decodeError
+	if validator.IsError(decodeError) {
+		if decodeError == mongo.ErrNoDocuments {
+			conflictError := domain.NewConflictError(location+"ChangePostStatus.Decode", statusField, fmt.Sprintf(constants.PostStatusChangeNotAllowed, postStatusUpdateRepository.Data.Status))
+			postRepository.Logger.Error(conflictError)
+			return common.NewResultOnFailure[post.Post](conflictError)
+		}
+		internalError := domain.NewInternalError(location+"ChangePostStatus.Decode", decodeError.Error())
+		postRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[post.Post](internalError)
 	}
 
-	return repository.PostRepositoryToPostMapper(updatedPost), nil
+	return common.NewResultOnSuccess[post.Post](repository.PostRepositoryToPostMapper(updatedPost))
 }
 
-func (postRepository *PostRepository) DeletePostByID(ctx context.Context, postID string) error {
-	postObjectID := model.HexToObjectIDMapper(postRepository.Logger, location+"GetPostById", postID)
+// PublishScheduledPosts publishes the scheduled posts whose publish time has come and returns how many were published.
+func (postRepository PostRepository) PublishScheduledPosts(ctx context.Context, now time.Time) common.Result[int64] {
+	query := bson.M{statusKey: constants.PostStatusScheduled, publishAtKey: bson.M{model.LessThanOrEqual: now}}
+	update := bson.M{model.Set: bson.M{statusKey: constants.PostStatusPublished, updatedAtKey: now}}
+	result, updateManyError := postRepository.Posts.UpdateMany(ctx, query, update)
+	if validator.IsError(updateManyError) {
+		internalError := domain.NewInternalError(location+"PublishScheduledPosts.UpdateMany", updateManyError.Error())
+		postRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[int64](internalError)
+	}
+
+	return common.NewResultOnSuccess[int64](result.ModifiedCount)
+}
+
+// DeletePostByID deletes the post with the provided ID from the database.
+func (postRepository PostRepository) DeletePostByID(ctx context.Context, postID string) error {
+	postObjectID := postRepository.postIDToObjectID(location+"DeletePostByID", postID)
 	if validator.IsError(postObjectID.Error) {
 		return postObjectID.Error
 	}
 
 	query := bson.M{model.ID: postObjectID.Data}
-	result, err := postRepository.posts.DeleteOne(ctx, query)
-	if validator.IsError(err) {
-		return err
+	result, deleteOneError := postRepository.Posts.DeleteOne(ctx, query)
+	if validator.IsError(deleteOneError) {
+		internalError := domain.NewInternalError(location+"DeletePostByID.DeleteOne", deleteOneError.Error())
+		postRepository.Logger.Error(internalError)
+		return internalError
 	}
 	if result.DeletedCount == 0 {
-		return errors.New("no document with that Id exists")
+		itemNotFoundError := domain.NewItemNotFoundError(location+"DeletePostByID.DeleteOne.DeletedCount", utility.BSONToStringMapper(query), postNotFound)
+		postRepository.Logger.Error(itemNotFoundError)
+		return itemNotFoundError
+	}
+
+	return nil
+}
+
+// dropUniqueTitleIndex drops the unique index on titles, a database that never had it is left as it is.
+func (postRepository PostRepository) dropUniqueTitleIndex(ctx context.Context, location string) error {
+	_, postIndexesDropOneError := postRepository.Posts.Indexes().DropOne(ctx, titleIndexName)
+	if validator.IsError(postIndexesDropOneError) {
+		var commandError mongo.CommandError
+		if errors.As(postIndexesDropOneError, &commandError) && (commandError.Code == indexNotFoundCode || commandError.Code == namespaceNotFoundCode) {
+			return nil
+		}
+		internalError := domain.NewInternalError(location+".dropUniqueTitleIndex.Indexes.DropOne", postIndexesDropOneError.Error())
+		postRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
+// ensureSlugIndexes creates a unique index on the slug and an index on the previous slugs,
+// which are looked up when an old permalink is followed.
+func (postRepository PostRepository) ensureSlugIndexes(ctx context.Context, location string) error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.M{slugKey: 1}, Options: options.Index().SetUnique(true).SetName(slugIndexName)},
+		{Keys: bson.M{previousSlugsKey: 1}},
+	}
+
+	_, postIndexesCreateManyError := postRepository.Posts.Indexes().CreateMany(ctx, indexes)
+	if validator.IsError(postIndexesCreateManyError) {
+		internalError := domain.NewInternalError(location+".ensureSlugIndexes.Indexes.CreateMany", postIndexesCreateManyError.Error())
+		postRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
+// ensureStatusIndexes creates an index for the listings of the posts with a status
+// and one for the scheduler looking up the scheduled posts that are due.
+func (postRepository PostRepository) ensureStatusIndexes(ctx context.Context, location string) error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: statusKey, Value: 1}, {Key: createdAtKey, Value: -1}}},
+		{Keys: bson.D{{Key: statusKey, Value: 1}, {Key: publishAtKey, Value: 1}}},
+	}
+
+	_, postIndexesCreateManyError := postRepository.Posts.Indexes().CreateMany(ctx, indexes)
+	if validator.IsError(postIndexesCreateManyError) {
+		internalError := domain.NewInternalError(location+".ensureStatusIndexes.Indexes.CreateMany", postIndexesCreateManyError.Error())
+		postRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
+// migratePostStatuses publishes the posts created before statuses were introduced, as of their creation.
+func (postRepository PostRepository) migratePostStatuses(ctx context.Context, location string) error {
+	query := bson.M{statusKey: bson.M{model.Exists: false}}
+	update := bson.A{bson.M{model.Set: bson.M{statusKey: constants.PostStatusPublished, publishAtKey: "$" + createdAtKey}}}
+	_, updateManyError := postRepository.Posts.UpdateMany(ctx, query, update)
+	if validator.IsError(updateManyError) {
+		internalError := domain.NewInternalError(location+".migratePostStatuses.UpdateMany", updateManyError.Error())
+		postRepository.Logger.Error(internalError)
+		return internalError
 	}
 
 	return nil
 }
+
+// migratePostSlugs gives the posts created before slugs were introduced the slug of their title.
+// The oldest posts are migrated first, so they keep the plain slug and the later posts sharing it get a numbered suffix.
+func (postRepository PostRepository) migratePostSlugs(ctx context.Context, location string) error {
+	query := bson.M{slugKey: bson.M{model.Exists: false}}
+	option := options.Find()
+	option.SetSort(bson.D{{Key: createdAtKey, Value: 1}, {Key: model.ID, Value: 1}})
+	option.SetProjection(bson.M{titleKey: 1})
+	cursor, postsFindError := postRepository.Posts.Find(ctx, query, option)
+	if validator.IsError(postsFindError) {
+		internalError := domain.NewInternalError(location+".migratePostSlugs.Find", postsFindError.Error())
+		postRepository.Logger.Error(internalError)
+		return internalError
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		postInstance := repository.PostRepository{}
+		decodeError := cursor.Decode(&postInstance)
+		if validator.IsError(decodeError) {
+			internalError := domain.NewInternalError(location+".migratePostSlugs.cursor.Decode", decodeError.Error())
+			postRepository.Logger.Error(internalError)
+			return internalError
+		}
+
+		slug := postRepository.findFreeSlug(ctx, location+".migratePostSlugs", postInstance.ID, domainUtility.NormalizeSlug(postInstance.Title))
+		if validator.IsError(slug.Error) {
+			return slug.Error
+		}
+
+		update := bson.M{model.Set: bson.M{slugKey: slug.Data, previousSlugsKey: bson.A{}}}
+		_, updateOneError := postRepository.Posts.UpdateOne(ctx, bson.M{model.ID: postInstance.ID}, update)
+		if validator.IsError(updateOneError) {
+			internalError := domain.NewInternalError(location+".migratePostSlugs.UpdateOne", updateOneError.Error())
+			postRepository.Logger.Error(internalError)
+			return internalError
+		}
+	}
+
+	cursorError := cursor.Err()
+	if validator.IsError(cursorError) {
+		internalError := domain.NewInternalError(location+".migratePostSlugs.cursor.Err", cursorError.Error())
+		postRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
+// findFreeSlug returns the first candidate of the slug that no other post has, neither as its slug nor as a previous one,
+// so the old permalinks of a post never start leading to another post. The post ID is nil for a new post.
+func (postRepository PostRepository) findFreeSlug(ctx context.Context, location string, postID primitive.ObjectID, slug string) common.Result[string] {
+	for attempt := 1; ; attempt++ {
+		candidate := domainUtility.SlugCandidate(slug, attempt)
+		query := bson.M{model.Or: bson.A{
+			bson.M{slugKey: candidate},
+			bson.M{previousSlugsKey: candidate},
+		}}
+		if !postID.IsZero() {
+			query[model.ID] = bson.M{model.NotEqual: postID}
+		}
+
+		count, countDocumentsError := postRepository.Posts.CountDocuments(ctx, query)
+		if validator.IsError(countDocumentsError) {
+			internalError := domain.NewInternalError(location+".findFreeSlug.CountDocuments", countDocumentsError.Error())
+			postRepository.Logger.Error(internalError)
+			return common.NewResultOnFailure[string](internalError)
+		}
+		if count == 0 {
+			return common.NewResultOnSuccess[string](candidate)
+		}
+	}
+}
+
+// postIDToObjectID converts the post ID, an ID that is not an ObjectID can't belong to any post.
+func (postRepository PostRepository) postIDToObjectID(location, postID string) common.Result[primitive.ObjectID] {
+	postObjectID, objectIDFromHexError := primitive.ObjectIDFromHex(postID)
+	if validator.IsError(objectIDFromHexError) {
+		itemNotFoundError := domain.NewItemNotFoundError(location+".postIDToObjectID.ObjectIDFromHex", postID, postNotFound)
+		postRepository.Logger.Error(itemNotFoundError)
+		return common.NewResultOnFailure[primitive.ObjectID](itemNotFoundError)
+	}
+
+	return common.NewResultOnSuccess[primitive.ObjectID](postObjectID)
+}
+
+// postFilterQuery builds the query of the filter, the fields that are empty don't filter the posts.
+func (postRepository PostRepository) postFilterQuery(location string, postFilter post.PostFilter) common.Result[bson.M] {
+	query := bson.M{}
+	if validator.IsValueNotEmpty(postFilter.UserID) {
+		userObjectID := model.HexToObjectIDMapper(postRepository.Logger, location+".postFilterQuery", postFilter.UserID)
+		if validator.IsError(userObjectID.Error) {
+			return common.NewResultOnFailure[bson.M](userObjectID.Error)
+		}
+		query[userIDKey] = userObjectID.Data
+	}
+	if validator.IsValueNotEmpty(postFilter.Status) {
+		query[statusKey] = postFilter.Status
+	}
+
+	createdAt := bson.M{}
+	if !postFilter.CreatedFrom.IsZero() {
+		createdAt[model.GreaterThanOrEqual] = postFilter.CreatedFrom
+	}
+	if !postFilter.CreatedTo.IsZero() {
+		createdAt[model.LessThanOrEqual] = postFilter.CreatedTo
+	}
+	if len(createdAt) > 0 {
+		query[createdAtKey] = createdAt
+	}
+
+	return common.NewResultOnSuccess[bson.M](query)
+}
+
+// getUsername retrieves the username of the author from the users collection.
+func (postRepository PostRepository) getUsername(location string, ctx context.Context, userID primitive.ObjectID) common.Result[string] {
+	fetchedUser := user.UserRepository{}
+	query := bson.M{model.ID: userID}
+	option := options.FindOne().SetProjection(bson.M{usernameKey: 1})
+	userFindOneError := postRepository.Users.FindOne(ctx, query, option).Decode(&fetchedUser)
+	if validator.IsError(userFindOneError) {
+		if utility.IsMongoDBError(userFindOneError) {
+			internalError := domain.NewInternalError(location+".getUsername.FindOne.Decode", userFindOneError.Error())
+			postRepository.Logger.Error(internalError)
+			return common.NewResultOnFailure[string](internalError)
+		}
+		itemNotFoundError := domain.NewItemNotFoundError(location+".getUsername.FindOne.Decode", utility.BSONToStringMapper(query), userNotFound)
+		postRepository.Logger.Error(itemNotFoundError)
+		return common.NewResultOnFailure[string](itemNotFoundError)
+	}
+
+	return common.NewResultOnSuccess[string](fetchedUser.Username)
+}
+
+// getPostByQuery retrieves a post based on the provided query from the database.
+func (postRepository PostRepository) getPostByQuery(location string, ctx context.Context, query bson.M) common.Result[post.Post] {
+	fetchedPost := postRepository.getPostRepositoryByQuery(location, ctx, query)
+	if validator.IsError(fetchedPost.Error) {
+		return common.NewResultOnFailure[post.Post](fetchedPost.Error)
+	}
+
+	return common.NewResultOnSuccess[post.Post](repository.PostRepositoryToPostMapper(fetchedPost.Data))
+}
+
+// getPostRepositoryByQuery retrieves a post based on the provided query from the database, keeping the repository model.
+func (postRepository PostRepository) getPostRepositoryByQuery(location string, ctx context.Context, query bson.M) common.Result[repository.PostRepository] {
+	fetchedPost := repository.PostRepository{}
+	postFindOneError := postRepository.Posts.FindOne(ctx, query).Decode(&fetchedPost)
+	if validator.IsError(postFindOneError) {
+		if utility.IsMongoDBError(postFindOneError) {
+			internalError := domain.NewInternalError(location+".getPostByQuery.FindOne.Decode", postFindOneError.Error())
+			postRepository.Logger.Error(internalError)
+			return common.NewResultOnFailure[repository.PostRepository](internalError)
+		}
+		itemNotFoundError := domain.NewItemNotFoundError(location+".getPostByQuery.FindOne.Decode", utility.BSONToStringMapper(query), postFindOneError.Error())
+		postRepository.Logger.Error(itemNotFoundError)
+		return common.NewResultOnFailure[repository.PostRepository](itemNotFoundError)
+	}
+
+	return common.NewResultOnSuccess[repository.PostRepository](fetchedPost)
+}
+
+// slugConflictError reports a slug that other posts kept taking while the post was stored.
+func (postRepository PostRepository) slugConflictError(location string) error {
+	conflictError := domain.NewConflictError(location, titleField, constants.PostSlugAlreadyExists)
+	postRepository.Logger.Error(conflictError)
+	return conflictError
+}
@@ -23,7 +23,7 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PostUseCaseClient interface {
 	GetPostById(ctx context.Context, in *PostById, opts ...grpc.CallOption) (*PostView, error)
-	GetAllPosts(ctx context.Context, in *Posts, opts ...grpc.CallOption) (PostUseCase_GetAllPostsClient, error)
+	GetAllPosts(ctx context.Context, in *Posts, opts ...grpc.CallOption) (*PostsView, error)
 	CreatePost(ctx context.Context, in *PostCreate, opts ...grpc.CallOption) (*PostView, error)
 	UpdatePostById(ctx context.Context, in *PostUpdate, opts ...grpc.CallOption) (*PostView, error)
 	DeletePostById(ctx context.Context, in *PostById, opts ...grpc.CallOption) (*PostDeleteView, error)
@@ -46,36 +46,13 @@ func (c *postUseCaseClient) GetPostById(ctx context.Context, in *PostById, opts
 	return out, nil
 }
 
-func (c *postUseCaseClient) GetAllPosts(ctx context.Context, in *Posts, opts ...grpc.CallOption) (PostUseCase_GetAllPostsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &PostUseCase_ServiceDesc.Streams[0], "/model.PostUseCase/GetAllPosts", opts...)
+func (c *postUseCaseClient) GetAllPosts(ctx context.Context, in *Posts, opts ...grpc.CallOption) (*PostsView, error) {
+	out := new(PostsView)
+	err := c.cc.Invoke(ctx, "/model.PostUseCase/GetAllPosts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &postUseCaseGetAllPostsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type PostUseCase_GetAllPostsClient interface {
-	Recv() (*Post, error)
-	grpc.ClientStream
-}
-
-type postUseCaseGetAllPostsClient struct {
-	grpc.ClientStream
-}
-
-func (x *postUseCaseGetAllPostsClient) Recv() (*Post, error) {
-	m := new(Post)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+	return out, nil
 }
 
 func (c *postUseCaseClient) CreatePost(ctx context.Context, in *PostCreate, opts ...grpc.CallOption) (*PostView, error) {
@@ -110,7 +87,7 @@ func (c *postUseCaseClient) DeletePostById(ctx context.Context, in *PostById, op
 // for forward compatibility
 type PostUseCaseServer interface {
 	GetPostById(context.Context, *PostById) (*PostView, error)
-	GetAllPosts(*Posts, PostUseCase_GetAllPostsServer) error
+	GetAllPosts(context.Context, *Posts) (*PostsView, error)
 	CreatePost(context.Context, *PostCreate) (*PostView, error)
 	UpdatePostById(context.Context, *PostUpdate) (*PostView, error)
 	DeletePostById(context.Context, *PostById) (*PostDeleteView, error)
@@ -124,8 +101,8 @@ type UnimplementedPostUseCaseServer struct {
 func (UnimplementedPostUseCaseServer) GetPostById(context.Context, *PostById) (*PostView, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPostById not implemented")
 }
-func (UnimplementedPostUseCaseServer) GetAllPosts(*Posts, PostUseCase_GetAllPostsServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetAllPosts not implemented")
+func (UnimplementedPostUseCaseServer) GetAllPosts(context.Context, *Posts) (*PostsView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllPosts not implemented")
 }
 func (UnimplementedPostUseCaseServer) CreatePost(context.Context, *PostCreate) (*PostView, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
@@ -167,25 +144,22 @@ func _PostUseCase_GetPostById_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostUseCase_GetAllPosts_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(Posts)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _PostUseCase_GetAllPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Posts)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(PostUseCaseServer).GetAllPosts(m, &postUseCaseGetAllPostsServer{stream})
-}
-
-type PostUseCase_GetAllPostsServer interface {
-	Send(*Post) error
-	grpc.ServerStream
-}
-
-type postUseCaseGetAllPostsServer struct {
-	grpc.ServerStream
-}
-
-func (x *postUseCaseGetAllPostsServer) Send(m *Post) error {
-	return x.ServerStream.SendMsg(m)
+	if interceptor == nil {
+		return srv.(PostUseCaseServer).GetAllPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/model.PostUseCase/GetAllPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostUseCaseServer).GetAllPosts(ctx, req.(*Posts))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
 func _PostUseCase_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
@@ -253,6 +227,10 @@ var PostUseCase_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPostById",
 			Handler:    _PostUseCase_GetPostById_Handler,
 		},
+		{
+			MethodName: "GetAllPosts",
+			Handler:    _PostUseCase_GetAllPosts_Handler,
+		},
 		{
 			MethodName: "CreatePost",
 			Handler:    _PostUseCase_CreatePost_Handler,
@@ -266,12 +244,6 @@ var PostUseCase_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _PostUseCase_DeletePostById_Handler,
 		},
 	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "GetAllPosts",
-			Handler:       _PostUseCase_GetAllPosts_Handler,
-			ServerStreams: true,
-		},
-	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "post_usecase.proto",
 }
@@ -1,19 +1,94 @@
 package v1
 
 import (
+	"time"
+
 	pb "github.com/yachnytskyi/golang-mongo-grpc/internal/post/delivery/grpc/v1/model/pb"
-	post "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/usecase"
+	post "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/model"
+	usecase "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/usecase"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type PostGrpcServer struct {
 	pb.UnimplementedPostUseCaseServer
-	postUseCase post.PostUseCase
+	postUseCase usecase.PostUseCase
 }
 
-func NewGrpcPostServer(postUseCase post.PostUseCase) (*PostGrpcServer, error) {
+func NewGrpcPostServer(postUseCase usecase.PostUseCase) (*PostGrpcServer, error) {
 	postGrpcServer := &PostGrpcServer{
 		postUseCase: postUseCase,
 	}
 
 	return postGrpcServer, nil
 }
+
+// handleError converts a domain error into a gRPC status with the matching code.
+func handleError(err error) error {
+	switch err.(type) {
+	case domain.ValidationError, domain.ValidationErrors:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case domain.AuthorizationError:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case domain.ItemNotFoundError:
+		return status.Error(codes.NotFound, err.Error())
+	case domain.ConflictError:
+		return status.Error(codes.AlreadyExists, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func postsToPostsViewMapper(posts post.Posts) *pb.PostsView {
+	postsView := make([]*pb.Post, len(posts.Posts))
+	for index, post := range posts.Posts {
+		postsView[index] = postToProtobufMapper(post)
+	}
+
+	paginationResponse := posts.PaginationResponse
+	return &pb.PostsView{
+		Posts: postsView,
+		PaginationResponse: &pb.PaginationResponse{
+			CurrentPage: int64(paginationResponse.Page),
+			TotalPages:  int64(paginationResponse.TotalPages),
+			PagesLeft:   int64(paginationResponse.PagesLeft),
+			TotalItems:  int64(paginationResponse.TotalItems),
+			ItemsLeft:   int64(paginationResponse.ItemsLeft),
+			PageStart:   int64(paginationResponse.PageStart),
+			PageEnd:     int64(paginationResponse.PageEnd),
+			Limit:       int64(paginationResponse.Limit),
+			OrderBy:     paginationResponse.OrderBy,
+			SortOrder:   paginationResponse.SortOrder,
+		},
+	}
+}
+
+func postToPostViewMapper(post post.Post) *pb.PostView {
+	return &pb.PostView{
+		Post: postToProtobufMapper(post),
+	}
+}
+
+func postToProtobufMapper(post post.Post) *pb.Post {
+	return &pb.Post{
+		PostID:    post.ID,
+		UserID:    post.UserID,
+		User:      post.Username,
+		Title:     post.Title,
+		Content:   post.Content,
+		Image:     post.Image,
+		CreatedAt: timestamppb.New(post.CreatedAt),
+		UpdatedAt: timestamppb.New(post.UpdatedAt),
+	}
+}
+
+// timestampToTime converts an optional timestamp, a missing one becomes the zero time that doesn't filter.
+func timestampToTime(timestamp *timestamppb.Timestamp) time.Time {
+	if timestamp == nil {
+		return time.Time{}
+	}
+
+	return timestamp.AsTime()
+}
@@ -2,24 +2,20 @@ package v1
 
 import (
 	"context"
-	"strings"
 
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
 	postProtobufV1 "github.com/yachnytskyi/golang-mongo-grpc/internal/post/delivery/grpc/v1/model/pb"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
 )
 
 func (PostGrpcServer *PostGrpcServer) DeletePostById(ctx context.Context, postData *postProtobufV1.PostById) (*postProtobufV1.PostDeleteView, error) {
 	postID := postData.GetPostID()
 	userID := postData.GetUserID()
 
-	err := PostGrpcServer.postUseCase.DeletePostByID(ctx, postID, userID)
-	if err != nil {
-		if strings.Contains(err.Error(), "Id exists") {
-			return nil, status.Errorf(codes.NotFound, err.Error(), "error")
-		}
-
-		return nil, status.Errorf(codes.Internal, err.Error(), "error")
+	// The gRPC API has no authentication yet, so callers are only granted the default role.
+	deletePostError := PostGrpcServer.postUseCase.DeletePostByID(ctx, postID, userID, constants.UserRoleValue, nil)
+	if validator.IsError(deletePostError) {
+		return nil, handleError(deletePostError)
 	}
 
 	postDeleteView := &postProtobufV1.PostDeleteView{
@@ -1,37 +1,35 @@
 package v1
 
-// import (
-// 	"context"
+import (
+	"context"
+	"strconv"
 
-// 	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
-// 	postProtobufV1 "github.com/yachnytskyi/golang-mongo-grpc/internal/post/delivery/grpc/v1/model/pb"
-// 	"google.golang.org/grpc/codes"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	postProtobufV1 "github.com/yachnytskyi/golang-mongo-grpc/internal/post/delivery/grpc/v1/model/pb"
+	post "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/model"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
 
-// 	"google.golang.org/grpc/status"
-// 	"google.golang.org/protobuf/types/known/timestamppb"
-// )
+// GetAllPosts returns a page of the posts, the page links are left out since there are no URLs in gRPC.
+func (PostGrpcServer *PostGrpcServer) GetAllPosts(ctx context.Context, postsData *postProtobufV1.Posts) (*postProtobufV1.PostsView, error) {
+	page := constants.DefaultPage
+	if postsData.Page != nil {
+		page = strconv.FormatInt(postsData.GetPage(), 10)
+	}
+	limit := constants.DefaultLimit
+	if postsData.Limit != nil {
+		limit = strconv.FormatInt(postsData.GetLimit(), 10)
+	}
 
-// func (PostGrpcServer *PostGrpcServer) GetAllPosts(postsData *postProtobufV1.Posts, streamOfPosts postProtobufV1.PostUseCase_GetAllPostsServer) error {
-// 	ctx, cancel := context.WithTimeout(context.TODO(), constants.DefaultContextTimer)
-// 	defer cancel()
-// 	page := postsData.GetPage()
-// 	limit := postsData.GetLimit()
+	paginationQuery := common.NewPaginationQuery(page, limit, postsData.GetOrderBy(), postsData.GetSortOrder(), "")
+	postFilter := post.NewPostFilter(postsData.GetUserId(), "", timestampToTime(postsData.GetCreatedFrom()), timestampToTime(postsData.GetCreatedTo()))
 
-	// posts, err := PostGrpcServer.postUseCase.GetAllPosts(ctx, int(page), int(limit))
-	// if err != nil {
-	// 	return status.Errorf(codes.Internal, err.Error())
-	// }
+	// The gRPC API has no authentication yet, so callers are anonymous and only list the published posts.
+	fetchedPosts := PostGrpcServer.postUseCase.GetAllPosts(ctx, paginationQuery, postFilter, "")
+	if validator.IsError(fetchedPosts.Error) {
+		return nil, handleError(fetchedPosts.Error)
+	}
 
-	// for _, post := range posts.Posts {
-	// 	streamOfPosts.Send(&postProtobufV1.Post{
-	// 		PostID:    post.PostID,
-	// 		Title:     post.Title,
-	// 		Content:   post.Content,
-	// 		Image:     post.Image,
-	// 		CreatedAt: timestamppb.New(post.CreatedAt),
-	// 		UpdatedAt: timestamppb.New(post.UpdatedAt),
-	// 	})
-	// }
-
-	// return nil
-// }
+	return postsToPostsViewMapper(fetchedPosts.Data), nil
+}
@@ -2,38 +2,17 @@ package v1
 
 import (
 	"context"
-	"strings"
 
 	postProtobufV1 "github.com/yachnytskyi/golang-mongo-grpc/internal/post/delivery/grpc/v1/model/pb"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
 )
 
 func (PostGrpcServer *PostGrpcServer) GetPostById(ctx context.Context, postData *postProtobufV1.PostById) (*postProtobufV1.PostView, error) {
-	postID := postData.GetPostID()
-
-	post, err := PostGrpcServer.postUseCase.GetPostById(ctx, postID)
-
-	if err != nil {
-		if strings.Contains(err.Error(), "Id exists") {
-			return nil, status.Errorf(codes.NotFound, err.Error(), "error")
-		}
-
-		return nil, status.Errorf(codes.Internal, err.Error(), "error")
-	}
-
-	postView := &postProtobufV1.PostView{
-		Post: &postProtobufV1.Post{
-			PostID:    post.PostID,
-			UserID:    post.UserID,
-			Title:     post.Title,
-			Content:   post.Content,
-			Image:     post.Image,
-			CreatedAt: timestamppb.New(post.CreatedAt),
-			UpdatedAt: timestamppb.New(post.UpdatedAt),
-		},
+	// The gRPC API has no authentication yet, so callers are anonymous and only get the published posts.
+	fetchedPost := PostGrpcServer.postUseCase.GetPostById(ctx, postData.GetPostID(), "")
+	if validator.IsError(fetchedPost.Error) {
+		return nil, handleError(fetchedPost.Error)
 	}
 
-	return postView, nil
+	return postToPostViewMapper(fetchedPost.Data), nil
 }
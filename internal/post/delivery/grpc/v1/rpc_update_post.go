@@ -2,46 +2,28 @@ package v1
 
 import (
 	"context"
-	"strings"
 
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
 	postProtobufV1 "github.com/yachnytskyi/golang-mongo-grpc/internal/post/delivery/grpc/v1/model/pb"
 	post "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/model"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
 )
 
 func (postGrpcServer *PostGrpcServer) UpdatePostById(ctx context.Context, updatedPostData *postProtobufV1.PostUpdate) (*postProtobufV1.PostView, error) {
-	postID := updatedPostData.GetPostID()
 	userID := updatedPostData.GetUserID()
-
-	post := &post.PostUpdate{
-		Title:   updatedPostData.GetTitle(),
-		Content: updatedPostData.GetContent(),
-		Image:   updatedPostData.GetImage(),
-		UserID:  updatedPostData.GetUserID(),
-	}
-
-	createdPost, err := postGrpcServer.postUseCase.UpdatePostById(ctx, postID, post, userID)
-
-	if err != nil {
-		if strings.Contains(err.Error(), "Id exists") {
-			return nil, status.Errorf(codes.NotFound, err.Error(), "error")
-		}
-
-		return nil, status.Errorf(codes.Internal, err.Error(), "error")
-	}
-
-	postView := &postProtobufV1.PostView{
-		Post: &postProtobufV1.Post{
-			PostID:    createdPost.PostID,
-			UserID:    createdPost.UserID,
-			Title:     createdPost.Title,
-			Content:   createdPost.Content,
-			CreatedAt: timestamppb.New(createdPost.CreatedAt),
-			UpdatedAt: timestamppb.New(createdPost.UpdatedAt),
-		},
+	postUpdate := post.NewPostUpdate(
+		updatedPostData.GetPostID(),
+		userID,
+		updatedPostData.GetTitle(),
+		updatedPostData.GetContent(),
+		updatedPostData.GetImage(),
+	)
+
+	// The gRPC API has no authentication yet, so callers are only granted the default role.
+	updatedPost := postGrpcServer.postUseCase.UpdatePostById(ctx, postUpdate, userID, constants.UserRoleValue, nil)
+	if validator.IsError(updatedPost.Error) {
+		return nil, handleError(updatedPost.Error)
 	}
 
-	return postView, nil
+	return postToPostViewMapper(updatedPost.Data), nil
 }
@@ -3,131 +3,212 @@ package gin
 import (
 	"context"
 	"net/http"
-	"strconv"
-	"strings"
+	"path"
 
 	"github.com/gin-gonic/gin"
 	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
 	view "github.com/yachnytskyi/golang-mongo-grpc/internal/post/delivery/model"
-	post "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/model"
 	useCase "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/usecase"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/delivery/http"
+	delivery "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/delivery/http"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/delivery/http/gin/common"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	location = "internal.post.delivery.http.gin."
 )
 
 type PostController struct {
-	postUseCase useCase.PostUseCase
+	Config      *config.ApplicationConfig
+	Logger      interfaces.Logger
+	PostUseCase useCase.PostUseCase
 }
 
-func NewPostController(postUseCase useCase.PostUseCase) PostController {
+func NewPostController(config *config.ApplicationConfig, logger interfaces.Logger, postUseCase useCase.PostUseCase) PostController {
 	return PostController{
-		postUseCase: postUseCase,
+		Config:      config,
+		Logger:      logger,
+		PostUseCase: postUseCase,
 	}
 }
 
+// GetAllPosts lists the published posts, authenticated authors may list their own posts with another status.
 func (postController PostController) GetAllPosts(controllerContext any) {
 	ginContext := controllerContext.(*gin.Context)
 	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
 	defer cancel()
-	page := ginContext.DefaultQuery("page", "1")
-	limit := ginContext.DefaultQuery("limit", "10")
 
-	intPage, err := strconv.Atoi(page)
+	currentUserID, _ := ctx.Value(constants.ID).(string)
+	var postFilterViewData view.PostFilterView
+	shouldBindQuery := ginContext.ShouldBindQuery(&postFilterViewData)
+	if validator.IsError(shouldBindQuery) {
+		common.HandleJSONBindingError(ginContext, postController.Logger, location+"GetAllPosts", shouldBindQuery)
+		return
+	}
 
-	if err != nil {
-		ginContext.JSON(http.StatusBadGateway, gin.H{"status": "fail", "message": err.Error()})
+	paginationQuery := common.ParsePaginationQuery(ginContext)
+	postFilterData := view.PostFilterViewToPostFilterMapper(postFilterViewData)
+	fetchedPosts := postController.PostUseCase.GetAllPosts(ctx, paginationQuery, postFilterData, currentUserID)
+	if validator.IsError(fetchedPosts.Error) {
+		abortWithError(ginContext, fetchedPosts.Error)
 		return
 	}
 
-	intLimit, err := strconv.Atoi(limit)
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.PostsToPostsViewMapper(fetchedPosts.Data)))
+}
+
+func (postController PostController) GetPostById(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
 
-	if err != nil {
-		ginContext.JSON(http.StatusBadGateway, gin.H{"status": "fail", "message": err.Error()})
+	postID := ginContext.Param(constants.ItemIdParam)
+	currentUserID, _ := ctx.Value(constants.ID).(string)
+	fetchedPost := postController.PostUseCase.GetPostById(ctx, postID, currentUserID)
+	if validator.IsError(fetchedPost.Error) {
+		abortWithError(ginContext, fetchedPost.Error)
 		return
 	}
 
-	fetchedPosts, err := postController.postUseCase.GetAllPosts(ctx, intPage, intLimit)
-	if err != nil {
-		ginContext.JSON(http.StatusBadGateway, gin.H{"status": "fail", "message": err.Error()})
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.PostToPostViewMapper(fetchedPost.Data)))
+}
+
+// GetPostBySlug responds with the post of the permalink, a permalink with a previous slug of the post
+// or with the slug in another case is redirected permanently to the permalink with the current slug.
+func (postController PostController) GetPostBySlug(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	slug := ginContext.Param(constants.SlugParam)
+	currentUserID, _ := ctx.Value(constants.ID).(string)
+	fetchedPost := postController.PostUseCase.GetPostBySlug(ctx, slug, currentUserID)
+	if validator.IsError(fetchedPost.Error) {
+		abortWithError(ginContext, fetchedPost.Error)
 		return
 	}
-	ginContext.JSON(http.StatusOK, view.PostsToPostsViewMapper(fetchedPosts))
+	if fetchedPost.Data.Slug != slug {
+		ginContext.Redirect(http.StatusMovedPermanently, path.Join(path.Dir(ginContext.Request.URL.Path), fetchedPost.Data.Slug))
+		return
+	}
+
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.PostToPostViewMapper(fetchedPost.Data)))
 }
 
-func (postController PostController) GetPostById(controllerContext any) {
+func (postController PostController) CreatePost(controllerContext any) {
 	ginContext := controllerContext.(*gin.Context)
 	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
 	defer cancel()
-	postID := ginContext.Param("postID")
 
-	fetchedPost, err := postController.postUseCase.GetPostById(ctx, postID)
+	currentUserID := ctx.Value(constants.ID).(string)
+	var postCreateViewData view.PostCreateView
+	shouldBindJSON := ginContext.ShouldBindJSON(&postCreateViewData)
+	if validator.IsError(shouldBindJSON) {
+		common.HandleJSONBindingError(ginContext, postController.Logger, location+"CreatePost", shouldBindJSON)
+		return
+	}
 
-	if err != nil {
-		if strings.Contains(err.Error(), "Id exists") {
-			ginContext.JSON(http.StatusNotFound, gin.H{"status": "fail", "message": err.Error()})
-			return
-		}
-		ginContext.JSON(http.StatusBadGateway, gin.H{"status": "fail", "message": err.Error()})
+	postCreateData := view.PostCreateViewToPostCreateMapper(currentUserID, postCreateViewData)
+	createdPost := postController.PostUseCase.CreatePost(ctx, postCreateData)
+	if validator.IsError(createdPost.Error) {
+		abortWithError(ginContext, createdPost.Error)
 		return
 	}
 
-	ginContext.JSON(http.StatusOK, gin.H{"status": "success", "data": view.PostToPostViewMapper(fetchedPost)})
+	ginContext.JSON(http.StatusCreated, model.NewJSONResponseOnSuccess(view.PostToPostViewMapper(createdPost.Data)))
 }
 
-func (postController PostController) CreatePost(controllerContext any) {
+func (postController PostController) UpdatePostById(controllerContext any) {
 	ginContext := controllerContext.(*gin.Context)
 	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
 	defer cancel()
 
-	var createdPostData *post.PostCreate = new(post.PostCreate)
-	createdPostData.UserID = ctx.Value(constants.ID).(string)
-	err := ginContext.ShouldBindJSON(&createdPostData)
-	if err != nil {
-		ginContext.JSON(http.StatusBadRequest, err.Error())
+	postID := ginContext.Param(constants.ItemIdParam)
+	currentUserID := ctx.Value(constants.ID).(string)
+	currentUserRole := ctx.Value(constants.UserRole).(string)
+	currentUserScopes, _ := ctx.Value(constants.Scopes).([]string)
+	var postUpdateViewData view.PostUpdateView
+	shouldBindJSON := ginContext.ShouldBindJSON(&postUpdateViewData)
+	if validator.IsError(shouldBindJSON) {
+		common.HandleJSONBindingError(ginContext, postController.Logger, location+"UpdatePostById", shouldBindJSON)
+		return
+	}
+
+	postUpdateData := view.PostUpdateViewToPostUpdateMapper(postID, currentUserID, postUpdateViewData)
+	updatedPost := postController.PostUseCase.UpdatePostById(ctx, postUpdateData, currentUserID, currentUserRole, currentUserScopes)
+	if validator.IsError(updatedPost.Error) {
+		abortWithError(ginContext, updatedPost.Error)
+		return
 	}
 
-	createdPost, err := postController.postUseCase.CreatePost(ctx, createdPostData)
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.PostToPostViewMapper(updatedPost.Data)))
+}
+
+// PublishPost publishes the post, or schedules it for the publish time of the optional request body.
+func (postController PostController) PublishPost(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
 
-	if err != nil {
-		if strings.Contains(err.Error(), "sorry, but this title already exists. Please choose another one") {
-			ginContext.JSON(http.StatusConflict, gin.H{"status": "fail", "message": err.Error()})
+	postID := ginContext.Param(constants.ItemIdParam)
+	currentUserID := ctx.Value(constants.ID).(string)
+	currentUserRole := ctx.Value(constants.UserRole).(string)
+	currentUserScopes, _ := ctx.Value(constants.Scopes).([]string)
+	var postPublishViewData view.PostPublishView
+	if ginContext.Request.ContentLength != 0 {
+		shouldBindJSON := ginContext.ShouldBindJSON(&postPublishViewData)
+		if validator.IsError(shouldBindJSON) {
+			common.HandleJSONBindingError(ginContext, postController.Logger, location+"PublishPost", shouldBindJSON)
 			return
 		}
-		ginContext.JSON(http.StatusBadGateway, gin.H{"status": "fail", "message": err.Error()})
+	}
+
+	publishedPost := postController.PostUseCase.PublishPost(ctx, postID, postPublishViewData.PublishAt, currentUserID, currentUserRole, currentUserScopes)
+	if validator.IsError(publishedPost.Error) {
+		abortWithError(ginContext, publishedPost.Error)
 		return
 	}
 
-	ginContext.JSON(http.StatusCreated, gin.H{"status": "success", "data": createdPost})
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.PostToPostViewMapper(publishedPost.Data)))
 }
 
-func (postController PostController) UpdatePostById(controllerContext any) {
+func (postController PostController) UnpublishPost(controllerContext any) {
 	ginContext := controllerContext.(*gin.Context)
 	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
 	defer cancel()
-	postID := ginContext.Param("postID")
-	currentUserID := ctx.Value(constants.ID).(string)
 
-	var updatedPostData *post.PostUpdate = new(post.PostUpdate)
-	updatedPostData.PostID = ginContext.Param("postID")
-	updatedPostData.UserID = currentUserID
-	err := ginContext.ShouldBindJSON(&updatedPostData)
-	if err != nil {
-		ginContext.JSON(http.StatusBadGateway, gin.H{"status": "fail", "message": err.Error()})
+	postID := ginContext.Param(constants.ItemIdParam)
+	currentUserID := ctx.Value(constants.ID).(string)
+	currentUserRole := ctx.Value(constants.UserRole).(string)
+	currentUserScopes, _ := ctx.Value(constants.Scopes).([]string)
+	unpublishedPost := postController.PostUseCase.UnpublishPost(ctx, postID, currentUserID, currentUserRole, currentUserScopes)
+	if validator.IsError(unpublishedPost.Error) {
+		abortWithError(ginContext, unpublishedPost.Error)
 		return
 	}
 
-	updatedPost, err := postController.postUseCase.UpdatePostById(ctx, postID, updatedPostData, currentUserID)
-	if err != nil {
-		if strings.Contains(err.Error(), "Id exists") {
-			ginContext.JSON(http.StatusNotFound, gin.H{"status": "fail", "message": err.Error()})
-			return
-		}
-		if strings.Contains(err.Error(), "sorry, but you do not have permissions to do that") {
-			ginContext.JSON(http.StatusUnauthorized, gin.H{"status": "fail", "message": err.Error()})
-			return
-		}
-		ginContext.JSON(http.StatusBadGateway, gin.H{"status": "fail", "message": err.Error()})
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.PostToPostViewMapper(unpublishedPost.Data)))
+}
+
+func (postController PostController) ArchivePost(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	postID := ginContext.Param(constants.ItemIdParam)
+	currentUserID := ctx.Value(constants.ID).(string)
+	currentUserRole := ctx.Value(constants.UserRole).(string)
+	currentUserScopes, _ := ctx.Value(constants.Scopes).([]string)
+	archivedPost := postController.PostUseCase.ArchivePost(ctx, postID, currentUserID, currentUserRole, currentUserScopes)
+	if validator.IsError(archivedPost.Error) {
+		abortWithError(ginContext, archivedPost.Error)
 		return
 	}
-	ginContext.JSON(http.StatusOK, gin.H{"status": "success", "data": updatedPost})
+
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.PostToPostViewMapper(archivedPost.Data)))
 }
 
 func (postController PostController) DeletePostByID(controllerContext any) {
@@ -135,21 +216,22 @@ func (postController PostController) DeletePostByID(controllerContext any) {
 	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
 	defer cancel()
 
-	postID := ginContext.Param("postID")
+	postID := ginContext.Param(constants.ItemIdParam)
 	currentUserID := ctx.Value(constants.ID).(string)
-	err := postController.postUseCase.DeletePostByID(ctx, postID, currentUserID)
-
-	if err != nil {
-		if strings.Contains(err.Error(), "Id exists") {
-			ginContext.JSON(http.StatusNotFound, gin.H{"status": "fail", "message": err.Error()})
-			return
-		}
-		if strings.Contains(err.Error(), "sorry, but you do not have permissions to do that") {
-			ginContext.JSON(http.StatusUnauthorized, gin.H{"status": "fail", "message": err.Error()})
-			return
-		}
-		ginContext.JSON(http.StatusBadGateway, gin.H{"status": "fail", "message": err.Error()})
+	currentUserRole := ctx.Value(constants.UserRole).(string)
+	currentUserScopes, _ := ctx.Value(constants.Scopes).([]string)
+	deletePostError := postController.PostUseCase.DeletePostByID(ctx, postID, currentUserID, currentUserRole, currentUserScopes)
+	if validator.IsError(deletePostError) {
+		abortWithError(ginContext, deletePostError)
 		return
 	}
-	ginContext.JSON(http.StatusNoContent, nil)
+
+	ginContext.Status(http.StatusNoContent)
+}
+
+// abortWithError responds with the error and the status code of its kind,
+// like 404 Not Found for missing posts and 409 Conflict for taken titles.
+func abortWithError(ginContext *gin.Context, err error) {
+	httpError := delivery.HandleError(err)
+	ginContext.JSON(delivery.StatusCode(httpError), model.NewJSONResponseOnFailure(httpError))
 }
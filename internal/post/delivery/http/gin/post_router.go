@@ -4,43 +4,63 @@ import (
 	"github.com/gin-gonic/gin"
 
 	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	domainUtility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
 	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
 	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
 	middleware "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/delivery/http/gin/middleware"
 )
 
 type PostRouter struct {
-	Config         *config.ApplicationConfig
-	Logger         interfaces.Logger
-	PostController interfaces.PostController
+	Config           *config.ApplicationConfig
+	Logger           interfaces.Logger
+	KeyRings         domainUtility.KeyRings
+	PostController   interfaces.PostController
+	SessionValidator interfaces.SessionValidator
 }
 
-func NewPostRouter(config *config.ApplicationConfig, logger interfaces.Logger, postController interfaces.PostController) interfaces.Router {
+func NewPostRouter(config *config.ApplicationConfig, logger interfaces.Logger, keyRings domainUtility.KeyRings, postController interfaces.PostController, sessionValidator interfaces.SessionValidator) interfaces.Router {
 	return PostRouter{
-		Config:         config,
-		Logger:         logger,
-		PostController: postController,
+		Config:           config,
+		Logger:           logger,
+		KeyRings:         keyRings,
+		PostController:   postController,
+		SessionValidator: sessionValidator,
 	}
 }
 
 func (postRouter PostRouter) Router(routerGroup any) {
 	ginRouterGroup := routerGroup.(*gin.RouterGroup)
 	router := ginRouterGroup.Group(constants.PostsGroupPath)
-	router.GET("/", func(ginContext *gin.Context) {
+
+	// Public routes authenticate the user only when a token is sent, so the authors can read their posts that are not published.
+	publicRouter := router.Group("", middleware.OptionalAuthenticationMiddleware(postRouter.Config, postRouter.Logger, postRouter.KeyRings.AccessToken, postRouter.SessionValidator))
+	publicRouter.GET(constants.GetAllItemsURL, func(ginContext *gin.Context) {
 		postRouter.PostController.GetAllPosts(ginContext)
 	})
-	router.GET("/:postID", func(ginContext *gin.Context) {
+	publicRouter.GET(constants.GetItemByIdURL, func(ginContext *gin.Context) {
 		postRouter.PostController.GetPostById(ginContext)
 	})
+	publicRouter.GET(constants.GetPostBySlugPath, func(ginContext *gin.Context) {
+		postRouter.PostController.GetPostBySlug(ginContext)
+	})
 
-	router.Use(middleware.AuthenticationMiddleware(postRouter.Config, postRouter.Logger))
-	router.POST("/", func(ginContext *gin.Context) {
+	router.Use(middleware.AuthenticationMiddleware(postRouter.Config, postRouter.Logger, postRouter.KeyRings.AccessToken, postRouter.SessionValidator))
+	router.POST(constants.GetAllItemsURL, middleware.RequirePermission(postRouter.Logger, constants.PostCreatePermission), func(ginContext *gin.Context) {
 		postRouter.PostController.CreatePost(ginContext)
 	})
-	router.PUT("/:postID", func(ginContext *gin.Context) {
+	router.PUT(constants.GetItemByIdURL, middleware.RequirePermission(postRouter.Logger, constants.PostUpdateOwnPermission), func(ginContext *gin.Context) {
 		postRouter.PostController.UpdatePostById(ginContext)
 	})
-	router.DELETE("/:postID", func(ginContext *gin.Context) {
+	router.POST(constants.PublishPostPath, middleware.RequirePermission(postRouter.Logger, constants.PostUpdateOwnPermission), func(ginContext *gin.Context) {
+		postRouter.PostController.PublishPost(ginContext)
+	})
+	router.POST(constants.UnpublishPostPath, middleware.RequirePermission(postRouter.Logger, constants.PostUpdateOwnPermission), func(ginContext *gin.Context) {
+		postRouter.PostController.UnpublishPost(ginContext)
+	})
+	router.POST(constants.ArchivePostPath, middleware.RequirePermission(postRouter.Logger, constants.PostUpdateOwnPermission), func(ginContext *gin.Context) {
+		postRouter.PostController.ArchivePost(ginContext)
+	})
+	router.DELETE(constants.GetItemByIdURL, middleware.RequirePermission(postRouter.Logger, constants.PostDeleteOwnPermission), func(ginContext *gin.Context) {
 		postRouter.PostController.DeletePostByID(ginContext)
 	})
 }
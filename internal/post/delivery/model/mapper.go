@@ -1,38 +1,69 @@
 package model
 
 import (
-	"github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/model"
+	"time"
+
+	post "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/model"
+	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/delivery/http"
 )
 
-func PostsToPostsViewMapper(posts *model.Posts) PostsView {
-	postsView := make([]*PostView, 0, 10)
-	for _, post := range posts.Posts {
-		postView := &PostView{}
-		postView.PostID = post.PostID
-		postView.UserID = post.UserID
-		postView.Title = post.Title
-		postView.Content = post.Content
-		postView.Image = post.Image
-		postView.Username = post.Username
-		postView.CreatedAt = post.CreatedAt
-		postView.UpdatedAt = post.UpdatedAt
-		postsView = append(postsView, postView)
-	}
+func PostFilterViewToPostFilterMapper(postFilterView PostFilterView) post.PostFilter {
+	return post.NewPostFilter(
+		postFilterView.UserID,
+		postFilterView.Status,
+		postFilterView.CreatedFrom,
+		postFilterView.CreatedTo,
+	)
+}
+
+func PostCreateViewToPostCreateMapper(userID string, postCreateView PostCreateView) post.PostCreate {
+	return post.NewPostCreate(
+		userID,
+		postCreateView.Title,
+		postCreateView.Content,
+		postCreateView.Image,
+	)
+}
 
-	return PostsView{
-		PostsView: postsView,
+func PostUpdateViewToPostUpdateMapper(postID, userID string, postUpdateView PostUpdateView) post.PostUpdate {
+	return post.NewPostUpdate(
+		postID,
+		userID,
+		postUpdateView.Title,
+		postUpdateView.Content,
+		postUpdateView.Image,
+	)
+}
+
+func PostsToPostsViewMapper(posts post.Posts) PostsView {
+	postsView := make([]PostView, len(posts.Posts))
+	for index, post := range posts.Posts {
+		postsView[index] = PostToPostViewMapper(post)
 	}
+
+	return NewPostsView(
+		postsView,
+		model.NewHTTPPaginationResponse(posts.PaginationResponse),
+	)
 }
 
-func PostToPostViewMapper(post *model.Post) PostView {
-	return PostView{
-		PostID:    post.PostID,
-		UserID:    post.UserID,
-		Title:     post.Title,
-		Content:   post.Content,
-		Image:     post.Image,
-		Username:  post.Username,
-		CreatedAt: post.CreatedAt,
-		UpdatedAt: post.UpdatedAt,
+func PostToPostViewMapper(post post.Post) PostView {
+	var publishAt *time.Time
+	if !post.PublishAt.IsZero() {
+		publishAt = &post.PublishAt
 	}
+
+	return NewPostView(
+		post.ID,
+		post.UserID,
+		post.Username,
+		post.Title,
+		post.Slug,
+		post.Content,
+		post.Image,
+		post.Status,
+		publishAt,
+		post.CreatedAt,
+		post.UpdatedAt,
+	)
 }
@@ -1,20 +1,69 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/delivery/http"
+)
 
-// [GET].
 type PostsView struct {
-	PostsView []*PostView `json:"posts"`
+	PostsView              []PostView                   `json:"posts"`
+	HTTPPaginationResponse model.HTTPPaginationResponse `json:"pagination_response"`
 }
 
-// [GET].
 type PostView struct {
-	PostID    string    `json:"post_id"`
-	UserID    string    `json:"user_id"`
-	Title     string    `json:"title"`
-	Content   string    `json:"content"`
-	Image     string    `json:"image,omitempty"`
-	Username  string    `json:"username"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	model.BaseEntity
+	UserID    string     `json:"user_id"`
+	Username  string     `json:"username"`
+	Title     string     `json:"title"`
+	Slug      string     `json:"slug"`
+	Content   string     `json:"content"`
+	Image     string     `json:"image,omitempty"`
+	Status    string     `json:"status"`
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+}
+
+type PostFilterView struct {
+	UserID      string    `form:"user_id"`
+	Status      string    `form:"status"`
+	CreatedFrom time.Time `form:"created_from" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedTo   time.Time `form:"created_to" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+type PostCreateView struct {
+	Title   string `json:"title"`
+	Content string `json:"content"`
+	Image   string `json:"image"`
+}
+
+type PostUpdateView struct {
+	Title   string `json:"title"`
+	Content string `json:"content"`
+	Image   string `json:"image"`
+}
+
+// PostPublishView schedules the post for the publish time, without one the post is published right away.
+type PostPublishView struct {
+	PublishAt time.Time `json:"publish_at"`
+}
+
+func NewPostsView(posts []PostView, paginationResponse model.HTTPPaginationResponse) PostsView {
+	return PostsView{
+		PostsView:              posts,
+		HTTPPaginationResponse: paginationResponse,
+	}
+}
+
+func NewPostView(id, userID, username, title, slug, content, image, status string, publishAt *time.Time, createdAt, updatedAt time.Time) PostView {
+	return PostView{
+		BaseEntity: model.NewBaseEntity(id, createdAt, updatedAt),
+		UserID:     userID,
+		Username:   username,
+		Title:      title,
+		Slug:       slug,
+		Content:    content,
+		Image:      image,
+		Status:     status,
+		PublishAt:  publishAt,
+	}
 }
@@ -1,36 +1,118 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/domain"
+)
 
 type Posts struct {
-	Posts []*Post
+	Posts              []Post
+	PaginationResponse common.PaginationResponse
 }
 
+// Post is visible to everyone only once it is published, PublishAt holds the time it was or is going to be published.
 type Post struct {
-	PostID    string
+	model.BaseEntity
 	UserID    string
+	Username  string
 	Title     string
+	Slug      string
 	Content   string
 	Image     string
-	Username  string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	Status    string
+	PublishAt time.Time
 }
 
+// PostFilter narrows the listed posts down to an author, a status and a creation range, empty fields don't filter.
+type PostFilter struct {
+	UserID      string
+	Status      string
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+}
+
+// PostCreate holds the slug derived from the title, the repository adds a suffix when it is taken.
 type PostCreate struct {
-	UserID    string
-	Title     string
-	Content   string
-	Image     string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	UserID  string
+	Title   string
+	Slug    string
+	Content string
+	Image   string
 }
 
 type PostUpdate struct {
-	PostID    string
-	UserID    string
-	Title     string
-	Content   string
-	Image     string
-	UpdatedAt time.Time
+	ID      string
+	UserID  string
+	Title   string
+	Slug    string
+	Content string
+	Image   string
+}
+
+// PostStatusUpdate moves the post to the status, as long as its current status is one of the previous statuses.
+type PostStatusUpdate struct {
+	ID               string
+	Status           string
+	PublishAt        time.Time
+	PreviousStatuses []string
+}
+
+func NewPosts(posts []Post, paginationResponse common.PaginationResponse) Posts {
+	return Posts{
+		Posts:              posts,
+		PaginationResponse: paginationResponse,
+	}
+}
+
+func NewPost(id, userID, username, title, slug, content, image, status string, publishAt, createdAt, updatedAt time.Time) Post {
+	return Post{
+		BaseEntity: model.NewBaseEntity(id, createdAt, updatedAt),
+		UserID:     userID,
+		Username:   username,
+		Title:      title,
+		Slug:       slug,
+		Content:    content,
+		Image:      image,
+		Status:     status,
+		PublishAt:  publishAt,
+	}
+}
+
+func NewPostFilter(userID, status string, createdFrom, createdTo time.Time) PostFilter {
+	return PostFilter{
+		UserID:      userID,
+		Status:      status,
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
+	}
+}
+
+func NewPostCreate(userID, title, content, image string) PostCreate {
+	return PostCreate{
+		UserID:  userID,
+		Title:   title,
+		Content: content,
+		Image:   image,
+	}
+}
+
+func NewPostUpdate(id, userID, title, content, image string) PostUpdate {
+	return PostUpdate{
+		ID:      id,
+		UserID:  userID,
+		Title:   title,
+		Content: content,
+		Image:   image,
+	}
+}
+
+func NewPostStatusUpdate(id, status string, publishAt time.Time, previousStatuses []string) PostStatusUpdate {
+	return PostStatusUpdate{
+		ID:               id,
+		Status:           status,
+		PublishAt:        publishAt,
+		PreviousStatuses: previousStatuses,
+	}
 }
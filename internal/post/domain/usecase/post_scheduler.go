@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+)
+
+const (
+	postSchedulerInterval = time.Minute
+	postSchedulerStarted  = "Post scheduler is started..."
+	postSchedulerStopped  = "Post scheduler has been successfully stopped..."
+)
+
+// PostScheduler publishes the scheduled posts whose publish time has come in a background goroutine,
+// once when it is started and then every interval until it is closed.
+type PostScheduler struct {
+	Logger      interfaces.Logger
+	PostUseCase PostUseCase
+	cancel      context.CancelFunc
+	done        chan struct{}
+}
+
+func NewPostScheduler(logger interfaces.Logger, postUseCase PostUseCase) *PostScheduler {
+	return &PostScheduler{
+		Logger:      logger,
+		PostUseCase: postUseCase,
+	}
+}
+
+func (postScheduler *PostScheduler) Start(ctx context.Context) {
+	schedulerContext, cancel := context.WithCancel(ctx)
+	postScheduler.cancel = cancel
+	postScheduler.done = make(chan struct{})
+
+	go func() {
+		defer close(postScheduler.done)
+		ticker := time.NewTicker(postSchedulerInterval)
+		defer ticker.Stop()
+
+		for {
+			postScheduler.publishScheduledPosts(schedulerContext)
+			select {
+			case <-schedulerContext.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	postScheduler.Logger.Info(domain.NewInfoMessage(location+"PostScheduler.Start", postSchedulerStarted))
+}
+
+// Close stops the scheduler and waits for a run in progress, which is cancelled too, at most until the context is done.
+func (postScheduler *PostScheduler) Close(ctx context.Context) {
+	if postScheduler.cancel == nil {
+		return
+	}
+
+	postScheduler.cancel()
+	select {
+	case <-postScheduler.done:
+	case <-ctx.Done():
+	}
+
+	postScheduler.Logger.Info(domain.NewInfoMessage(location+"PostScheduler.Close", postSchedulerStopped))
+}
+
+// publishScheduledPosts runs a single round, a failed round is only logged and retried on the next tick.
+func (postScheduler *PostScheduler) publishScheduledPosts(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DefaultContextTimer)
+	defer cancel()
+
+	postScheduler.PostUseCase.PublishScheduledPosts(ctx)
+}
@@ -2,17 +2,36 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	post "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/model"
 	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
-	model "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/model"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
 	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	commonUtility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/common"
+	policy "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/policy"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
 )
 
 const (
 	location = "internal.post.domain.usecase."
+
+	postNotFound             = "There is no post with the ID."
+	unpublishedPostsOfAuthor = "Sorry, only the author can list the posts that are not published."
+	scheduledPostsPublished  = "Scheduled posts have been published: %d."
 )
 
+// postStatusSources lists the statuses a post can be moved from into each status,
+// a scheduled post can be published right away or rescheduled.
+var postStatusSources = map[string][]string{
+	constants.PostStatusPublished: {constants.PostStatusDraft, constants.PostStatusScheduled, constants.PostStatusArchived},
+	constants.PostStatusScheduled: {constants.PostStatusDraft, constants.PostStatusScheduled, constants.PostStatusArchived},
+	constants.PostStatusDraft:     {constants.PostStatusPublished, constants.PostStatusScheduled, constants.PostStatusArchived},
+	constants.PostStatusArchived:  {constants.PostStatusDraft, constants.PostStatusScheduled, constants.PostStatusPublished},
+}
+
 type PostUseCase struct {
 	Logger         interfaces.Logger
 	PostRepository interfaces.PostRepository
@@ -25,49 +44,190 @@ func NewPostUseCase(logger interfaces.Logger, postRepository interfaces.PostRepo
 	}
 }
 
-func (postUseCase PostUseCase) GetAllPosts(ctx context.Context, page int, limit int) (*model.Posts, error) {
-	fetchedPosts, err := postUseCase.PostRepository.GetAllPosts(ctx, page, limit)
-	return fetchedPosts, err
+// GetAllPosts lists the published posts unless the filter asks for another status,
+// which only the author may list their own posts with.
+func (postUseCase PostUseCase) GetAllPosts(ctx context.Context, paginationQuery common.PaginationQuery, postFilterData post.PostFilter, currentUserID string) common.Result[post.Posts] {
+	postFilter := validatePostFilter(postUseCase.Logger, postFilterData)
+	if validator.IsError(postFilter.Error) {
+		return common.NewResultOnFailure[post.Posts](domain.HandleError(postFilter.Error))
+	}
+	if validator.IsValueEmpty(postFilter.Data.Status) {
+		postFilter.Data.Status = constants.PostStatusPublished
+	}
+	if postFilter.Data.Status != constants.PostStatusPublished && (validator.IsValueEmpty(currentUserID) || postFilter.Data.UserID != currentUserID) {
+		authorizationError := domain.NewAuthorizationError(location+"GetAllPosts.Status", unpublishedPostsOfAuthor)
+		postUseCase.Logger.Debug(authorizationError)
+		return common.NewResultOnFailure[post.Posts](domain.HandleError(authorizationError))
+	}
+
+	fetchedPosts := postUseCase.PostRepository.GetAllPosts(ctx, setPostOrderBy(paginationQuery), postFilter.Data)
+	if validator.IsError(fetchedPosts.Error) {
+		return common.NewResultOnFailure[post.Posts](domain.HandleError(fetchedPosts.Error))
+	}
+
+	return fetchedPosts
+}
+
+// GetPostById returns the post if it is published or the current user is its author.
+func (postUseCase PostUseCase) GetPostById(ctx context.Context, postID, currentUserID string) common.Result[post.Post] {
+	fetchedPost := postUseCase.PostRepository.GetPostById(ctx, postID)
+	if validator.IsError(fetchedPost.Error) {
+		return common.NewResultOnFailure[post.Post](domain.HandleError(fetchedPost.Error))
+	}
+
+	checkPostVisibilityError := postUseCase.checkPostVisibility(location+"GetPostById", fetchedPost.Data, currentUserID)
+	if validator.IsError(checkPostVisibilityError) {
+		return common.NewResultOnFailure[post.Post](domain.HandleError(checkPostVisibilityError))
+	}
+
+	return fetchedPost
+}
+
+// GetPostBySlug returns the post with the slug, which may also be one of the previous slugs of the post.
+// The slug is lowercased first, so the permalink works with any case of the slug.
+// Like by the ID, a post that is not published is only returned to its author.
+func (postUseCase PostUseCase) GetPostBySlug(ctx context.Context, slug, currentUserID string) common.Result[post.Post] {
+	lowercaseSlug := commonUtility.SanitizeAndToLowerString(slug)
+	checkSlugError := checkSlug(postUseCase.Logger, location+"GetPostBySlug", lowercaseSlug)
+	if validator.IsError(checkSlugError) {
+		return common.NewResultOnFailure[post.Post](domain.HandleError(checkSlugError))
+	}
+
+	fetchedPost := postUseCase.PostRepository.GetPostBySlug(ctx, lowercaseSlug)
+	if validator.IsError(fetchedPost.Error) {
+		return common.NewResultOnFailure[post.Post](domain.HandleError(fetchedPost.Error))
+	}
+
+	checkPostVisibilityError := postUseCase.checkPostVisibility(location+"GetPostBySlug", fetchedPost.Data, currentUserID)
+	if validator.IsError(checkPostVisibilityError) {
+		return common.NewResultOnFailure[post.Post](domain.HandleError(checkPostVisibilityError))
+	}
+
+	return fetchedPost
+}
+
+// CreatePost creates the post as a draft, it is published with PublishPost.
+func (postUseCase PostUseCase) CreatePost(ctx context.Context, postCreateData post.PostCreate) common.Result[post.Post] {
+	postCreate := validatePostCreate(postUseCase.Logger, postCreateData)
+	if validator.IsError(postCreate.Error) {
+		return common.NewResultOnFailure[post.Post](domain.HandleError(postCreate.Error))
+	}
+
+	createdPost := postUseCase.PostRepository.CreatePost(ctx, postCreate.Data)
+	if validator.IsError(createdPost.Error) {
+		return common.NewResultOnFailure[post.Post](domain.HandleError(createdPost.Error))
+	}
+
+	return createdPost
+}
+
+// UpdatePostById updates the post if the current user owns it or may update any post.
+func (postUseCase PostUseCase) UpdatePostById(ctx context.Context, postUpdateData post.PostUpdate, currentUserID, currentUserRole string, currentUserScopes []string) common.Result[post.Post] {
+	postUpdate := validatePostUpdate(postUseCase.Logger, postUpdateData)
+	if validator.IsError(postUpdate.Error) {
+		return common.NewResultOnFailure[post.Post](domain.HandleError(postUpdate.Error))
+	}
+
+	checkPostPermissionError := postUseCase.checkPostPermission(ctx, location+"UpdatePostById", postUpdate.Data.ID, currentUserID, currentUserRole, currentUserScopes, constants.PostUpdateOwnPermission, constants.PostUpdateAnyPermission)
+	if validator.IsError(checkPostPermissionError) {
+		return common.NewResultOnFailure[post.Post](domain.HandleError(checkPostPermissionError))
+	}
+
+	updatedPost := postUseCase.PostRepository.UpdatePostById(ctx, postUpdate.Data)
+	if validator.IsError(updatedPost.Error) {
+		return common.NewResultOnFailure[post.Post](domain.HandleError(updatedPost.Error))
+	}
+
+	return updatedPost
+}
+
+// PublishPost publishes the post right away, or schedules it when the publish time is in the future,
+// if the current user owns it or may update any post. A draft, scheduled or archived post can be published.
+func (postUseCase PostUseCase) PublishPost(ctx context.Context, postID string, publishAt time.Time, currentUserID, currentUserRole string, currentUserScopes []string) common.Result[post.Post] {
+	now := time.Now()
+	status := constants.PostStatusScheduled
+	if !publishAt.After(now) {
+		status = constants.PostStatusPublished
+		publishAt = now
+	}
+
+	postStatusUpdate := post.NewPostStatusUpdate(postID, status, publishAt, postStatusSources[status])
+	return postUseCase.changePostStatus(ctx, location+"PublishPost", postStatusUpdate, currentUserID, currentUserRole, currentUserScopes)
 }
 
-func (postUseCase PostUseCase) GetPostById(ctx context.Context, postID string) (*model.Post, error) {
-	fetchedPost, err := postUseCase.PostRepository.GetPostById(ctx, postID)
-	return fetchedPost, err
+// UnpublishPost moves the published, scheduled or archived post back to the drafts and drops its publish time.
+func (postUseCase PostUseCase) UnpublishPost(ctx context.Context, postID, currentUserID, currentUserRole string, currentUserScopes []string) common.Result[post.Post] {
+	postStatusUpdate := post.NewPostStatusUpdate(postID, constants.PostStatusDraft, time.Time{}, postStatusSources[constants.PostStatusDraft])
+	return postUseCase.changePostStatus(ctx, location+"UnpublishPost", postStatusUpdate, currentUserID, currentUserRole, currentUserScopes)
 }
 
-func (postUseCase PostUseCase) CreatePost(ctx context.Context, post *model.PostCreate) (*model.Post, error) {
-	createdPost, err := postUseCase.PostRepository.CreatePost(ctx, post)
-	return createdPost, err
+// ArchivePost takes the post out of the listings, it stays visible to its author and can be published again.
+func (postUseCase PostUseCase) ArchivePost(ctx context.Context, postID, currentUserID, currentUserRole string, currentUserScopes []string) common.Result[post.Post] {
+	postStatusUpdate := post.NewPostStatusUpdate(postID, constants.PostStatusArchived, time.Time{}, postStatusSources[constants.PostStatusArchived])
+	return postUseCase.changePostStatus(ctx, location+"ArchivePost", postStatusUpdate, currentUserID, currentUserRole, currentUserScopes)
 }
 
-func (postUseCase PostUseCase) UpdatePostById(ctx context.Context, postID string, post *model.PostUpdate, currentUserID string) (*model.Post, error) {
-	fetchedPost, err := postUseCase.GetPostById(ctx, postID)
+// PublishScheduledPosts publishes the scheduled posts whose publish time has come, the post scheduler runs it periodically.
+func (postUseCase PostUseCase) PublishScheduledPosts(ctx context.Context) error {
+	publishedPosts := postUseCase.PostRepository.PublishScheduledPosts(ctx, time.Now())
+	if validator.IsError(publishedPosts.Error) {
+		return domain.HandleError(publishedPosts.Error)
+	}
+	if publishedPosts.Data > 0 {
+		postUseCase.Logger.Info(domain.NewInfoMessage(location+"PublishScheduledPosts", fmt.Sprintf(scheduledPostsPublished, publishedPosts.Data)))
+	}
 
-	if err != nil {
-		return nil, err
+	return nil
+}
+
+// DeletePostByID deletes the post if the current user owns it or may delete any post.
+func (postUseCase PostUseCase) DeletePostByID(ctx context.Context, postID, currentUserID, currentUserRole string, currentUserScopes []string) error {
+	checkPostPermissionError := postUseCase.checkPostPermission(ctx, location+"DeletePostByID", postID, currentUserID, currentUserRole, currentUserScopes, constants.PostDeleteOwnPermission, constants.PostDeleteAnyPermission)
+	if validator.IsError(checkPostPermissionError) {
+		return domain.HandleError(checkPostPermissionError)
 	}
 
-	userID := fetchedPost.UserID
-	if currentUserID != userID {
-		return nil, domain.NewAuthorizationError(location, constants.AuthorizationErrorNotification)
+	deletePostError := postUseCase.PostRepository.DeletePostByID(ctx, postID)
+	if validator.IsError(deletePostError) {
+		return domain.HandleError(deletePostError)
 	}
 
-	updatedPost, err := postUseCase.PostRepository.UpdatePostById(ctx, postID, post)
-	return updatedPost, err
+	return nil
 }
 
-func (postUseCase PostUseCase) DeletePostByID(ctx context.Context, postID string, currentUserID string) error {
-	fetchedPost, err := postUseCase.GetPostById(ctx, postID)
+// changePostStatus moves the post to another status if the current user owns it or may update any post.
+func (postUseCase PostUseCase) changePostStatus(ctx context.Context, location string, postStatusUpdate post.PostStatusUpdate, currentUserID, currentUserRole string, currentUserScopes []string) common.Result[post.Post] {
+	checkPostPermissionError := postUseCase.checkPostPermission(ctx, location, postStatusUpdate.ID, currentUserID, currentUserRole, currentUserScopes, constants.PostUpdateOwnPermission, constants.PostUpdateAnyPermission)
+	if validator.IsError(checkPostPermissionError) {
+		return common.NewResultOnFailure[post.Post](domain.HandleError(checkPostPermissionError))
+	}
 
-	if err != nil {
-		return err
+	updatedPost := postUseCase.PostRepository.ChangePostStatus(ctx, postStatusUpdate)
+	if validator.IsError(updatedPost.Error) {
+		return common.NewResultOnFailure[post.Post](domain.HandleError(updatedPost.Error))
 	}
 
-	userID := fetchedPost.UserID
-	if currentUserID != userID {
-		return domain.NewAuthorizationError(location, constants.AuthorizationErrorNotification)
+	return updatedPost
+}
+
+// checkPostVisibility reports a post that is not published as missing to everyone but its author,
+// so drafts don't reveal that they exist.
+func (postUseCase PostUseCase) checkPostVisibility(location string, fetchedPost post.Post, currentUserID string) error {
+	if fetchedPost.Status == constants.PostStatusPublished || (validator.IsValueNotEmpty(currentUserID) && fetchedPost.UserID == currentUserID) {
+		return nil
+	}
+
+	itemNotFoundError := domain.NewItemNotFoundError(location+".checkPostVisibility", fetchedPost.ID, postNotFound)
+	postUseCase.Logger.Debug(itemNotFoundError)
+	return itemNotFoundError
+}
+
+// checkPostPermission fetches the post and checks that the current user may change it.
+func (postUseCase PostUseCase) checkPostPermission(ctx context.Context, location, postID, currentUserID, currentUserRole string, currentUserScopes []string, ownPermission, anyPermission string) error {
+	fetchedPost := postUseCase.PostRepository.GetPostById(ctx, postID)
+	if validator.IsError(fetchedPost.Error) {
+		return fetchedPost.Error
 	}
 
-	deletedPost := postUseCase.PostRepository.DeletePostByID(ctx, postID)
-	return deletedPost
+	return policy.CheckOwnershipPermission(postUseCase.Logger, location+".checkPostPermission", currentUserRole, currentUserScopes, currentUserID, fetchedPost.Data.UserID, ownPermission, anyPermission)
 }
@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	post "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/model"
+	postUtility "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/utility"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	commonUtility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/common"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/domain"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+// Constants used for various validation messages and field names.
+const (
+	// Error Messages for invalid inputs.
+	titleAllowedCharacters   = "Sorry, control characters are not allowed in the title."
+	contentAllowedCharacters = "Sorry, control characters other than line breaks and tabs are not allowed in the content."
+	invalidImageURL          = "Sorry, the image must be an absolute http or https URL."
+	invalidUserID            = "Sorry, the user ID must consist of 24 hexadecimal characters."
+	invalidCreatedRange      = "Sorry, the start of the creation range must be before its end."
+	slugAllowedCharacters    = "Sorry, only letters (a-z), numbers (0-9) and hyphens are allowed."
+	invalidStatus            = "Sorry, the status must be one of draft, scheduled, published or archived."
+
+	// Field Names used in validation.
+	titleField        = "title"
+	contentField      = "content"
+	imageField        = "image"
+	userIDField       = "user_id"
+	createdRangeField = "created_from and created_to"
+	slugField         = "slug"
+	statusField       = "status"
+
+	// Length constraints.
+	minTitleLength   = 4
+	maxTitleLength   = 150
+	minContentLength = 10
+	maxContentLength = 20000
+	minImageLength   = 10
+	maxImageLength   = 2048
+	userIDLength     = 24
+	minSlugLength    = 1
+	maxSlugLength    = postUtility.MaxSlugLength + 10 // Leaves room for the suffixes of slugs made unique.
+)
+
+// Regular expressions for validating the fields.
+var (
+	titleRegex   = regexp.MustCompile(`^\P{C}*$`)
+	contentRegex = regexp.MustCompile(`^(?:\P{C}|[\n\t])*$`)
+	imageRegex   = regexp.MustCompile(`^[\x21-\x7E]*$`)
+	userIDRegex  = regexp.MustCompile(`^[a-fA-F0-9]{24}$`)
+	slugRegex    = regexp.MustCompile(`^[a-z0-9-]*$`)
+)
+
+// sortablePostFields lists the fields the posts can be ordered by, other fields fall back to the default one,
+// the same way the pagination falls back to the defaults for an invalid page or sort order.
+var sortablePostFields = []string{constants.DefaultOrderBy, "updated_at", titleField}
+
+// postStatuses lists the statuses the posts can be filtered by.
+var postStatuses = []string{constants.PostStatusDraft, constants.PostStatusScheduled, constants.PostStatusPublished, constants.PostStatusArchived}
+
+func validatePostFilter(logger interfaces.Logger, postFilter post.PostFilter) common.Result[post.PostFilter] {
+	validationErrors := make([]error, 0, 3)
+
+	postFilter.UserID = strings.TrimSpace(postFilter.UserID)
+	postFilter.Status = commonUtility.SanitizeAndToLowerString(postFilter.Status)
+	userIDValidator := utility.NewStringValidator(userIDField, postFilter.UserID, userIDRegex, userIDLength, userIDLength, true)
+	userIDValidator.Notification = invalidUserID
+	validationErrors = utility.ValidateField(logger, location+"validatePostFilter", userIDValidator, validationErrors)
+	if validator.IsValueNotEmpty(postFilter.Status) && !slices.Contains(postStatuses, postFilter.Status) {
+		validationError := domain.NewValidationError(location+"validatePostFilter.Status", statusField, constants.FieldOptional, invalidStatus)
+		logger.Debug(validationError)
+		validationErrors = append(validationErrors, validationError)
+	}
+	if !postFilter.CreatedFrom.IsZero() && !postFilter.CreatedTo.IsZero() && postFilter.CreatedFrom.After(postFilter.CreatedTo) {
+		validationError := domain.NewValidationError(location+"validatePostFilter.CreatedRange", createdRangeField, constants.FieldOptional, invalidCreatedRange)
+		logger.Debug(validationError)
+		validationErrors = append(validationErrors, validationError)
+	}
+	if len(validationErrors) > 0 {
+		return common.NewResultOnFailure[post.PostFilter](domain.NewValidationErrors(validationErrors))
+	}
+
+	return common.NewResultOnSuccess[post.PostFilter](postFilter)
+}
+
+func checkSlug(logger interfaces.Logger, location, slug string) error {
+	validationErrors := make([]error, 0, 1)
+
+	slugValidator := utility.NewStringValidator(slugField, slug, slugRegex, minSlugLength, maxSlugLength, false)
+	slugValidator.Notification = slugAllowedCharacters
+	validationErrors = utility.ValidateField(logger, location+".checkSlug", slugValidator, validationErrors)
+	if len(validationErrors) > 0 {
+		return validationErrors[0]
+	}
+
+	return nil
+}
+
+// setPostOrderBy keeps the order of the pagination query if the posts can be ordered by the field.
+func setPostOrderBy(paginationQuery common.PaginationQuery) common.PaginationQuery {
+	if !slices.Contains(sortablePostFields, paginationQuery.OrderBy) {
+		paginationQuery.OrderBy = constants.DefaultOrderBy
+	}
+
+	return paginationQuery
+}
+
+func validatePostCreate(logger interfaces.Logger, postCreate post.PostCreate) common.Result[post.PostCreate] {
+	validationErrors := make([]error, 0, 3)
+
+	postCreate.Title = commonUtility.SanitizeAndCollapseWhitespace(postUtility.SanitizeHTML(postCreate.Title))
+	postCreate.Slug = postUtility.NormalizeSlug(postCreate.Title)
+	postCreate.Content = postUtility.SanitizeHTML(postCreate.Content)
+	postCreate.Image = strings.TrimSpace(postCreate.Image)
+
+	validationErrors = validatePostFields(logger, location+"validatePostCreate", postCreate.Title, postCreate.Content, postCreate.Image, validationErrors)
+	if len(validationErrors) > 0 {
+		return common.NewResultOnFailure[post.PostCreate](domain.NewValidationErrors(validationErrors))
+	}
+
+	return common.NewResultOnSuccess[post.PostCreate](postCreate)
+}
+
+func validatePostUpdate(logger interfaces.Logger, postUpdate post.PostUpdate) common.Result[post.PostUpdate] {
+	validationErrors := make([]error, 0, 3)
+
+	postUpdate.Title = commonUtility.SanitizeAndCollapseWhitespace(postUtility.SanitizeHTML(postUpdate.Title))
+	postUpdate.Slug = postUtility.NormalizeSlug(postUpdate.Title)
+	postUpdate.Content = postUtility.SanitizeHTML(postUpdate.Content)
+	postUpdate.Image = strings.TrimSpace(postUpdate.Image)
+
+	validationErrors = validatePostFields(logger, location+"validatePostUpdate", postUpdate.Title, postUpdate.Content, postUpdate.Image, validationErrors)
+	if len(validationErrors) > 0 {
+		return common.NewResultOnFailure[post.PostUpdate](domain.NewValidationErrors(validationErrors))
+	}
+
+	return common.NewResultOnSuccess[post.PostUpdate](postUpdate)
+}
+
+// validatePostFields validates the already sanitized title, content and image of a post.
+func validatePostFields(logger interfaces.Logger, location, title, content, image string, validationErrors []error) []error {
+	titleValidator := utility.NewStringValidator(titleField, title, titleRegex, minTitleLength, maxTitleLength, false)
+	titleValidator.Notification = titleAllowedCharacters
+	contentValidator := utility.NewStringValidator(contentField, content, contentRegex, minContentLength, maxContentLength, false)
+	contentValidator.Notification = contentAllowedCharacters
+
+	errors := utility.ValidateField(logger, location+".validatePostFields", titleValidator, validationErrors)
+	errors = utility.ValidateField(logger, location+".validatePostFields", contentValidator, errors)
+	errors = validateImage(logger, location+".validatePostFields", image, errors)
+
+	return errors
+}
+
+// validateImage checks that the optional image is an absolute http or https URL.
+func validateImage(logger interfaces.Logger, location, image string, validationErrors []error) []error {
+	imageValidator := utility.NewStringValidator(imageField, image, imageRegex, minImageLength, maxImageLength, true)
+	imageValidator.Notification = invalidImageURL
+	errors := utility.ValidateField(logger, location+".validateImage", imageValidator, validationErrors)
+	if image == "" || len(errors) > len(validationErrors) {
+		return errors
+	}
+
+	imageURL, parseError := url.ParseRequestURI(image)
+	if validator.IsError(parseError) || (imageURL.Scheme != "http" && imageURL.Scheme != "https") || imageURL.Host == "" {
+		validationError := domain.NewValidationError(location+".validateImage.ParseRequestURI", imageField, constants.FieldOptional, invalidImageURL)
+		logger.Debug(validationError)
+		errors = append(errors, validationError)
+	}
+
+	return errors
+}
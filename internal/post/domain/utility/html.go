@@ -0,0 +1,46 @@
+package utility
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	// Elements whose content must not survive as text, like scripts and styles.
+	unsafeElementRegex = regexp.MustCompile(`(?is)<(script|style|iframe|object|embed|noscript)\b[^>]*>.*?</(script|style|iframe|object|embed|noscript)\s*>`)
+	htmlCommentRegex   = regexp.MustCompile(`(?s)<!--.*?-->`)
+	// Only what looks like a tag is removed, so text like "a < b" is left as it is.
+	htmlTagRegex         = regexp.MustCompile(`(?s)</?[a-zA-Z][^>]*>`)
+	trailingSpaceRegex   = regexp.MustCompile(`[ \t]+\n`)
+	repeatedNewlineRegex = regexp.MustCompile(`\n{3,}`)
+)
+
+// SanitizeHTML strips the HTML markup from the text, posts are stored as plain text.
+// Unsafe elements are dropped together with their content, other tags are dropped and their text is kept.
+// Entities are decoded before the markup is stripped, and both are repeated until the text stops changing,
+// so an escaped or double escaped tag is dropped like any other tag and sanitizing the result again changes nothing.
+// Line breaks are normalized to "\n" and kept, with at most one empty line in a row.
+func SanitizeHTML(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+	// Every round that changes the text makes it shorter, so the loop ends.
+	for {
+		strippedText := stripHTML(html.UnescapeString(text))
+		if strippedText == text {
+			break
+		}
+		text = strippedText
+	}
+	text = trailingSpaceRegex.ReplaceAllString(text, "\n")
+	text = repeatedNewlineRegex.ReplaceAllString(text, "\n\n")
+
+	return strings.TrimSpace(text)
+}
+
+// stripHTML drops the unsafe elements, the comments and the tags of the text.
+func stripHTML(text string) string {
+	text = unsafeElementRegex.ReplaceAllString(text, "")
+	text = htmlCommentRegex.ReplaceAllString(text, "")
+	return htmlTagRegex.ReplaceAllString(text, "")
+}
@@ -0,0 +1,135 @@
+package utility
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	DefaultSlug   = "post"
+	MaxSlugLength = 80 // Leaves room for the suffixes of slugs made unique.
+)
+
+// transliterations spells the letters that don't decompose into a latin letter and a diacritic in latin letters,
+// Cyrillic letters follow the official Ukrainian romanization.
+var transliterations = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ð': "d", 'ł': "l", 'þ': "th", 'ı': "i",
+	'а': "a", 'б': "b", 'в': "v", 'г': "h", 'ґ': "g", 'д': "d", 'е': "e", 'є': "ie", 'ж': "zh", 'з': "z",
+	'и': "y", 'і': "i", 'ї': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p",
+	'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ь': "", 'ю': "iu", 'я': "ia", 'ъ': "", 'ы': "y", 'э': "e", 'ё': "io", '’': "", '\'': "",
+}
+
+// initialTransliterations spells the Cyrillic letters that are written differently at the start of a word.
+var initialTransliterations = map[rune]string{
+	'є': "ye", 'ї': "yi", 'й': "y", 'ю': "yu", 'я': "ya",
+}
+
+// NormalizeSlug derives the slug from the title, the slug identifies the post in permalinks.
+// The title is lowercased and transliterated to latin letters, every run of other characters than
+// letters and digits becomes a single hyphen and the slug is cut to MaxSlugLength at a word boundary.
+func NormalizeSlug(title string) string {
+	var builder strings.Builder
+	pendingHyphen := false
+	previous := rune(0)
+	for _, character := range strings.ToLower(title) {
+		for _, letter := range transliterate(previous, character) {
+			if !isSlugCharacter(letter) {
+				pendingHyphen = builder.Len() > 0
+				continue
+			}
+			if pendingHyphen {
+				builder.WriteByte('-')
+				pendingHyphen = false
+			}
+			builder.WriteRune(letter)
+		}
+		previous = character
+	}
+
+	return cutSlug(builder.String())
+}
+
+// transliterate spells the character in latin letters, the table is consulted before the decomposition,
+// so letters like "й" keep their own spelling instead of losing the diacritic.
+func transliterate(previous, character rune) string {
+	// "зг" is spelled "zgh" to tell it apart from "ж".
+	if previous == 'з' && character == 'г' {
+		return "gh"
+	}
+
+	if !unicode.IsLetter(previous) {
+		spelling, isInitial := initialTransliterations[character]
+		if isInitial {
+			return spelling
+		}
+	}
+
+	spelling, isTransliterated := transliterations[character]
+	if isTransliterated {
+		return spelling
+	}
+
+	var builder strings.Builder
+	for _, letter := range norm.NFD.String(string(character)) {
+		if !unicode.Is(unicode.Mn, letter) {
+			builder.WriteRune(letter)
+		}
+	}
+
+	return builder.String()
+}
+
+// SlugCandidate returns the slug for the attempt to find a free one, the first attempt is the slug itself
+// and the following ones get a numbered suffix. A title without a single usable character falls back to DefaultSlug.
+func SlugCandidate(slug string, attempt int) string {
+	if slug == "" {
+		slug = DefaultSlug
+	}
+	if attempt <= 1 {
+		return slug
+	}
+
+	return slug + "-" + strconv.Itoa(attempt)
+}
+
+// IsSlugCandidate checks if the slug is one of the candidates of the base slug, so a post whose title still leads
+// to the same base slug keeps its slug, even if a lower suffix has become free in the meantime.
+func IsSlugCandidate(slug, base string) bool {
+	if base == "" {
+		base = DefaultSlug
+	}
+	if slug == base {
+		return true
+	}
+
+	suffix, hasBase := strings.CutPrefix(slug, base+"-")
+	if !hasBase {
+		return false
+	}
+	attempt, atoiError := strconv.Atoi(suffix)
+
+	return atoiError == nil && attempt > 1 && SlugCandidate(base, attempt) == slug
+}
+
+func isSlugCharacter(character rune) bool {
+	return (character >= 'a' && character <= 'z') || (character >= '0' && character <= '9')
+}
+
+// cutSlug cuts the slug to MaxSlugLength, dropping the partial last word when there is an earlier hyphen.
+func cutSlug(slug string) string {
+	if len(slug) <= MaxSlugLength {
+		return slug
+	}
+
+	slug = slug[:MaxSlugLength]
+	lastHyphen := strings.LastIndexByte(slug, '-')
+	if lastHyphen > 0 {
+		return slug[:lastHyphen]
+	}
+
+	return slug
+}
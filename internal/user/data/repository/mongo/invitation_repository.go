@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	repository "github.com/yachnytskyi/golang-mongo-grpc/internal/user/data/repository/mongo/model"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/data/repository/mongo"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/data/repository/mongo"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	codeHashKey = "code_hash"
+	usesKey     = "uses"
+	maxUsesKey  = "max_uses"
+
+	invitationNotActive = "The invitation has been revoked or does not exist."
+	invitationNotUsable = "The invitation does not exist, has been revoked, has expired or has been used up."
+)
+
+type InvitationRepository struct {
+	Config      *config.ApplicationConfig
+	Logger      interfaces.Logger
+	Invitations *mongo.Collection
+}
+
+func NewInvitationRepository(config *config.ApplicationConfig, logger interfaces.Logger, database *mongo.Database) InvitationRepository {
+	repository := InvitationRepository{
+		Config:      config,
+		Logger:      logger,
+		Invitations: database.Collection(constants.InvitationsTable),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultContextTimer)
+	defer cancel()
+
+	// Ensure the invitation indexes during initialization.
+	ensureInvitationIndexesError := repository.ensureInvitationIndexes(ctx, location+"NewInvitationRepository")
+	if validator.IsError(ensureInvitationIndexesError) {
+		logger.Panic(domain.NewInternalError(location+"NewInvitationRepository.ensureInvitationIndexes", ensureInvitationIndexesError.Error()))
+	}
+
+	return repository
+}
+
+// CreateInvitation stores a newly created invitation in the database.
+func (invitationRepository InvitationRepository) CreateInvitation(ctx context.Context, invitationCreate user.InvitationCreate) common.Result[user.Invitation] {
+	invitationCreateRepository := repository.InvitationCreateToInvitationCreateRepositoryMapper(invitationRepository.Logger, location+"CreateInvitation", invitationCreate)
+	if validator.IsError(invitationCreateRepository.Error) {
+		return common.NewResultOnFailure[user.Invitation](invitationCreateRepository.Error)
+	}
+
+	invitationCreateRepository.Data.CreatedAt = time.Now()
+	invitationCreateRepository.Data.UpdatedAt = time.Now()
+	insertOneResult, insertOneResultError := invitationRepository.Invitations.InsertOne(ctx, &invitationCreateRepository.Data)
+	if validator.IsError(insertOneResultError) {
+		internalError := domain.NewInternalError(location+"CreateInvitation.InsertOne", insertOneResultError.Error())
+		invitationRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[user.Invitation](internalError)
+	}
+
+	query := bson.M{model.ID: insertOneResult.InsertedID}
+	return invitationRepository.getInvitationByQuery(location+"CreateInvitation", ctx, query)
+}
+
+// GetInvitations retrieves the invitations that have not been revoked, newest first.
+// Expired and used up invitations are listed as well, so administrators can see how they were used.
+func (invitationRepository InvitationRepository) GetInvitations(ctx context.Context) common.Result[user.Invitations] {
+	query := bson.M{revokedKey: false}
+	option := options.Find()
+	option.SetSort(bson.M{createdAtKey: -1})
+	cursor, invitationsFindError := invitationRepository.Invitations.Find(ctx, query, option)
+	if validator.IsError(invitationsFindError) {
+		internalError := domain.NewInternalError(location+"GetInvitations.Find", invitationsFindError.Error())
+		invitationRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[user.Invitations](internalError)
+	}
+	defer cursor.Close(ctx)
+
+	fetchedInvitations := make([]repository.InvitationRepository, 0)
+	for cursor.Next(ctx) {
+		invitationInstance := repository.InvitationRepository{}
+		decodeError := cursor.Decode(&invitationInstance)
+		if validator.IsError(decodeError) {
+			internalError := domain.NewInternalError(location+"GetInvitations.cursor.decode", decodeError.Error())
+			invitationRepository.Logger.Error(internalError)
+			return common.NewResultOnFailure[user.Invitations](internalError)
+		}
+		fetchedInvitations = append(fetchedInvitations, invitationInstance)
+	}
+
+	cursorError := cursor.Err()
+	if validator.IsError(cursorError) {
+		internalError := domain.NewInternalError(location+"GetInvitations.cursor.Err", cursorError.Error())
+		invitationRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[user.Invitations](internalError)
+	}
+
+	return common.NewResultOnSuccess[user.Invitations](repository.InvitationsRepositoryToInvitationsMapper(fetchedInvitations))
+}
+
+// RevokeInvitation revokes the invitation with the provided ID, its code can't be used anymore.
+func (invitationRepository InvitationRepository) RevokeInvitation(ctx context.Context, invitationID string) error {
+	invitationObjectID := model.HexToObjectIDMapper(invitationRepository.Logger, location+"RevokeInvitation", invitationID)
+	if validator.IsError(invitationObjectID.Error) {
+		return invitationObjectID.Error
+	}
+
+	query := bson.M{
+		model.ID:   invitationObjectID.Data,
+		revokedKey: false,
+	}
+	update := bson.M{model.Set: bson.M{
+		revokedKey:   true,
+		updatedAtKey: time.Now(),
+	}}
+
+	result, updateOneError := invitationRepository.Invitations.UpdateOne(ctx, query, update)
+	if validator.IsError(updateOneError) {
+		internalError := domain.NewInternalError(location+"RevokeInvitation.UpdateOne", updateOneError.Error())
+		invitationRepository.Logger.Error(internalError)
+		return internalError
+	}
+	if result.ModifiedCount == 0 {
+		itemNotFoundError := domain.NewItemNotFoundError(location+"RevokeInvitation.UpdateOne.ModifiedCount", utility.BSONToStringMapper(query), invitationNotActive)
+		invitationRepository.Logger.Error(itemNotFoundError)
+		return itemNotFoundError
+	}
+
+	return nil
+}
+
+// UseInvitation counts a use of the invitation with the provided code hash.
+// The conditions are checked and the counter is incremented in a single update,
+// so concurrent registrations can't use the invitation more often than allowed.
+func (invitationRepository InvitationRepository) UseInvitation(ctx context.Context, codeHash string) error {
+	query := bson.M{
+		codeHashKey:  codeHash,
+		revokedKey:   false,
+		expiresAtKey: bson.M{model.GreaterThan: time.Now()},
+		model.Expression: bson.M{
+			model.LessThan: bson.A{"$" + usesKey, "$" + maxUsesKey},
+		},
+	}
+	update := bson.M{
+		model.Increment: bson.M{usesKey: 1},
+		model.Set:       bson.M{updatedAtKey: time.Now()},
+	}
+
+	result, updateOneError := invitationRepository.Invitations.UpdateOne(ctx, query, update)
+	if validator.IsError(updateOneError) {
+		internalError := domain.NewInternalError(location+"UseInvitation.UpdateOne", updateOneError.Error())
+		invitationRepository.Logger.Error(internalError)
+		return internalError
+	}
+	if result.ModifiedCount == 0 {
+		itemNotFoundError := domain.NewItemNotFoundError(location+"UseInvitation.UpdateOne.ModifiedCount", codeHashKey, invitationNotUsable)
+		invitationRepository.Logger.Error(itemNotFoundError)
+		return itemNotFoundError
+	}
+
+	return nil
+}
+
+// ReleaseInvitation gives back a use of the invitation when the registration it was used for failed.
+func (invitationRepository InvitationRepository) ReleaseInvitation(ctx context.Context, codeHash string) error {
+	query := bson.M{
+		codeHashKey: codeHash,
+		usesKey:     bson.M{model.GreaterThan: 0},
+	}
+	update := bson.M{
+		model.Increment: bson.M{usesKey: -1},
+		model.Set:       bson.M{updatedAtKey: time.Now()},
+	}
+
+	_, updateOneError := invitationRepository.Invitations.UpdateOne(ctx, query, update)
+	if validator.IsError(updateOneError) {
+		internalError := domain.NewInternalError(location+"ReleaseInvitation.UpdateOne", updateOneError.Error())
+		invitationRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
+// ensureInvitationIndexes creates a unique index on the code hash.
+func (invitationRepository InvitationRepository) ensureInvitationIndexes(ctx context.Context, location string) error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.M{codeHashKey: 1}, Options: options.Index().SetUnique(true)},
+	}
+
+	_, invitationsIndexesCreateManyError := invitationRepository.Invitations.Indexes().CreateMany(ctx, indexes)
+	if validator.IsError(invitationsIndexesCreateManyError) {
+		internalError := domain.NewInternalError(location+".ensureInvitationIndexes.Indexes.CreateMany", invitationsIndexesCreateManyError.Error())
+		invitationRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
+// getInvitationByQuery retrieves an invitation based on the provided query from the database.
+func (invitationRepository InvitationRepository) getInvitationByQuery(location string, ctx context.Context, query bson.M) common.Result[user.Invitation] {
+	fetchedInvitation := repository.InvitationRepository{}
+	invitationFindOneError := invitationRepository.Invitations.FindOne(ctx, query).Decode(&fetchedInvitation)
+	if validator.IsError(invitationFindOneError) {
+		if utility.IsMongoDBError(invitationFindOneError) {
+			internalError := domain.NewInternalError(location+".getInvitationByQuery.FindOne.Decode", invitationFindOneError.Error())
+			invitationRepository.Logger.Error(internalError)
+			return common.NewResultOnFailure[user.Invitation](internalError)
+		}
+		itemNotFoundError := domain.NewItemNotFoundError(location+".getInvitationByQuery.FindOne.Decode", utility.BSONToStringMapper(query), invitationFindOneError.Error())
+		invitationRepository.Logger.Error(itemNotFoundError)
+		return common.NewResultOnFailure[user.Invitation](itemNotFoundError)
+	}
+
+	return common.NewResultOnSuccess[user.Invitation](repository.InvitationRepositoryToInvitationMapper(fetchedInvitation))
+}
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	repository "github.com/yachnytskyi/golang-mongo-grpc/internal/user/data/repository/mongo/model"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/data/repository/mongo"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	loginAttemptKey   = "key"
+	failedAttemptsKey = "failed_attempts"
+	blockedUntilKey   = "blocked_until"
+	lockedKey         = "locked"
+	unlockTokenKey    = "unlock_token"
+
+	unlockTokenNotFound = "The unlock token does not match any locked login."
+)
+
+type LoginAttemptRepository struct {
+	Config        *config.ApplicationConfig
+	Logger        interfaces.Logger
+	LoginAttempts *mongo.Collection
+}
+
+func NewLoginAttemptRepository(config *config.ApplicationConfig, logger interfaces.Logger, database *mongo.Database) LoginAttemptRepository {
+	repository := LoginAttemptRepository{
+		Config:        config,
+		Logger:        logger,
+		LoginAttempts: database.Collection(constants.LoginAttemptsTable),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultContextTimer)
+	defer cancel()
+
+	// Ensure the login attempt indexes during initialization.
+	ensureLoginAttemptIndexesError := repository.ensureLoginAttemptIndexes(ctx, location+"NewLoginAttemptRepository")
+	if validator.IsError(ensureLoginAttemptIndexesError) {
+		logger.Panic(domain.NewInternalError(location+"NewLoginAttemptRepository.ensureLoginAttemptIndexes", ensureLoginAttemptIndexesError.Error()))
+	}
+
+	return repository
+}
+
+// GetLoginAttempt retrieves the failed login attempts of the key that are still within their window.
+// A key without failed attempts is returned as an empty login attempt.
+func (loginAttemptRepository LoginAttemptRepository) GetLoginAttempt(ctx context.Context, key string) common.Result[user.LoginAttempt] {
+	query := bson.M{
+		loginAttemptKey: key,
+		expiresAtKey:    bson.M{model.GreaterThan: time.Now()},
+	}
+
+	fetchedLoginAttempt := repository.LoginAttemptRepository{}
+	loginAttemptFindOneError := loginAttemptRepository.LoginAttempts.FindOne(ctx, query).Decode(&fetchedLoginAttempt)
+	if validator.IsError(loginAttemptFindOneError) {
+		if loginAttemptFindOneError == mongo.ErrNoDocuments {
+			return common.NewResultOnSuccess[user.LoginAttempt](user.LoginAttempt{Key: key})
+		}
+		internalError := domain.NewInternalError(location+"GetLoginAttempt.FindOne.Decode", loginAttemptFindOneError.Error())
+		loginAttemptRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[user.LoginAttempt](internalError)
+	}
+
+	return common.NewResultOnSuccess[user.LoginAttempt](repository.LoginAttemptRepositoryToLoginAttemptMapper(fetchedLoginAttempt))
+}
+
+// IncrementFailedLoginAttempts atomically counts a failed login attempt for the key and returns the updated counter.
+// Attempts that have outlived their window are discarded first, so the counter starts from scratch.
+func (loginAttemptRepository LoginAttemptRepository) IncrementFailedLoginAttempts(ctx context.Context, key string, expiresAt time.Time) common.Result[user.LoginAttempt] {
+	staleQuery := bson.M{
+		loginAttemptKey: key,
+		expiresAtKey:    bson.M{model.LessThanOrEqual: time.Now()},
+	}
+	_, deleteOneError := loginAttemptRepository.LoginAttempts.DeleteOne(ctx, staleQuery)
+	if validator.IsError(deleteOneError) {
+		internalError := domain.NewInternalError(location+"IncrementFailedLoginAttempts.DeleteOne", deleteOneError.Error())
+		loginAttemptRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[user.LoginAttempt](internalError)
+	}
+
+	query := bson.M{loginAttemptKey: key}
+	update := bson.M{
+		model.Increment:   bson.M{failedAttemptsKey: 1},
+		model.Max:         bson.M{expiresAtKey: expiresAt},
+		model.Set:         bson.M{updatedAtKey: time.Now()},
+		model.SetOnInsert: bson.M{createdAtKey: time.Now()},
+	}
+	option := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	updatedLoginAttempt := repository.LoginAttemptRepository{}
+	findOneAndUpdateError := loginAttemptRepository.LoginAttempts.FindOneAndUpdate(ctx, query, update, option).Decode(&updatedLoginAttempt)
+	if validator.IsError(findOneAndUpdateError) {
+		internalError := domain.NewInternalError(location+"IncrementFailedLoginAttempts.FindOneAndUpdate.Decode", findOneAndUpdateError.Error())
+		loginAttemptRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[user.LoginAttempt](internalError)
+	}
+
+	return common.NewResultOnSuccess[user.LoginAttempt](repository.LoginAttemptRepositoryToLoginAttemptMapper(updatedLoginAttempt))
+}
+
+// BlockLoginAttempts blocks further login attempts for the key until the provided time.
+func (loginAttemptRepository LoginAttemptRepository) BlockLoginAttempts(ctx context.Context, loginAttemptBlock user.LoginAttemptBlock) error {
+	set := bson.M{
+		blockedUntilKey: loginAttemptBlock.BlockedUntil,
+		lockedKey:       loginAttemptBlock.Locked,
+		updatedAtKey:    time.Now(),
+	}
+	if validator.IsValueNotEmpty(loginAttemptBlock.UnlockToken) {
+		set[unlockTokenKey] = loginAttemptBlock.UnlockToken
+	}
+
+	query := bson.M{loginAttemptKey: loginAttemptBlock.Key}
+	update := bson.M{
+		model.Set: set,
+		model.Max: bson.M{expiresAtKey: loginAttemptBlock.ExpiresAt},
+	}
+
+	_, updateOneError := loginAttemptRepository.LoginAttempts.UpdateOne(ctx, query, update)
+	if validator.IsError(updateOneError) {
+		internalError := domain.NewInternalError(location+"BlockLoginAttempts.UpdateOne", updateOneError.Error())
+		loginAttemptRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
+// ResetLoginAttempts removes the failed login attempts of the key.
+func (loginAttemptRepository LoginAttemptRepository) ResetLoginAttempts(ctx context.Context, key string) error {
+	query := bson.M{loginAttemptKey: key}
+	_, deleteOneError := loginAttemptRepository.LoginAttempts.DeleteOne(ctx, query)
+	if validator.IsError(deleteOneError) {
+		internalError := domain.NewInternalError(location+"ResetLoginAttempts.DeleteOne", deleteOneError.Error())
+		loginAttemptRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
+// UnlockLoginAttempts removes the locked login attempts matching the provided unlock token.
+func (loginAttemptRepository LoginAttemptRepository) UnlockLoginAttempts(ctx context.Context, unlockToken string) error {
+	query := bson.M{
+		unlockTokenKey: unlockToken,
+		lockedKey:      true,
+	}
+
+	result, deleteOneError := loginAttemptRepository.LoginAttempts.DeleteOne(ctx, query)
+	if validator.IsError(deleteOneError) {
+		internalError := domain.NewInternalError(location+"UnlockLoginAttempts.DeleteOne", deleteOneError.Error())
+		loginAttemptRepository.Logger.Error(internalError)
+		return internalError
+	}
+	if result.DeletedCount == 0 {
+		invalidTokenError := domain.NewInvalidTokenError(location+"UnlockLoginAttempts.DeleteOne.DeletedCount", unlockTokenNotFound)
+		loginAttemptRepository.Logger.Error(invalidTokenError)
+		invalidTokenError.Notification = constants.InvalidTokenErrorMessage
+		return invalidTokenError
+	}
+
+	return nil
+}
+
+// ensureLoginAttemptIndexes creates a unique index on the key, a sparse index on the unlock token,
+// and a TTL index that lets the database purge login attempts once their window is over.
+func (loginAttemptRepository LoginAttemptRepository) ensureLoginAttemptIndexes(ctx context.Context, location string) error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.M{loginAttemptKey: 1}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.M{unlockTokenKey: 1}, Options: options.Index().SetSparse(true)},
+		{Keys: bson.M{expiresAtKey: 1}, Options: options.Index().SetExpireAfterSeconds(0)},
+	}
+
+	_, loginAttemptsIndexesCreateManyError := loginAttemptRepository.LoginAttempts.Indexes().CreateMany(ctx, indexes)
+	if validator.IsError(loginAttemptsIndexesCreateManyError) {
+		internalError := domain.NewInternalError(location+".ensureLoginAttemptIndexes.Indexes.CreateMany", loginAttemptsIndexesCreateManyError.Error())
+		loginAttemptRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
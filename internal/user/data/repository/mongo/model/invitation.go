@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+
+	mongoModel "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/data/repository/mongo"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type InvitationRepository struct {
+	mongoModel.BaseEntity `bson:",inline"`
+	CreatedBy             primitive.ObjectID `bson:"created_by"`
+	CodeHash              string             `bson:"code_hash"`
+	MaxUses               int                `bson:"max_uses"`
+	Uses                  int                `bson:"uses"`
+	ExpiresAt             time.Time          `bson:"expires_at"`
+	Revoked               bool               `bson:"revoked"`
+}
+
+type InvitationCreateRepository struct {
+	CreatedBy primitive.ObjectID `bson:"created_by"`
+	CodeHash  string             `bson:"code_hash"`
+	MaxUses   int                `bson:"max_uses"`
+	Uses      int                `bson:"uses"`
+	ExpiresAt time.Time          `bson:"expires_at"`
+	Revoked   bool               `bson:"revoked"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+func NewInvitationCreateRepository(createdBy primitive.ObjectID, codeHash string, maxUses int, expiresAt time.Time) InvitationCreateRepository {
+	return InvitationCreateRepository{
+		CreatedBy: createdBy,
+		CodeHash:  codeHash,
+		MaxUses:   maxUses,
+		ExpiresAt: expiresAt,
+	}
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+type LoginAttemptRepository struct {
+	Key            string    `bson:"key"`
+	FailedAttempts int       `bson:"failed_attempts"`
+	BlockedUntil   time.Time `bson:"blocked_until"`
+	Locked         bool      `bson:"locked"`
+	UnlockToken    string    `bson:"unlock_token,omitempty"`
+	ExpiresAt      time.Time `bson:"expires_at"`
+	CreatedAt      time.Time `bson:"created_at"`
+	UpdatedAt      time.Time `bson:"updated_at"`
+}
@@ -30,10 +30,19 @@ func UserRepositoryToUserMapper(userRepository UserRepository) userModel.User {
 	return userModel.NewUser(
 		userRepository.ID.Hex(),
 		userRepository.Username,
+		userRepository.Handle,
 		userRepository.Email,
 		userRepository.Password,
+		userRepository.MustResetPassword,
 		userRepository.Role,
 		userRepository.Verified,
+		userRepository.Suspended,
+		userRepository.SuspensionReason,
+		userRepository.SuspendedUntil,
+		userRepository.TwoFactorEnabled,
+		userRepository.TwoFactorSecret,
+		userRepository.TwoFactorLastStep,
+		userRepository.RecoveryCodes,
 		userRepository.CreatedAt,
 		userRepository.UpdatedAt,
 	)
@@ -41,18 +50,28 @@ func UserRepositoryToUserMapper(userRepository UserRepository) userModel.User {
 
 func UserResetExpiryRepositoryToUserResetExpiryMapper(userResetExpiryRepository UserResetExpiryRepository) userModel.UserResetExpiry {
 	return userModel.NewUserResetExpiry(
+		userResetExpiryRepository.Username,
+		userResetExpiryRepository.Email,
 		userResetExpiryRepository.ResetExpiry,
 	)
 }
 
+func UserVerificationExpiryRepositoryToUserVerificationExpiryMapper(userVerificationExpiryRepository UserVerificationExpiryRepository) userModel.UserVerificationExpiry {
+	return userModel.NewUserVerificationExpiry(
+		userVerificationExpiryRepository.VerificationExpiry,
+	)
+}
+
 func UserCreateToUserCreateRepositoryMapper(userCreate userModel.UserCreate) UserCreateRepository {
 	return NewUserCreateRepository(
 		userCreate.Username,
+		userCreate.Handle,
 		userCreate.Email,
 		userCreate.Password,
 		userCreate.Role,
 		userCreate.Verified,
 		userCreate.VerificationCode,
+		userCreate.VerificationExpiry,
 	)
 }
 
@@ -65,13 +84,32 @@ func UserUpdateToUserUpdateRepositoryMapper(logger interfaces.Logger, location s
 	return common.NewResultOnSuccess(NewUserUpdateRepository(
 		userObjectID.Data,
 		userUpdate.Username,
+		userUpdate.Handle,
 	))
 }
 
+func UserEmailChangeToUserEmailChangeRepositoryMapper(userEmailChange userModel.UserEmailChange) UserEmailChangeRepository {
+	return NewUserEmailChangeRepository(
+		userEmailChange.Email,
+		userEmailChange.ConfirmationToken,
+		userEmailChange.CancelToken,
+		userEmailChange.Expiry,
+	)
+}
+
+func UserEmailChangeRepositoryToUserEmailChangeMapper(userEmailChangeRepository UserEmailChangeRepository) userModel.UserEmailChange {
+	userEmailChange := userModel.NewUserEmailChange("", userEmailChangeRepository.PendingEmail, "")
+	userEmailChange.ConfirmationToken = userEmailChangeRepository.EmailChangeToken
+	userEmailChange.CancelToken = userEmailChangeRepository.EmailChangeCancelToken
+	userEmailChange.Expiry = userEmailChangeRepository.EmailChangeExpiry
+	return userEmailChange
+}
+
 func UserForgottenPasswordToUserForgottenPasswordRepositoryMapper(userForgottenPassword userModel.UserForgottenPassword) UserForgottenPasswordRepository {
 	return NewUserForgottenPasswordRepository(
 		userForgottenPassword.ResetToken,
 		userForgottenPassword.ResetExpiry,
+		userForgottenPassword.MustResetPassword,
 	)
 }
 
@@ -80,3 +118,189 @@ func UserResetPasswordToUserResetPasswordRepositoryMapper(userResetPassword user
 		userResetPassword.Password,
 	)
 }
+
+func UserVerificationCodeToUserVerificationCodeRepositoryMapper(userVerificationCode userModel.UserVerificationCode) UserVerificationCodeRepository {
+	return NewUserVerificationCodeRepository(
+		userVerificationCode.VerificationCode,
+		userVerificationCode.VerificationExpiry,
+	)
+}
+
+func RefreshTokenRepositoryToRefreshTokenMapper(refreshTokenRepository RefreshTokenRepository) userModel.RefreshToken {
+	return userModel.NewRefreshToken(
+		refreshTokenRepository.ID.Hex(),
+		refreshTokenRepository.TokenID,
+		refreshTokenRepository.FamilyID,
+		refreshTokenRepository.UserID.Hex(),
+		refreshTokenRepository.UserAgent,
+		refreshTokenRepository.IPAddress,
+		refreshTokenRepository.ExpiresAt,
+		refreshTokenRepository.Revoked,
+		refreshTokenRepository.ReplacedBy,
+		refreshTokenRepository.CreatedAt,
+		refreshTokenRepository.UpdatedAt,
+	)
+}
+
+func RefreshTokenCreateToRefreshTokenCreateRepositoryMapper(logger interfaces.Logger, location string, refreshTokenCreate userModel.RefreshTokenCreate) common.Result[RefreshTokenCreateRepository] {
+	userObjectID := model.HexToObjectIDMapper(logger, location+".RefreshTokenCreateToRefreshTokenCreateRepositoryMapper", refreshTokenCreate.UserID)
+	if validator.IsError(userObjectID.Error) {
+		return common.NewResultOnFailure[RefreshTokenCreateRepository](userObjectID.Error)
+	}
+
+	return common.NewResultOnSuccess(NewRefreshTokenCreateRepository(
+		refreshTokenCreate.TokenID,
+		refreshTokenCreate.FamilyID,
+		userObjectID.Data,
+		refreshTokenCreate.UserAgent,
+		refreshTokenCreate.IPAddress,
+		refreshTokenCreate.ExpiresAt,
+	))
+}
+
+func RefreshTokensRepositoryToUserSessionsMapper(refreshTokensRepository []RefreshTokenRepository) userModel.UserSessions {
+	userSessions := make([]userModel.UserSession, len(refreshTokensRepository))
+	for index, refreshTokenRepository := range refreshTokensRepository {
+		userSessions[index] = RefreshTokenRepositoryToUserSessionMapper(refreshTokenRepository)
+	}
+
+	return userModel.NewUserSessions(userSessions)
+}
+
+func RefreshTokenRepositoryToUserSessionMapper(refreshTokenRepository RefreshTokenRepository) userModel.UserSession {
+	return userModel.NewUserSession(
+		refreshTokenRepository.FamilyID,
+		refreshTokenRepository.UserAgent,
+		refreshTokenRepository.IPAddress,
+		refreshTokenRepository.CreatedAt,
+		refreshTokenRepository.ExpiresAt,
+	)
+}
+
+func LoginAttemptRepositoryToLoginAttemptMapper(loginAttemptRepository LoginAttemptRepository) userModel.LoginAttempt {
+	return userModel.NewLoginAttempt(
+		loginAttemptRepository.Key,
+		loginAttemptRepository.FailedAttempts,
+		loginAttemptRepository.BlockedUntil,
+		loginAttemptRepository.Locked,
+		loginAttemptRepository.ExpiresAt,
+	)
+}
+
+func PersonalAccessTokensRepositoryToPersonalAccessTokensMapper(personalAccessTokensRepository []PersonalAccessTokenRepository) userModel.PersonalAccessTokens {
+	personalAccessTokens := make([]userModel.PersonalAccessToken, len(personalAccessTokensRepository))
+	for index, personalAccessTokenRepository := range personalAccessTokensRepository {
+		personalAccessTokens[index] = PersonalAccessTokenRepositoryToPersonalAccessTokenMapper(personalAccessTokenRepository)
+	}
+
+	return userModel.NewPersonalAccessTokens(personalAccessTokens)
+}
+
+func PersonalAccessTokenRepositoryToPersonalAccessTokenMapper(personalAccessTokenRepository PersonalAccessTokenRepository) userModel.PersonalAccessToken {
+	return userModel.NewPersonalAccessToken(
+		personalAccessTokenRepository.ID.Hex(),
+		personalAccessTokenRepository.UserID.Hex(),
+		personalAccessTokenRepository.Name,
+		personalAccessTokenRepository.Scopes,
+		personalAccessTokenRepository.LastUsedAt,
+		personalAccessTokenRepository.ExpiresAt,
+		personalAccessTokenRepository.Revoked,
+		personalAccessTokenRepository.CreatedAt,
+		personalAccessTokenRepository.UpdatedAt,
+	)
+}
+
+func PersonalAccessTokenCreateToPersonalAccessTokenCreateRepositoryMapper(logger interfaces.Logger, location string, personalAccessTokenCreate userModel.PersonalAccessTokenCreate) common.Result[PersonalAccessTokenCreateRepository] {
+	userObjectID := model.HexToObjectIDMapper(logger, location+".PersonalAccessTokenCreateToPersonalAccessTokenCreateRepositoryMapper", personalAccessTokenCreate.UserID)
+	if validator.IsError(userObjectID.Error) {
+		return common.NewResultOnFailure[PersonalAccessTokenCreateRepository](userObjectID.Error)
+	}
+
+	return common.NewResultOnSuccess(NewPersonalAccessTokenCreateRepository(
+		userObjectID.Data,
+		personalAccessTokenCreate.Name,
+		personalAccessTokenCreate.Scopes,
+		personalAccessTokenCreate.TokenHash,
+		personalAccessTokenCreate.ExpiresAt,
+	))
+}
+
+func InvitationsRepositoryToInvitationsMapper(invitationsRepository []InvitationRepository) userModel.Invitations {
+	invitations := make([]userModel.Invitation, len(invitationsRepository))
+	for index, invitationRepository := range invitationsRepository {
+		invitations[index] = InvitationRepositoryToInvitationMapper(invitationRepository)
+	}
+
+	return userModel.NewInvitations(invitations)
+}
+
+func InvitationRepositoryToInvitationMapper(invitationRepository InvitationRepository) userModel.Invitation {
+	return userModel.NewInvitation(
+		invitationRepository.ID.Hex(),
+		invitationRepository.CreatedBy.Hex(),
+		invitationRepository.MaxUses,
+		invitationRepository.Uses,
+		invitationRepository.ExpiresAt,
+		invitationRepository.Revoked,
+		invitationRepository.CreatedAt,
+		invitationRepository.UpdatedAt,
+	)
+}
+
+func InvitationCreateToInvitationCreateRepositoryMapper(logger interfaces.Logger, location string, invitationCreate userModel.InvitationCreate) common.Result[InvitationCreateRepository] {
+	createdByObjectID := model.HexToObjectIDMapper(logger, location+".InvitationCreateToInvitationCreateRepositoryMapper", invitationCreate.CreatedBy)
+	if validator.IsError(createdByObjectID.Error) {
+		return common.NewResultOnFailure[InvitationCreateRepository](createdByObjectID.Error)
+	}
+
+	return common.NewResultOnSuccess(NewInvitationCreateRepository(
+		createdByObjectID.Data,
+		invitationCreate.CodeHash,
+		invitationCreate.MaxUses,
+		invitationCreate.ExpiresAt,
+	))
+}
+
+func OAuthStateToOAuthStateRepositoryMapper(oauthState userModel.OAuthState) OAuthStateRepository {
+	return NewOAuthStateRepository(
+		oauthState.StateHash,
+		oauthState.Provider,
+		oauthState.CodeVerifier,
+		oauthState.ExpiresAt,
+	)
+}
+
+func OAuthStateRepositoryToOAuthStateMapper(oauthStateRepository OAuthStateRepository) userModel.OAuthState {
+	return userModel.NewOAuthState(
+		oauthStateRepository.StateHash,
+		oauthStateRepository.Provider,
+		oauthStateRepository.CodeVerifier,
+		oauthStateRepository.ExpiresAt,
+	)
+}
+
+func UserIdentityRepositoryToUserIdentityMapper(userIdentityRepository UserIdentityRepository) userModel.UserIdentity {
+	return userModel.NewUserIdentity(
+		userIdentityRepository.ID.Hex(),
+		userIdentityRepository.UserID.Hex(),
+		userIdentityRepository.Provider,
+		userIdentityRepository.Subject,
+		userIdentityRepository.Email,
+		userIdentityRepository.CreatedAt,
+		userIdentityRepository.UpdatedAt,
+	)
+}
+
+func UserIdentityCreateToUserIdentityCreateRepositoryMapper(logger interfaces.Logger, location string, userIdentityCreate userModel.UserIdentityCreate) common.Result[UserIdentityCreateRepository] {
+	userObjectID := model.HexToObjectIDMapper(logger, location+".UserIdentityCreateToUserIdentityCreateRepositoryMapper", userIdentityCreate.UserID)
+	if validator.IsError(userObjectID.Error) {
+		return common.NewResultOnFailure[UserIdentityCreateRepository](userObjectID.Error)
+	}
+
+	return common.NewResultOnSuccess(NewUserIdentityCreateRepository(
+		userObjectID.Data,
+		userIdentityCreate.Provider,
+		userIdentityCreate.Subject,
+		userIdentityCreate.Email,
+	))
+}
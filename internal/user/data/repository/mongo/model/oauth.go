@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	mongoModel "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/data/repository/mongo"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type OAuthStateRepository struct {
+	StateHash    string    `bson:"state_hash"`
+	Provider     string    `bson:"provider"`
+	CodeVerifier string    `bson:"code_verifier"`
+	ExpiresAt    time.Time `bson:"expires_at"`
+	CreatedAt    time.Time `bson:"created_at"`
+}
+
+type UserIdentityRepository struct {
+	mongoModel.BaseEntity `bson:",inline"`
+	UserID                primitive.ObjectID `bson:"user_id"`
+	Provider              string             `bson:"provider"`
+	Subject               string             `bson:"subject"`
+	Email                 string             `bson:"email"`
+}
+
+type UserIdentityCreateRepository struct {
+	UserID    primitive.ObjectID `bson:"user_id"`
+	Provider  string             `bson:"provider"`
+	Subject   string             `bson:"subject"`
+	Email     string             `bson:"email"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+func NewOAuthStateRepository(stateHash, provider, codeVerifier string, expiresAt time.Time) OAuthStateRepository {
+	return OAuthStateRepository{
+		StateHash:    stateHash,
+		Provider:     provider,
+		CodeVerifier: codeVerifier,
+		ExpiresAt:    expiresAt,
+	}
+}
+
+func NewUserIdentityCreateRepository(userID primitive.ObjectID, provider, subject, email string) UserIdentityCreateRepository {
+	return UserIdentityCreateRepository{
+		UserID:   userID,
+		Provider: provider,
+		Subject:  subject,
+		Email:    email,
+	}
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	mongoModel "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/data/repository/mongo"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type PersonalAccessTokenRepository struct {
+	mongoModel.BaseEntity `bson:",inline"`
+	UserID                primitive.ObjectID `bson:"user_id"`
+	Name                  string             `bson:"name"`
+	Scopes                []string           `bson:"scopes"`
+	TokenHash             string             `bson:"token_hash"`
+	LastUsedAt            time.Time          `bson:"last_used_at,omitempty"`
+	ExpiresAt             time.Time          `bson:"expires_at,omitempty"`
+	Revoked               bool               `bson:"revoked"`
+}
+
+type PersonalAccessTokenCreateRepository struct {
+	UserID    primitive.ObjectID `bson:"user_id"`
+	Name      string             `bson:"name"`
+	Scopes    []string           `bson:"scopes"`
+	TokenHash string             `bson:"token_hash"`
+	ExpiresAt time.Time          `bson:"expires_at,omitempty"`
+	Revoked   bool               `bson:"revoked"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+func NewPersonalAccessTokenCreateRepository(userID primitive.ObjectID, name string, scopes []string, tokenHash string, expiresAt time.Time) PersonalAccessTokenCreateRepository {
+	return PersonalAccessTokenCreateRepository{
+		UserID:    userID,
+		Name:      name,
+		Scopes:    scopes,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+	}
+}
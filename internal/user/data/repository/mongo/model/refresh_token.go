@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	mongoModel "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/data/repository/mongo"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type RefreshTokenRepository struct {
+	mongoModel.BaseEntity `bson:",inline"`
+	TokenID               string             `bson:"token_id"`
+	FamilyID              string             `bson:"family_id"`
+	UserID                primitive.ObjectID `bson:"user_id"`
+	UserAgent             string             `bson:"user_agent"`
+	IPAddress             string             `bson:"ip_address"`
+	ExpiresAt             time.Time          `bson:"expires_at"`
+	Revoked               bool               `bson:"revoked"`
+	ReplacedBy            string             `bson:"replaced_by"`
+}
+
+type RefreshTokenCreateRepository struct {
+	TokenID   string             `bson:"token_id"`
+	FamilyID  string             `bson:"family_id"`
+	UserID    primitive.ObjectID `bson:"user_id"`
+	UserAgent string             `bson:"user_agent"`
+	IPAddress string             `bson:"ip_address"`
+	ExpiresAt time.Time          `bson:"expires_at"`
+	Revoked   bool               `bson:"revoked"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+func NewRefreshTokenCreateRepository(tokenID, familyID string, userID primitive.ObjectID, userAgent, ipAddress string, expiresAt time.Time) RefreshTokenCreateRepository {
+	return RefreshTokenCreateRepository{
+		TokenID:   tokenID,
+		FamilyID:  familyID,
+		UserID:    userID,
+		UserAgent: userAgent,
+		IPAddress: ipAddress,
+		ExpiresAt: expiresAt,
+	}
+}
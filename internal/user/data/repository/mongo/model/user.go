@@ -15,33 +15,53 @@ type UsersRepository struct {
 
 type UserRepository struct {
 	mongoModel.BaseEntity `bson:",inline"`
-	Username              string `bson:"username"`
-	Email                 string `bson:"email"`
-	Password              string `bson:"password"`
-	Role                  string `bson:"role"`
-	Verified              bool   `bson:"verified"`
+	Username              string    `bson:"username"`
+	Handle                string    `bson:"handle"`
+	Email                 string    `bson:"email"`
+	Password              string    `bson:"password"`
+	MustResetPassword     bool      `bson:"must_reset_password,omitempty"`
+	Role                  string    `bson:"role"`
+	Verified              bool      `bson:"verified"`
+	Suspended             bool      `bson:"suspended"`
+	SuspensionReason      string    `bson:"suspension_reason,omitempty"`
+	SuspendedUntil        time.Time `bson:"suspended_until,omitempty"`
+	TwoFactorEnabled      bool      `bson:"two_factor_enabled"`
+	TwoFactorSecret       string    `bson:"two_factor_secret,omitempty"`
+	TwoFactorLastStep     int64     `bson:"two_factor_last_step,omitempty"`
+	RecoveryCodes         []string  `bson:"recovery_codes,omitempty"`
 }
 
 type UserCreateRepository struct {
-	Username         string    `bson:"username"`
-	Email            string    `bson:"email"`
-	Password         string    `bson:"password"`
-	Role             string    `bson:"role"`
-	Verified         bool      `bson:"verified"`
-	VerificationCode string    `bson:"verification_code"`
-	CreatedAt        time.Time `bson:"created_at"`
-	UpdatedAt        time.Time `bson:"updated_at"`
+	Username           string    `bson:"username"`
+	Handle             string    `bson:"handle"`
+	Email              string    `bson:"email"`
+	Password           string    `bson:"password"`
+	Role               string    `bson:"role"`
+	Verified           bool      `bson:"verified"`
+	VerificationCode   string    `bson:"verification_code"`
+	VerificationExpiry time.Time `bson:"verification_expiry"`
+	CreatedAt          time.Time `bson:"created_at"`
+	UpdatedAt          time.Time `bson:"updated_at"`
 }
 
 type UserUpdateRepository struct {
 	UserID    primitive.ObjectID `bson:"_id"`
 	Username  string             `bson:"username"`
+	Handle    string             `bson:"handle"`
 	UpdatedAt time.Time          `bson:"updated_at"`
 }
 
+type UserEmailChangeRepository struct {
+	PendingEmail           string    `bson:"pending_email"`
+	EmailChangeToken       string    `bson:"email_change_token"`
+	EmailChangeCancelToken string    `bson:"email_change_cancel_token"`
+	EmailChangeExpiry      time.Time `bson:"email_change_expiry"`
+}
+
 type UserForgottenPasswordRepository struct {
-	ResetToken  string    `bson:"reset_token"`
-	ResetExpiry time.Time `bson:"reset_expiry"`
+	ResetToken        string    `bson:"reset_token"`
+	ResetExpiry       time.Time `bson:"reset_expiry"`
+	MustResetPassword bool      `bson:"must_reset_password,omitempty"`
 }
 
 type UserResetPasswordRepository struct {
@@ -49,31 +69,55 @@ type UserResetPasswordRepository struct {
 }
 
 type UserResetExpiryRepository struct {
+	Username    string    `bson:"username"`
+	Email       string    `bson:"email"`
 	ResetExpiry time.Time `bson:"reset_expiry"`
 }
 
-func NewUserCreateRepository(username, email, password, role string, verified bool, verificationCode string) UserCreateRepository {
+type UserVerificationCodeRepository struct {
+	VerificationCode   string    `bson:"verification_code"`
+	VerificationExpiry time.Time `bson:"verification_expiry"`
+}
+
+type UserVerificationExpiryRepository struct {
+	VerificationExpiry time.Time `bson:"verification_expiry"`
+}
+
+func NewUserCreateRepository(username, handle, email, password, role string, verified bool, verificationCode string, verificationExpiry time.Time) UserCreateRepository {
 	return UserCreateRepository{
-		Username:         username,
-		Email:            email,
-		Password:         password,
-		Role:             role,
-		Verified:         verified,
-		VerificationCode: verificationCode,
+		Username:           username,
+		Handle:             handle,
+		Email:              email,
+		Password:           password,
+		Role:               role,
+		Verified:           verified,
+		VerificationCode:   verificationCode,
+		VerificationExpiry: verificationExpiry,
 	}
 }
 
-func NewUserUpdateRepository(userID primitive.ObjectID, username string) UserUpdateRepository {
+func NewUserUpdateRepository(userID primitive.ObjectID, username, handle string) UserUpdateRepository {
 	return UserUpdateRepository{
 		UserID:   userID,
 		Username: username,
+		Handle:   handle,
 	}
 }
 
-func NewUserForgottenPasswordRepository(resetToken string, resetExpiry time.Time) UserForgottenPasswordRepository {
+func NewUserEmailChangeRepository(pendingEmail, emailChangeToken, emailChangeCancelToken string, emailChangeExpiry time.Time) UserEmailChangeRepository {
+	return UserEmailChangeRepository{
+		PendingEmail:           pendingEmail,
+		EmailChangeToken:       emailChangeToken,
+		EmailChangeCancelToken: emailChangeCancelToken,
+		EmailChangeExpiry:      emailChangeExpiry,
+	}
+}
+
+func NewUserForgottenPasswordRepository(resetToken string, resetExpiry time.Time, mustResetPassword bool) UserForgottenPasswordRepository {
 	return UserForgottenPasswordRepository{
-		ResetToken:  resetToken,
-		ResetExpiry: resetExpiry,
+		ResetToken:        resetToken,
+		ResetExpiry:       resetExpiry,
+		MustResetPassword: mustResetPassword,
 	}
 }
 
@@ -89,6 +133,19 @@ func NewUserResetExpiryRepository(resetExpiry time.Time) UserResetExpiryReposito
 	}
 }
 
+func NewUserVerificationCodeRepository(verificationCode string, verificationExpiry time.Time) UserVerificationCodeRepository {
+	return UserVerificationCodeRepository{
+		VerificationCode:   verificationCode,
+		VerificationExpiry: verificationExpiry,
+	}
+}
+
+func NewUserVerificationExpiryRepository(verificationExpiry time.Time) UserVerificationExpiryRepository {
+	return UserVerificationExpiryRepository{
+		VerificationExpiry: verificationExpiry,
+	}
+}
+
 func NewUsersRepository(users []UserRepository) UsersRepository {
 	return UsersRepository{
 		Users: users,
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	repository "github.com/yachnytskyi/golang-mongo-grpc/internal/user/data/repository/mongo/model"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/data/repository/mongo"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/data/repository/mongo"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	stateHashKey = "state_hash"
+	providerKey  = "provider"
+	subjectKey   = "subject"
+)
+
+type OAuthRepository struct {
+	Config         *config.ApplicationConfig
+	Logger         interfaces.Logger
+	OAuthStates    *mongo.Collection
+	UserIdentities *mongo.Collection
+}
+
+func NewOAuthRepository(config *config.ApplicationConfig, logger interfaces.Logger, database *mongo.Database) OAuthRepository {
+	repository := OAuthRepository{
+		Config:         config,
+		Logger:         logger,
+		OAuthStates:    database.Collection(constants.OAuthStatesTable),
+		UserIdentities: database.Collection(constants.UserIdentitiesTable),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultContextTimer)
+	defer cancel()
+
+	// Ensure the OAuth indexes during initialization.
+	ensureOAuthIndexesError := repository.ensureOAuthIndexes(ctx, location+"NewOAuthRepository")
+	if validator.IsError(ensureOAuthIndexesError) {
+		logger.Panic(domain.NewInternalError(location+"NewOAuthRepository.ensureOAuthIndexes", ensureOAuthIndexesError.Error()))
+	}
+
+	return repository
+}
+
+// CreateOAuthState stores a pending social login until the provider redirects back or the state expires.
+func (oauthRepository OAuthRepository) CreateOAuthState(ctx context.Context, oauthState user.OAuthState) error {
+	oauthStateRepository := repository.OAuthStateToOAuthStateRepositoryMapper(oauthState)
+	oauthStateRepository.CreatedAt = time.Now()
+	_, insertOneError := oauthRepository.OAuthStates.InsertOne(ctx, &oauthStateRepository)
+	if validator.IsError(insertOneError) {
+		internalError := domain.NewInternalError(location+"CreateOAuthState.InsertOne", insertOneError.Error())
+		oauthRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
+// ConsumeOAuthState retrieves and removes the pending social login with the provided state hash,
+// so a callback can't be replayed. An expired state may still be returned until the TTL monitor removes it.
+func (oauthRepository OAuthRepository) ConsumeOAuthState(ctx context.Context, stateHash string) common.Result[user.OAuthState] {
+	query := bson.M{stateHashKey: stateHash}
+	fetchedOAuthState := repository.OAuthStateRepository{}
+	oauthStateFindOneAndDeleteError := oauthRepository.OAuthStates.FindOneAndDelete(ctx, query).Decode(&fetchedOAuthState)
+	if validator.IsError(oauthStateFindOneAndDeleteError) {
+		if utility.IsMongoDBError(oauthStateFindOneAndDeleteError) {
+			internalError := domain.NewInternalError(location+"ConsumeOAuthState.FindOneAndDelete.Decode", oauthStateFindOneAndDeleteError.Error())
+			oauthRepository.Logger.Error(internalError)
+			return common.NewResultOnFailure[user.OAuthState](internalError)
+		}
+		invalidTokenError := domain.NewInvalidTokenError(location+"ConsumeOAuthState.FindOneAndDelete.Decode", oauthStateFindOneAndDeleteError.Error())
+		oauthRepository.Logger.Error(invalidTokenError)
+		invalidTokenError.Notification = constants.InvalidTokenErrorMessage
+		return common.NewResultOnFailure[user.OAuthState](invalidTokenError)
+	}
+
+	return common.NewResultOnSuccess[user.OAuthState](repository.OAuthStateRepositoryToOAuthStateMapper(fetchedOAuthState))
+}
+
+// GetUserIdentity retrieves the link of the external identity to a user.
+// An identity that isn't linked yet is returned as an empty user identity.
+func (oauthRepository OAuthRepository) GetUserIdentity(ctx context.Context, provider, subject string) common.Result[user.UserIdentity] {
+	query := bson.M{
+		providerKey: provider,
+		subjectKey:  subject,
+	}
+
+	fetchedUserIdentity := repository.UserIdentityRepository{}
+	userIdentityFindOneError := oauthRepository.UserIdentities.FindOne(ctx, query).Decode(&fetchedUserIdentity)
+	if validator.IsError(userIdentityFindOneError) {
+		if userIdentityFindOneError == mongo.ErrNoDocuments {
+			return common.NewResultOnSuccess[user.UserIdentity](user.UserIdentity{Provider: provider, Subject: subject})
+		}
+		internalError := domain.NewInternalError(location+"GetUserIdentity.FindOne.Decode", userIdentityFindOneError.Error())
+		oauthRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[user.UserIdentity](internalError)
+	}
+
+	return common.NewResultOnSuccess[user.UserIdentity](repository.UserIdentityRepositoryToUserIdentityMapper(fetchedUserIdentity))
+}
+
+// CreateUserIdentity links an external identity to a user.
+func (oauthRepository OAuthRepository) CreateUserIdentity(ctx context.Context, userIdentityCreate user.UserIdentityCreate) common.Result[user.UserIdentity] {
+	userIdentityCreateRepository := repository.UserIdentityCreateToUserIdentityCreateRepositoryMapper(oauthRepository.Logger, location+"CreateUserIdentity", userIdentityCreate)
+	if validator.IsError(userIdentityCreateRepository.Error) {
+		return common.NewResultOnFailure[user.UserIdentity](userIdentityCreateRepository.Error)
+	}
+
+	userIdentityCreateRepository.Data.CreatedAt = time.Now()
+	userIdentityCreateRepository.Data.UpdatedAt = time.Now()
+	insertOneResult, insertOneResultError := oauthRepository.UserIdentities.InsertOne(ctx, &userIdentityCreateRepository.Data)
+	if validator.IsError(insertOneResultError) {
+		internalError := domain.NewInternalError(location+"CreateUserIdentity.InsertOne", insertOneResultError.Error())
+		oauthRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[user.UserIdentity](internalError)
+	}
+
+	query := bson.M{model.ID: insertOneResult.InsertedID}
+	fetchedUserIdentity := repository.UserIdentityRepository{}
+	userIdentityFindOneError := oauthRepository.UserIdentities.FindOne(ctx, query).Decode(&fetchedUserIdentity)
+	if validator.IsError(userIdentityFindOneError) {
+		internalError := domain.NewInternalError(location+"CreateUserIdentity.FindOne.Decode", userIdentityFindOneError.Error())
+		oauthRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[user.UserIdentity](internalError)
+	}
+
+	return common.NewResultOnSuccess[user.UserIdentity](repository.UserIdentityRepositoryToUserIdentityMapper(fetchedUserIdentity))
+}
+
+// ensureOAuthIndexes creates a unique index on the state hash, a TTL index removing the expired states,
+// a unique index on the external identity and a lookup index on the user.
+func (oauthRepository OAuthRepository) ensureOAuthIndexes(ctx context.Context, location string) error {
+	oauthStateIndexes := []mongo.IndexModel{
+		{Keys: bson.M{stateHashKey: 1}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.M{expiresAtKey: 1}, Options: options.Index().SetExpireAfterSeconds(0)},
+	}
+
+	_, oauthStatesIndexesCreateManyError := oauthRepository.OAuthStates.Indexes().CreateMany(ctx, oauthStateIndexes)
+	if validator.IsError(oauthStatesIndexesCreateManyError) {
+		internalError := domain.NewInternalError(location+".ensureOAuthIndexes.OAuthStates.Indexes.CreateMany", oauthStatesIndexesCreateManyError.Error())
+		oauthRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	userIdentityIndexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: providerKey, Value: 1}, {Key: subjectKey, Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.M{userIDKey: 1}},
+	}
+
+	_, userIdentitiesIndexesCreateManyError := oauthRepository.UserIdentities.Indexes().CreateMany(ctx, userIdentityIndexes)
+	if validator.IsError(userIdentitiesIndexesCreateManyError) {
+		internalError := domain.NewInternalError(location+".ensureOAuthIndexes.UserIdentities.Indexes.CreateMany", userIdentitiesIndexesCreateManyError.Error())
+		oauthRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
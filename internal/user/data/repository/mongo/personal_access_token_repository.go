@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	repository "github.com/yachnytskyi/golang-mongo-grpc/internal/user/data/repository/mongo/model"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/data/repository/mongo"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/data/repository/mongo"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	tokenHashKey  = "token_hash"
+	lastUsedAtKey = "last_used_at"
+
+	personalAccessTokenNotActive = "The personal access token has been revoked or does not exist."
+)
+
+type PersonalAccessTokenRepository struct {
+	Config               *config.ApplicationConfig
+	Logger               interfaces.Logger
+	PersonalAccessTokens *mongo.Collection
+}
+
+func NewPersonalAccessTokenRepository(config *config.ApplicationConfig, logger interfaces.Logger, database *mongo.Database) PersonalAccessTokenRepository {
+	repository := PersonalAccessTokenRepository{
+		Config:               config,
+		Logger:               logger,
+		PersonalAccessTokens: database.Collection(constants.PersonalAccessTokensTable),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultContextTimer)
+	defer cancel()
+
+	// Ensure the personal access token indexes during initialization.
+	ensurePersonalAccessTokenIndexesError := repository.ensurePersonalAccessTokenIndexes(ctx, location+"NewPersonalAccessTokenRepository")
+	if validator.IsError(ensurePersonalAccessTokenIndexesError) {
+		logger.Panic(domain.NewInternalError(location+"NewPersonalAccessTokenRepository.ensurePersonalAccessTokenIndexes", ensurePersonalAccessTokenIndexesError.Error()))
+	}
+
+	return repository
+}
+
+// CreatePersonalAccessToken stores a newly created personal access token in the database.
+func (personalAccessTokenRepository PersonalAccessTokenRepository) CreatePersonalAccessToken(ctx context.Context, personalAccessTokenCreate user.PersonalAccessTokenCreate) common.Result[user.PersonalAccessToken] {
+	personalAccessTokenCreateRepository := repository.PersonalAccessTokenCreateToPersonalAccessTokenCreateRepositoryMapper(personalAccessTokenRepository.Logger, location+"CreatePersonalAccessToken", personalAccessTokenCreate)
+	if validator.IsError(personalAccessTokenCreateRepository.Error) {
+		return common.NewResultOnFailure[user.PersonalAccessToken](personalAccessTokenCreateRepository.Error)
+	}
+
+	personalAccessTokenCreateRepository.Data.CreatedAt = time.Now()
+	personalAccessTokenCreateRepository.Data.UpdatedAt = time.Now()
+	insertOneResult, insertOneResultError := personalAccessTokenRepository.PersonalAccessTokens.InsertOne(ctx, &personalAccessTokenCreateRepository.Data)
+	if validator.IsError(insertOneResultError) {
+		internalError := domain.NewInternalError(location+"CreatePersonalAccessToken.InsertOne", insertOneResultError.Error())
+		personalAccessTokenRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[user.PersonalAccessToken](internalError)
+	}
+
+	query := bson.M{model.ID: insertOneResult.InsertedID}
+	return personalAccessTokenRepository.getPersonalAccessTokenByQuery(location+"CreatePersonalAccessToken", ctx, query)
+}
+
+// GetPersonalAccessTokens retrieves the personal access tokens of the user that have not been revoked, newest first.
+// Expired tokens are listed as well, so the user can see why a client stopped working.
+func (personalAccessTokenRepository PersonalAccessTokenRepository) GetPersonalAccessTokens(ctx context.Context, userID string) common.Result[user.PersonalAccessTokens] {
+	userObjectID := model.HexToObjectIDMapper(personalAccessTokenRepository.Logger, location+"GetPersonalAccessTokens", userID)
+	if validator.IsError(userObjectID.Error) {
+		return common.NewResultOnFailure[user.PersonalAccessTokens](userObjectID.Error)
+	}
+
+	query := bson.M{
+		userIDKey:  userObjectID.Data,
+		revokedKey: false,
+	}
+	option := options.Find()
+	option.SetSort(bson.M{createdAtKey: -1})
+	cursor, personalAccessTokensFindError := personalAccessTokenRepository.PersonalAccessTokens.Find(ctx, query, option)
+	if validator.IsError(personalAccessTokensFindError) {
+		internalError := domain.NewInternalError(location+"GetPersonalAccessTokens.Find", personalAccessTokensFindError.Error())
+		personalAccessTokenRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[user.PersonalAccessTokens](internalError)
+	}
+	defer cursor.Close(ctx)
+
+	fetchedPersonalAccessTokens := make([]repository.PersonalAccessTokenRepository, 0)
+	for cursor.Next(ctx) {
+		personalAccessTokenInstance := repository.PersonalAccessTokenRepository{}
+		decodeError := cursor.Decode(&personalAccessTokenInstance)
+		if validator.IsError(decodeError) {
+			internalError := domain.NewInternalError(location+"GetPersonalAccessTokens.cursor.decode", decodeError.Error())
+			personalAccessTokenRepository.Logger.Error(internalError)
+			return common.NewResultOnFailure[user.PersonalAccessTokens](internalError)
+		}
+		fetchedPersonalAccessTokens = append(fetchedPersonalAccessTokens, personalAccessTokenInstance)
+	}
+
+	cursorError := cursor.Err()
+	if validator.IsError(cursorError) {
+		internalError := domain.NewInternalError(location+"GetPersonalAccessTokens.cursor.Err", cursorError.Error())
+		personalAccessTokenRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[user.PersonalAccessTokens](internalError)
+	}
+
+	return common.NewResultOnSuccess[user.PersonalAccessTokens](repository.PersonalAccessTokensRepositoryToPersonalAccessTokensMapper(fetchedPersonalAccessTokens))
+}
+
+// GetPersonalAccessTokenByHash retrieves the personal access token with the provided hash that has not been revoked.
+func (personalAccessTokenRepository PersonalAccessTokenRepository) GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) common.Result[user.PersonalAccessToken] {
+	query := bson.M{
+		tokenHashKey: tokenHash,
+		revokedKey:   false,
+	}
+	return personalAccessTokenRepository.getPersonalAccessTokenByQuery(location+"GetPersonalAccessTokenByHash", ctx, query)
+}
+
+// UpdatePersonalAccessTokenLastUsedAt records when the personal access token was last used.
+func (personalAccessTokenRepository PersonalAccessTokenRepository) UpdatePersonalAccessTokenLastUsedAt(ctx context.Context, personalAccessTokenID string, lastUsedAt time.Time) error {
+	personalAccessTokenObjectID := model.HexToObjectIDMapper(personalAccessTokenRepository.Logger, location+"UpdatePersonalAccessTokenLastUsedAt", personalAccessTokenID)
+	if validator.IsError(personalAccessTokenObjectID.Error) {
+		return personalAccessTokenObjectID.Error
+	}
+
+	query := bson.M{model.ID: personalAccessTokenObjectID.Data}
+	update := bson.M{model.Set: bson.M{lastUsedAtKey: lastUsedAt}}
+	_, updateOneError := personalAccessTokenRepository.PersonalAccessTokens.UpdateOne(ctx, query, update)
+	if validator.IsError(updateOneError) {
+		internalError := domain.NewInternalError(location+"UpdatePersonalAccessTokenLastUsedAt.UpdateOne", updateOneError.Error())
+		personalAccessTokenRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
+// RevokePersonalAccessToken revokes the personal access token of the user with the provided ID.
+func (personalAccessTokenRepository PersonalAccessTokenRepository) RevokePersonalAccessToken(ctx context.Context, userID, personalAccessTokenID string) error {
+	userObjectID := model.HexToObjectIDMapper(personalAccessTokenRepository.Logger, location+"RevokePersonalAccessToken", userID)
+	if validator.IsError(userObjectID.Error) {
+		return userObjectID.Error
+	}
+	personalAccessTokenObjectID := model.HexToObjectIDMapper(personalAccessTokenRepository.Logger, location+"RevokePersonalAccessToken", personalAccessTokenID)
+	if validator.IsError(personalAccessTokenObjectID.Error) {
+		return personalAccessTokenObjectID.Error
+	}
+
+	query := bson.M{
+		model.ID:   personalAccessTokenObjectID.Data,
+		userIDKey:  userObjectID.Data,
+		revokedKey: false,
+	}
+	update := bson.M{model.Set: bson.M{
+		revokedKey:   true,
+		updatedAtKey: time.Now(),
+	}}
+
+	result, updateOneError := personalAccessTokenRepository.PersonalAccessTokens.UpdateOne(ctx, query, update)
+	if validator.IsError(updateOneError) {
+		internalError := domain.NewInternalError(location+"RevokePersonalAccessToken.UpdateOne", updateOneError.Error())
+		personalAccessTokenRepository.Logger.Error(internalError)
+		return internalError
+	}
+	if result.ModifiedCount == 0 {
+		itemNotFoundError := domain.NewItemNotFoundError(location+"RevokePersonalAccessToken.UpdateOne.ModifiedCount", utility.BSONToStringMapper(query), personalAccessTokenNotActive)
+		personalAccessTokenRepository.Logger.Error(itemNotFoundError)
+		return itemNotFoundError
+	}
+
+	return nil
+}
+
+// ensurePersonalAccessTokenIndexes creates a unique index on the token hash and a lookup index on the user.
+func (personalAccessTokenRepository PersonalAccessTokenRepository) ensurePersonalAccessTokenIndexes(ctx context.Context, location string) error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.M{tokenHashKey: 1}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.M{userIDKey: 1}},
+	}
+
+	_, personalAccessTokensIndexesCreateManyError := personalAccessTokenRepository.PersonalAccessTokens.Indexes().CreateMany(ctx, indexes)
+	if validator.IsError(personalAccessTokensIndexesCreateManyError) {
+		internalError := domain.NewInternalError(location+".ensurePersonalAccessTokenIndexes.Indexes.CreateMany", personalAccessTokensIndexesCreateManyError.Error())
+		personalAccessTokenRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
+// getPersonalAccessTokenByQuery retrieves a personal access token based on the provided query from the database.
+func (personalAccessTokenRepository PersonalAccessTokenRepository) getPersonalAccessTokenByQuery(location string, ctx context.Context, query bson.M) common.Result[user.PersonalAccessToken] {
+	fetchedPersonalAccessToken := repository.PersonalAccessTokenRepository{}
+	personalAccessTokenFindOneError := personalAccessTokenRepository.PersonalAccessTokens.FindOne(ctx, query).Decode(&fetchedPersonalAccessToken)
+	if validator.IsError(personalAccessTokenFindOneError) {
+		if utility.IsMongoDBError(personalAccessTokenFindOneError) {
+			internalError := domain.NewInternalError(location+".getPersonalAccessTokenByQuery.FindOne.Decode", personalAccessTokenFindOneError.Error())
+			personalAccessTokenRepository.Logger.Error(internalError)
+			return common.NewResultOnFailure[user.PersonalAccessToken](internalError)
+		}
+		invalidTokenError := domain.NewInvalidTokenError(location+".getPersonalAccessTokenByQuery.FindOne.Decode", personalAccessTokenFindOneError.Error())
+		personalAccessTokenRepository.Logger.Error(invalidTokenError)
+		invalidTokenError.Notification = constants.InvalidTokenErrorMessage
+		return common.NewResultOnFailure[user.PersonalAccessToken](invalidTokenError)
+	}
+
+	return common.NewResultOnSuccess[user.PersonalAccessToken](repository.PersonalAccessTokenRepositoryToPersonalAccessTokenMapper(fetchedPersonalAccessToken))
+}
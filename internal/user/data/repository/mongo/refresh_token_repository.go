@@ -0,0 +1,311 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	repository "github.com/yachnytskyi/golang-mongo-grpc/internal/user/data/repository/mongo/model"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/data/repository/mongo"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/data/repository/mongo"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	tokenIDKey    = "token_id"
+	familyIDKey   = "family_id"
+	userIDKey     = "user_id"
+	expiresAtKey  = "expires_at"
+	revokedKey    = "revoked"
+	replacedByKey = "replaced_by"
+
+	refreshTokenAlreadyUsed = "The refresh token has already been used or revoked."
+	sessionNotActive        = "The session has been revoked or has expired."
+)
+
+type RefreshTokenRepository struct {
+	Config        *config.ApplicationConfig
+	Logger        interfaces.Logger
+	RefreshTokens *mongo.Collection
+}
+
+func NewRefreshTokenRepository(config *config.ApplicationConfig, logger interfaces.Logger, database *mongo.Database) RefreshTokenRepository {
+	repository := RefreshTokenRepository{
+		Config:        config,
+		Logger:        logger,
+		RefreshTokens: database.Collection(constants.RefreshTokensTable),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultContextTimer)
+	defer cancel()
+
+	// Ensure the token indexes during initialization.
+	ensureRefreshTokenIndexesError := repository.ensureRefreshTokenIndexes(ctx, location+"NewRefreshTokenRepository")
+	if validator.IsError(ensureRefreshTokenIndexesError) {
+		logger.Panic(domain.NewInternalError(location+"NewRefreshTokenRepository.ensureRefreshTokenIndexes", ensureRefreshTokenIndexesError.Error()))
+	}
+
+	return repository
+}
+
+// CreateRefreshToken stores a newly issued refresh token in the database.
+func (refreshTokenRepository RefreshTokenRepository) CreateRefreshToken(ctx context.Context, refreshTokenCreate user.RefreshTokenCreate) common.Result[user.RefreshToken] {
+	refreshTokenCreateRepository := repository.RefreshTokenCreateToRefreshTokenCreateRepositoryMapper(refreshTokenRepository.Logger, location+"CreateRefreshToken", refreshTokenCreate)
+	if validator.IsError(refreshTokenCreateRepository.Error) {
+		return common.NewResultOnFailure[user.RefreshToken](refreshTokenCreateRepository.Error)
+	}
+
+	refreshTokenCreateRepository.Data.CreatedAt = time.Now()
+	refreshTokenCreateRepository.Data.UpdatedAt = time.Now()
+	insertOneResult, insertOneResultError := refreshTokenRepository.RefreshTokens.InsertOne(ctx, &refreshTokenCreateRepository.Data)
+	if validator.IsError(insertOneResultError) {
+		internalError := domain.NewInternalError(location+"CreateRefreshToken.InsertOne", insertOneResultError.Error())
+		refreshTokenRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[user.RefreshToken](internalError)
+	}
+
+	query := bson.M{model.ID: insertOneResult.InsertedID}
+	return refreshTokenRepository.getRefreshTokenByQuery(location+"CreateRefreshToken", ctx, query)
+}
+
+// GetRefreshTokenByTokenID retrieves a refresh token by its token ID (jti) from the database.
+func (refreshTokenRepository RefreshTokenRepository) GetRefreshTokenByTokenID(ctx context.Context, tokenID string) common.Result[user.RefreshToken] {
+	query := bson.M{tokenIDKey: tokenID}
+	return refreshTokenRepository.getRefreshTokenByQuery(location+"GetRefreshTokenByTokenID", ctx, query)
+}
+
+// RotateRefreshToken revokes the provided refresh token and records the token that replaced it.
+// The update only matches a token that has not been revoked yet, so a token can be rotated only once.
+func (refreshTokenRepository RefreshTokenRepository) RotateRefreshToken(ctx context.Context, tokenID, replacedByTokenID string) error {
+	query := bson.D{
+		{Key: tokenIDKey, Value: tokenID},
+		{Key: revokedKey, Value: false},
+	}
+	update := bson.D{{Key: model.Set, Value: bson.D{
+		{Key: revokedKey, Value: true},
+		{Key: replacedByKey, Value: replacedByTokenID},
+		{Key: updatedAtKey, Value: time.Now()},
+	}}}
+
+	result, updateOneError := refreshTokenRepository.RefreshTokens.UpdateOne(ctx, query, update)
+	if validator.IsError(updateOneError) {
+		internalError := domain.NewInternalError(location+"RotateRefreshToken.UpdateOne", updateOneError.Error())
+		refreshTokenRepository.Logger.Error(internalError)
+		return internalError
+	}
+	if result.ModifiedCount == 0 {
+		invalidTokenError := domain.NewInvalidTokenError(location+"RotateRefreshToken.UpdateOne.ModifiedCount", refreshTokenAlreadyUsed)
+		refreshTokenRepository.Logger.Warn(invalidTokenError)
+		invalidTokenError.Notification = constants.InvalidTokenErrorMessage
+		return invalidTokenError
+	}
+
+	return nil
+}
+
+// RevokeRefreshToken revokes the refresh token with the provided token ID (jti).
+func (refreshTokenRepository RefreshTokenRepository) RevokeRefreshToken(ctx context.Context, tokenID string) error {
+	query := bson.D{{Key: tokenIDKey, Value: tokenID}}
+	return refreshTokenRepository.revokeRefreshTokens(location+"RevokeRefreshToken", ctx, query)
+}
+
+// RevokeRefreshTokenFamily revokes every refresh token that descends from the same login.
+func (refreshTokenRepository RefreshTokenRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	query := bson.D{{Key: familyIDKey, Value: familyID}}
+	return refreshTokenRepository.revokeRefreshTokens(location+"RevokeRefreshTokenFamily", ctx, query)
+}
+
+// GetActiveSessions retrieves the sessions of the user that have a valid refresh token.
+// Rotation revokes the previous token, so every session has at most one active refresh token.
+func (refreshTokenRepository RefreshTokenRepository) GetActiveSessions(ctx context.Context, userID string) common.Result[user.UserSessions] {
+	query := refreshTokenRepository.activeRefreshTokensQuery(location+"GetActiveSessions", userID)
+	if validator.IsError(query.Error) {
+		return common.NewResultOnFailure[user.UserSessions](query.Error)
+	}
+
+	option := options.Find()
+	option.SetSort(bson.M{createdAtKey: -1})
+	cursor, refreshTokensFindError := refreshTokenRepository.RefreshTokens.Find(ctx, query.Data, option)
+	if validator.IsError(refreshTokensFindError) {
+		internalError := domain.NewInternalError(location+"GetActiveSessions.Find", refreshTokensFindError.Error())
+		refreshTokenRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[user.UserSessions](internalError)
+	}
+	defer cursor.Close(ctx)
+
+	fetchedRefreshTokens := make([]repository.RefreshTokenRepository, 0)
+	for cursor.Next(ctx) {
+		refreshTokenInstance := repository.RefreshTokenRepository{}
+		decodeError := cursor.Decode(&refreshTokenInstance)
+		if validator.IsError(decodeError) {
+			internalError := domain.NewInternalError(location+"GetActiveSessions.cursor.decode", decodeError.Error())
+			refreshTokenRepository.Logger.Error(internalError)
+			return common.NewResultOnFailure[user.UserSessions](internalError)
+		}
+		fetchedRefreshTokens = append(fetchedRefreshTokens, refreshTokenInstance)
+	}
+
+	cursorError := cursor.Err()
+	if validator.IsError(cursorError) {
+		internalError := domain.NewInternalError(location+"GetActiveSessions.cursor.Err", cursorError.Error())
+		refreshTokenRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[user.UserSessions](internalError)
+	}
+
+	return common.NewResultOnSuccess[user.UserSessions](repository.RefreshTokensRepositoryToUserSessionsMapper(fetchedRefreshTokens))
+}
+
+// CheckActiveSession checks that the session of the user still has a valid refresh token.
+func (refreshTokenRepository RefreshTokenRepository) CheckActiveSession(ctx context.Context, userID, sessionID string) error {
+	query := refreshTokenRepository.activeRefreshTokensQuery(location+"CheckActiveSession", userID)
+	if validator.IsError(query.Error) {
+		return query.Error
+	}
+
+	query.Data[familyIDKey] = sessionID
+	activeRefreshTokens, countDocumentsError := refreshTokenRepository.RefreshTokens.CountDocuments(ctx, query.Data)
+	if validator.IsError(countDocumentsError) {
+		internalError := domain.NewInternalError(location+"CheckActiveSession.CountDocuments", countDocumentsError.Error())
+		refreshTokenRepository.Logger.Error(internalError)
+		return internalError
+	}
+	if activeRefreshTokens == 0 {
+		invalidTokenError := domain.NewInvalidTokenError(location+"CheckActiveSession.CountDocuments", sessionNotActive)
+		refreshTokenRepository.Logger.Error(invalidTokenError)
+		invalidTokenError.Notification = constants.InvalidTokenErrorMessage
+		return invalidTokenError
+	}
+
+	return nil
+}
+
+// RevokeSession revokes the refresh tokens of the provided session that belongs to the user.
+func (refreshTokenRepository RefreshTokenRepository) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	query := refreshTokenRepository.activeRefreshTokensQuery(location+"RevokeSession", userID)
+	if validator.IsError(query.Error) {
+		return query.Error
+	}
+
+	query.Data[familyIDKey] = sessionID
+	update := bson.M{model.Set: bson.M{
+		revokedKey:   true,
+		updatedAtKey: time.Now(),
+	}}
+
+	result, updateManyError := refreshTokenRepository.RefreshTokens.UpdateMany(ctx, query.Data, update)
+	if validator.IsError(updateManyError) {
+		internalError := domain.NewInternalError(location+"RevokeSession.UpdateMany", updateManyError.Error())
+		refreshTokenRepository.Logger.Error(internalError)
+		return internalError
+	}
+	if result.ModifiedCount == 0 {
+		itemNotFoundError := domain.NewItemNotFoundError(location+"RevokeSession.UpdateMany.ModifiedCount", utility.BSONToStringMapper(query.Data), sessionNotActive)
+		refreshTokenRepository.Logger.Error(itemNotFoundError)
+		return itemNotFoundError
+	}
+
+	return nil
+}
+
+// RevokeAllSessions revokes every refresh token of the user.
+func (refreshTokenRepository RefreshTokenRepository) RevokeAllSessions(ctx context.Context, userID string) error {
+	userObjectID := model.HexToObjectIDMapper(refreshTokenRepository.Logger, location+"RevokeAllSessions", userID)
+	if validator.IsError(userObjectID.Error) {
+		return userObjectID.Error
+	}
+
+	query := bson.D{{Key: userIDKey, Value: userObjectID.Data}}
+	return refreshTokenRepository.revokeRefreshTokens(location+"RevokeAllSessions", ctx, query)
+}
+
+// RevokeOtherSessions revokes every refresh token of the user except the ones of the provided session.
+func (refreshTokenRepository RefreshTokenRepository) RevokeOtherSessions(ctx context.Context, userID, currentSessionID string) error {
+	userObjectID := model.HexToObjectIDMapper(refreshTokenRepository.Logger, location+"RevokeOtherSessions", userID)
+	if validator.IsError(userObjectID.Error) {
+		return userObjectID.Error
+	}
+
+	query := bson.D{
+		{Key: userIDKey, Value: userObjectID.Data},
+		{Key: familyIDKey, Value: bson.M{model.NotEqual: currentSessionID}},
+	}
+	return refreshTokenRepository.revokeRefreshTokens(location+"RevokeOtherSessions", ctx, query)
+}
+
+// activeRefreshTokensQuery builds a query matching the refresh tokens of the user that are neither revoked nor expired.
+func (refreshTokenRepository RefreshTokenRepository) activeRefreshTokensQuery(location, userID string) common.Result[bson.M] {
+	userObjectID := model.HexToObjectIDMapper(refreshTokenRepository.Logger, location+".activeRefreshTokensQuery", userID)
+	if validator.IsError(userObjectID.Error) {
+		return common.NewResultOnFailure[bson.M](userObjectID.Error)
+	}
+
+	return common.NewResultOnSuccess(bson.M{
+		userIDKey:    userObjectID.Data,
+		revokedKey:   false,
+		expiresAtKey: bson.M{model.GreaterThan: time.Now()},
+	})
+}
+
+// revokeRefreshTokens marks all refresh tokens matching the provided query as revoked.
+func (refreshTokenRepository RefreshTokenRepository) revokeRefreshTokens(location string, ctx context.Context, query bson.D) error {
+	update := bson.D{{Key: model.Set, Value: bson.D{
+		{Key: revokedKey, Value: true},
+		{Key: updatedAtKey, Value: time.Now()},
+	}}}
+
+	_, updateManyError := refreshTokenRepository.RefreshTokens.UpdateMany(ctx, query, update)
+	if validator.IsError(updateManyError) {
+		internalError := domain.NewInternalError(location+".revokeRefreshTokens.UpdateMany", updateManyError.Error())
+		refreshTokenRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
+// ensureRefreshTokenIndexes creates a unique index on the token ID, lookup indexes on the family and user,
+// and a TTL index that lets the database purge refresh tokens once they expire.
+func (refreshTokenRepository RefreshTokenRepository) ensureRefreshTokenIndexes(ctx context.Context, location string) error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.M{tokenIDKey: 1}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.M{familyIDKey: 1}},
+		{Keys: bson.M{userIDKey: 1}},
+		{Keys: bson.M{expiresAtKey: 1}, Options: options.Index().SetExpireAfterSeconds(0)},
+	}
+
+	_, refreshTokensIndexesCreateManyError := refreshTokenRepository.RefreshTokens.Indexes().CreateMany(ctx, indexes)
+	if validator.IsError(refreshTokensIndexesCreateManyError) {
+		internalError := domain.NewInternalError(location+".ensureRefreshTokenIndexes.Indexes.CreateMany", refreshTokensIndexesCreateManyError.Error())
+		refreshTokenRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
+// getRefreshTokenByQuery retrieves a refresh token based on the provided query from the database.
+func (refreshTokenRepository RefreshTokenRepository) getRefreshTokenByQuery(location string, ctx context.Context, query bson.M) common.Result[user.RefreshToken] {
+	fetchedRefreshToken := repository.RefreshTokenRepository{}
+	refreshTokenFindOneError := refreshTokenRepository.RefreshTokens.FindOne(ctx, query).Decode(&fetchedRefreshToken)
+	if validator.IsError(refreshTokenFindOneError) {
+		if utility.IsMongoDBError(refreshTokenFindOneError) {
+			internalError := domain.NewInternalError(location+".getRefreshTokenByQuery.FindOne.Decode", refreshTokenFindOneError.Error())
+			refreshTokenRepository.Logger.Error(internalError)
+			return common.NewResultOnFailure[user.RefreshToken](internalError)
+		}
+		invalidTokenError := domain.NewInvalidTokenError(location+".getRefreshTokenByQuery.FindOne.Decode", refreshTokenFindOneError.Error())
+		refreshTokenRepository.Logger.Error(invalidTokenError)
+		invalidTokenError.Notification = constants.InvalidTokenErrorMessage
+		return common.NewResultOnFailure[user.RefreshToken](invalidTokenError)
+	}
+
+	return common.NewResultOnSuccess[user.RefreshToken](repository.RefreshTokenRepositoryToRefreshTokenMapper(fetchedRefreshToken))
+}
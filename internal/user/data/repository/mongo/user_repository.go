@@ -2,13 +2,14 @@ package repository
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
 	repository "github.com/yachnytskyi/golang-mongo-grpc/internal/user/data/repository/mongo/model"
-	repositoryUtility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/data/repository/utility"
 	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
 	useCase "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/usecase"
+	domainUtility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
 	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
 	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
 	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
@@ -24,26 +25,66 @@ import (
 const (
 	location       = "user.data.repository.mongo."
 	emailKey       = "email"
+	usernameKey    = "username"
+	handleKey      = "handle"
 	passwordKey    = "password"
 	resetTokenKey  = "reset_token"
 	resetExpiryKey = "reset_expiry"
 
+	mustResetPasswordKey = "must_reset_password"
+
+	verifiedKey           = "verified"
+	verificationCodeKey   = "verification_code"
+	verificationExpiryKey = "verification_expiry"
+	updatedAtKey          = "updated_at"
+
+	roleKey             = "role"
+	suspendedKey        = "suspended"
+	suspensionReasonKey = "suspension_reason"
+	suspendedUntilKey   = "suspended_until"
+	createdAtKey        = "created_at"
+
+	twoFactorEnabledKey  = "two_factor_enabled"
+	twoFactorSecretKey   = "two_factor_secret"
+	twoFactorLastStepKey = "two_factor_last_step"
+	recoveryCodesKey     = "recovery_codes"
+	mfaTokenKey          = "mfa_token"
+	mfaExpiryKey         = "mfa_expiry"
+
+	pendingEmailKey           = "pending_email"
+	emailChangeTokenKey       = "email_change_token"
+	emailChangeCancelTokenKey = "email_change_cancel_token"
+	emailChangeExpiryKey      = "email_change_expiry"
+
+	magicLinkTokenKey  = "magic_link_token"
+	magicLinkExpiryKey = "magic_link_expiry"
+
+	handleIndexName = "handle_unique"
+
 	invalidEmailOrPassword = "Invalid email or password."
+	mfaTokenNotValid       = "The MFA token does not exist, has expired or has already been used."
+	emailChangeNotFound    = "There is no pending email change for the token."
+	magicLinkTokenNotValid = "The magic link token does not exist, has expired or has already been used."
 	emailOrPasswordFields  = "email or password"
 	passwordsDoNotMatch    = "Passwords do not match."
 )
 
+// handleCollation compares handles ignoring case, queries by handle have to use it to be served by the unique handle index.
+var handleCollation = &options.Collation{Locale: "en", Strength: 2}
+
 type UserRepository struct {
-	Config *config.ApplicationConfig
-	Logger interfaces.Logger
-	Users  *mongo.Collection
+	Config         *config.ApplicationConfig
+	Logger         interfaces.Logger
+	PasswordHasher interfaces.PasswordHasher
+	Users          *mongo.Collection
 }
 
-func NewUserRepository(config *config.ApplicationConfig, logger interfaces.Logger, database *mongo.Database) UserRepository {
+func NewUserRepository(config *config.ApplicationConfig, logger interfaces.Logger, passwordHasher interfaces.PasswordHasher, database *mongo.Database) UserRepository {
 	repository := UserRepository{
-		Config: config,
-		Logger: logger,
-		Users:  database.Collection(constants.UsersTable),
+		Config:         config,
+		Logger:         logger,
+		PasswordHasher: passwordHasher,
+		Users:          database.Collection(constants.UsersTable),
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultContextTimer)
@@ -55,16 +96,32 @@ func NewUserRepository(config *config.ApplicationConfig, logger interfaces.Logge
 		logger.Panic(domain.NewInternalError(location+"GetAllUsers.Users.CountDocuments", ensureUniqueEmailIndexError.Error()))
 	}
 
+	// Give the existing users a handle before the unique index on handles is created.
+	migrateUserHandlesError := repository.migrateUserHandles(ctx, location+"NewUserRepository")
+	if validator.IsError(migrateUserHandlesError) {
+		logger.Panic(domain.NewInternalError(location+"NewUserRepository.migrateUserHandles", migrateUserHandlesError.Error()))
+	}
+
+	ensureUniqueHandleIndexError := repository.ensureUniqueHandleIndex(ctx, location+"NewUserRepository")
+	if validator.IsError(ensureUniqueHandleIndexError) {
+		logger.Panic(domain.NewInternalError(location+"NewUserRepository.ensureUniqueHandleIndex", ensureUniqueHandleIndexError.Error()))
+	}
+
 	return repository
 }
 
 // GetAllUsers retrieves a list of users from the database based on pagination parameters.
 func (userRepository UserRepository) GetAllUsers(ctx context.Context, paginationQuery common.PaginationQuery) common.Result[user.Users] {
+	return userRepository.GetAllUsersByFilter(ctx, paginationQuery, user.UserFilter{})
+}
+
+// GetAllUsersByFilter retrieves a list of users matching the filter from the database based on pagination parameters.
+func (userRepository UserRepository) GetAllUsersByFilter(ctx context.Context, paginationQuery common.PaginationQuery, userFilter user.UserFilter) common.Result[user.Users] {
 	// Count the total number of users to set up pagination.
-	query := bson.M{}
+	query := userFilterQuery(userFilter)
 	totalUsers, countDocumentsError := userRepository.Users.CountDocuments(ctx, query)
 	if validator.IsError(countDocumentsError) {
-		internalError := domain.NewInternalError(location+"GetAllUsers.Users.CountDocuments", countDocumentsError.Error())
+		internalError := domain.NewInternalError(location+"GetAllUsersByFilter.Users.CountDocuments", countDocumentsError.Error())
 		userRepository.Logger.Error(internalError)
 		return common.NewResultOnFailure[user.Users](internalError)
 	}
@@ -82,11 +139,11 @@ func (userRepository UserRepository) GetAllUsers(ctx context.Context, pagination
 	cursor, usersFindError := userRepository.Users.Find(ctx, query, &option)
 	if validator.IsError(usersFindError) {
 		if utility.IsMongoDBError(usersFindError) {
-			internalError := domain.NewInternalError(location+"GetAllUsers.Find", usersFindError.Error())
+			internalError := domain.NewInternalError(location+"GetAllUsersByFilter.Find", usersFindError.Error())
 			userRepository.Logger.Error(internalError)
 			return common.NewResultOnFailure[user.Users](internalError)
 		}
-		itemNotFoundError := domain.NewItemNotFoundError(location+"GetAllUsers.Find", utility.BSONToStringMapper(query), usersFindError.Error())
+		itemNotFoundError := domain.NewItemNotFoundError(location+"GetAllUsersByFilter.Find", utility.BSONToStringMapper(query), usersFindError.Error())
 		userRepository.Logger.Error(itemNotFoundError)
 		return common.NewResultOnFailure[user.Users](itemNotFoundError)
 	}
@@ -98,7 +155,7 @@ func (userRepository UserRepository) GetAllUsers(ctx context.Context, pagination
 		userInstance := repository.UserRepository{}
 		decodeError := cursor.Decode(&userInstance)
 		if validator.IsError(decodeError) {
-			internalError := domain.NewInternalError(location+"GetAllUsers.cursor.decode", decodeError.Error())
+			internalError := domain.NewInternalError(location+"GetAllUsersByFilter.cursor.decode", decodeError.Error())
 			userRepository.Logger.Error(internalError)
 			return common.NewResultOnFailure[user.Users](internalError)
 		}
@@ -107,7 +164,7 @@ func (userRepository UserRepository) GetAllUsers(ctx context.Context, pagination
 
 	cursorError := cursor.Err()
 	if validator.IsError(cursorError) {
-		internalError := domain.NewInternalError(location+"GetAllUsers.cursor.Err", cursorError.Error())
+		internalError := domain.NewInternalError(location+"GetAllUsersByFilter.cursor.Err", cursorError.Error())
 		userRepository.Logger.Error(internalError)
 		return common.NewResultOnFailure[user.Users](internalError)
 	}
@@ -117,6 +174,18 @@ func (userRepository UserRepository) GetAllUsers(ctx context.Context, pagination
 	return common.NewResultOnSuccess[user.Users](repository.UsersRepositoryToUsersMapper(usersRepository))
 }
 
+// CountUsersByRole returns the number of users with the role.
+func (userRepository UserRepository) CountUsersByRole(ctx context.Context, role string) common.Result[int64] {
+	totalUsers, countDocumentsError := userRepository.Users.CountDocuments(ctx, bson.M{roleKey: role})
+	if validator.IsError(countDocumentsError) {
+		internalError := domain.NewInternalError(location+"CountUsersByRole.Users.CountDocuments", countDocumentsError.Error())
+		userRepository.Logger.Error(internalError)
+		return common.NewResultOnFailure[int64](internalError)
+	}
+
+	return common.NewResultOnSuccess[int64](totalUsers)
+}
+
 // GetUserById retrieves a user by their ID from the database.
 func (userRepository UserRepository) GetUserById(ctx context.Context, userID string) common.Result[user.User] {
 	userObjectID := model.HexToObjectIDMapper(userRepository.Logger, location+"GetUserById", userID)
@@ -128,6 +197,12 @@ func (userRepository UserRepository) GetUserById(ctx context.Context, userID str
 	return userRepository.getUserByQuery(location+"GetUserById", ctx, query)
 }
 
+// GetUserByHandle retrieves a user by their handle from the database, ignoring case.
+func (userRepository UserRepository) GetUserByHandle(ctx context.Context, handle string) common.Result[user.User] {
+	query := bson.M{handleKey: handle}
+	return userRepository.getUserByQuery(location+"GetUserByHandle", ctx, query, options.FindOne().SetCollation(handleCollation))
+}
+
 // GetUserByEmail retrieves a user by their email from the database.
 func (userRepository UserRepository) GetUserByEmail(ctx context.Context, email string) common.Result[user.User] {
 	fetchedUser := repository.UserRepository{}
@@ -172,10 +247,35 @@ func (userRepository UserRepository) CheckEmailDuplicate(ctx context.Context, em
 	return validationError
 }
 
+// CheckUsernameDuplicate checks if the handle of a username is already taken by another user than the provided one,
+// the user ID is empty for a new user. Handles are compared ignoring case.
+func (userRepository UserRepository) CheckUsernameDuplicate(ctx context.Context, userID, handle string) error {
+	query := bson.M{handleKey: handle}
+	if validator.IsValueNotEmpty(userID) {
+		userObjectID := model.HexToObjectIDMapper(userRepository.Logger, location+"CheckUsernameDuplicate", userID)
+		if validator.IsError(userObjectID.Error) {
+			return userObjectID.Error
+		}
+		query[model.ID] = bson.M{model.NotEqual: userObjectID.Data}
+	}
+
+	count, countDocumentsError := userRepository.Users.CountDocuments(ctx, query, options.Count().SetCollation(handleCollation))
+	if validator.IsError(countDocumentsError) {
+		internalError := domain.NewInternalError(location+"CheckUsernameDuplicate.CountDocuments", countDocumentsError.Error())
+		userRepository.Logger.Error(internalError)
+		return internalError
+	}
+	if count == 0 {
+		return nil
+	}
+
+	return userRepository.usernameDuplicateError(location + "CheckUsernameDuplicate")
+}
+
 // Register creates a user in the database based on the provided UserCreate data.
 func (userRepository UserRepository) Register(ctx context.Context, userCreate user.UserCreate) common.Result[user.User] {
 	userCreateRepository := repository.UserCreateToUserCreateRepositoryMapper(userCreate)
-	hashedPassword := repositoryUtility.HashPassword(userRepository.Logger, location+"Register", userCreateRepository.Password)
+	hashedPassword := userRepository.PasswordHasher.HashPassword(location+"Register", userCreateRepository.Password)
 	if validator.IsError(hashedPassword.Error) {
 		return common.NewResultOnFailure[user.User](hashedPassword.Error)
 	}
@@ -185,6 +285,15 @@ func (userRepository UserRepository) Register(ctx context.Context, userCreate us
 	userCreateRepository.UpdatedAt = time.Now()
 	insertOneResult, insertOneResultError := userRepository.Users.InsertOne(ctx, &userCreateRepository)
 	if validator.IsError(insertOneResultError) {
+		// The unique indexes stay authoritative, a username or an email taken in the meantime is reported as a duplicate.
+		if mongo.IsDuplicateKeyError(insertOneResultError) {
+			if strings.Contains(insertOneResultError.Error(), handleIndexName) {
+				return common.NewResultOnFailure[user.User](userRepository.usernameDuplicateError(location + "Register.InsertOne"))
+			}
+			validationError := domain.NewValidationError(location+"Register.InsertOne", useCase.EmailField, constants.FieldRequired, constants.EmailAlreadyExists)
+			userRepository.Logger.Error(validationError)
+			return common.NewResultOnFailure[user.User](validationError)
+		}
 		internalError := domain.NewInternalError(location+"Register.InsertOne", insertOneResultError.Error())
 		userRepository.Logger.Error(internalError)
 		return common.NewResultOnFailure[user.User](internalError)
@@ -213,6 +322,9 @@ func (userRepository UserRepository) UpdateCurrentUser(ctx context.Context, user
 	updatedUser := repository.UserRepository{}
 	decodeError := result.Decode(&updatedUser)
 	if validator.IsError(decodeError) {
+		if mongo.IsDuplicateKeyError(decodeError) {
+			return common.NewResultOnFailure[user.User](userRepository.usernameDuplicateError(location + "UpdateCurrentUser.Decode"))
+		}
 		internalError := domain.NewInternalError(location+"UpdateCurrentUser.Decode", decodeError.Error())
 		userRepository.Logger.Error(internalError)
 		return common.NewResultOnFailure[user.User](internalError)
@@ -221,6 +333,44 @@ func (userRepository UserRepository) UpdateCurrentUser(ctx context.Context, user
 	return common.NewResultOnSuccess[user.User](repository.UserRepositoryToUserMapper(updatedUser))
 }
 
+// UpdatePassword hashes and stores the new password of the user.
+func (userRepository UserRepository) UpdatePassword(ctx context.Context, userPasswordUpdate user.UserPasswordUpdate) error {
+	hashedPassword := userRepository.PasswordHasher.HashPassword(location+"UpdatePassword", userPasswordUpdate.Password)
+	if validator.IsError(hashedPassword.Error) {
+		return hashedPassword.Error
+	}
+
+	return userRepository.updateUserFields(location+"UpdatePassword", ctx, userPasswordUpdate.ID, bson.D{{Key: passwordKey, Value: hashedPassword.Data}})
+}
+
+// RehashPassword replaces the password hash with a hash of the current algorithm and parameters.
+// The hash is only replaced if it has not changed in the meantime, so a concurrent password change is kept.
+func (userRepository UserRepository) RehashPassword(ctx context.Context, userID, currentHashedPassword, password string) error {
+	userObjectID := model.HexToObjectIDMapper(userRepository.Logger, location+"RehashPassword", userID)
+	if validator.IsError(userObjectID.Error) {
+		return userObjectID.Error
+	}
+
+	hashedPassword := userRepository.PasswordHasher.HashPassword(location+"RehashPassword", password)
+	if validator.IsError(hashedPassword.Error) {
+		return hashedPassword.Error
+	}
+
+	query := bson.M{
+		model.ID:    userObjectID.Data,
+		passwordKey: currentHashedPassword,
+	}
+	update := bson.D{{Key: model.Set, Value: bson.D{{Key: passwordKey, Value: hashedPassword.Data}}}}
+	_, updateOneError := userRepository.Users.UpdateOne(ctx, query, update)
+	if validator.IsError(updateOneError) {
+		internalError := domain.NewInternalError(location+"RehashPassword.UpdateOne", updateOneError.Error())
+		userRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
 // DeleteUserById deletes a user in the database based on the provided userID.
 func (userRepository UserRepository) DeleteUserById(ctx context.Context, userID string) error {
 	userObjectID := model.HexToObjectIDMapper(userRepository.Logger, location+"GetUserById", userID)
@@ -266,6 +416,7 @@ func (userRepository UserRepository) GetResetExpiry(ctx context.Context, token s
 }
 
 // ForgottenPassword updates a user's record with a reset token and expiration time.
+// A forced reset also marks the password as one that must be reset, a reset requested by the user leaves the mark as it is.
 func (userRepository UserRepository) ForgottenPassword(ctx context.Context, userForgottenPassword user.UserForgottenPassword) error {
 	userForgottenPasswordRepository := repository.UserForgottenPasswordToUserForgottenPasswordRepositoryMapper(userForgottenPassword)
 	userForgottenPasswordBSON := model.DataToMongoDocumentMapper(userRepository.Logger, location+"ForgottenPassword", userForgottenPasswordRepository)
@@ -293,7 +444,7 @@ func (userRepository UserRepository) ForgottenPassword(ctx context.Context, user
 // ResetUserPassword updates a user's password based on the provided reset token and new password.
 func (userRepository UserRepository) ResetUserPassword(ctx context.Context, userResetPassword user.UserResetPassword) error {
 	userResetPasswordRepository := repository.UserResetPasswordToUserResetPasswordRepositoryMapper(userResetPassword)
-	hashedPassword := repositoryUtility.HashPassword(userRepository.Logger, location+"ResetUserPassword", userResetPassword.Password)
+	hashedPassword := userRepository.PasswordHasher.HashPassword(location+"ResetUserPassword", userResetPassword.Password)
 	if validator.IsError(hashedPassword.Error) {
 		return hashedPassword.Error
 	}
@@ -312,6 +463,7 @@ func (userRepository UserRepository) ResetUserPassword(ctx context.Context, user
 		{Key: model.Unset, Value: bson.D{
 			{Key: resetTokenKey, Value: ""},
 			{Key: resetExpiryKey, Value: ""},
+			{Key: mustResetPasswordKey, Value: ""},
 		}},
 	}
 
@@ -330,6 +482,471 @@ func (userRepository UserRepository) ResetUserPassword(ctx context.Context, user
 	return nil
 }
 
+// RequestEmailChange stores a pending email change together with its hashed confirmation and cancellation tokens.
+// A previous pending change of the user is replaced.
+func (userRepository UserRepository) RequestEmailChange(ctx context.Context, userEmailChange user.UserEmailChange) error {
+	userEmailChangeRepository := repository.UserEmailChangeToUserEmailChangeRepositoryMapper(userEmailChange)
+	userEmailChangeBSON := model.DataToMongoDocumentMapper(userRepository.Logger, location+"RequestEmailChange", userEmailChangeRepository)
+	if validator.IsError(userEmailChangeBSON.Error) {
+		return userEmailChangeBSON.Error
+	}
+
+	userObjectID := model.HexToObjectIDMapper(userRepository.Logger, location+"RequestEmailChange", userEmailChange.ID)
+	if validator.IsError(userObjectID.Error) {
+		return userObjectID.Error
+	}
+
+	query := bson.M{model.ID: userObjectID.Data}
+	update := bson.D{{Key: model.Set, Value: userEmailChangeBSON.Data}}
+	return userRepository.updateUser(location+"RequestEmailChange", ctx, query, update)
+}
+
+// GetEmailChange retrieves the pending email change by its hashed confirmation token.
+func (userRepository UserRepository) GetEmailChange(ctx context.Context, confirmationToken string) common.Result[user.UserEmailChange] {
+	fetchedEmailChange := repository.UserEmailChangeRepository{}
+	query := bson.M{emailChangeTokenKey: confirmationToken}
+
+	userFindOneError := userRepository.Users.FindOne(ctx, query).Decode(&fetchedEmailChange)
+	if validator.IsError(userFindOneError) {
+		if utility.IsMongoDBError(userFindOneError) {
+			internalError := domain.NewInternalError(location+"GetEmailChange.FindOne.Decode", userFindOneError.Error())
+			userRepository.Logger.Error(internalError)
+			return common.NewResultOnFailure[user.UserEmailChange](internalError)
+		}
+		invalidTokenError := domain.NewInvalidTokenError(location+"GetEmailChange.Decode", emailChangeNotFound)
+		userRepository.Logger.Error(invalidTokenError)
+		invalidTokenError.Notification = constants.InvalidTokenErrorMessage
+		return common.NewResultOnFailure[user.UserEmailChange](invalidTokenError)
+	}
+
+	return common.NewResultOnSuccess[user.UserEmailChange](repository.UserEmailChangeRepositoryToUserEmailChangeMapper(fetchedEmailChange))
+}
+
+// ConfirmEmailChange applies the pending email change matching the hashed confirmation token, if it has not expired.
+// The unique email index stays authoritative, an address taken in the meantime is reported as a duplicate.
+func (userRepository UserRepository) ConfirmEmailChange(ctx context.Context, userEmailChange user.UserEmailChange) error {
+	query := bson.M{
+		emailChangeTokenKey:  userEmailChange.ConfirmationToken,
+		pendingEmailKey:      userEmailChange.Email,
+		emailChangeExpiryKey: bson.M{model.GreaterThan: time.Now()},
+	}
+	update := bson.D{
+		{Key: model.Set, Value: bson.D{
+			{Key: emailKey, Value: userEmailChange.Email},
+			{Key: verifiedKey, Value: true},
+			{Key: updatedAtKey, Value: time.Now()},
+		}},
+		{Key: model.Unset, Value: emailChangeFields()},
+	}
+
+	result, updateOneError := userRepository.Users.UpdateOne(ctx, query, update)
+	if validator.IsError(updateOneError) {
+		if mongo.IsDuplicateKeyError(updateOneError) {
+			validationError := domain.NewValidationError(location+"ConfirmEmailChange.UpdateOne", useCase.EmailField, constants.FieldRequired, constants.EmailAlreadyExists)
+			userRepository.Logger.Error(validationError)
+			return validationError
+		}
+		internalError := domain.NewInternalError(location+"ConfirmEmailChange.UpdateOne", updateOneError.Error())
+		userRepository.Logger.Error(internalError)
+		return internalError
+	}
+	if result.MatchedCount == 0 {
+		invalidTokenError := domain.NewInvalidTokenError(location+"ConfirmEmailChange.UpdateOne.MatchedCount", emailChangeNotFound)
+		userRepository.Logger.Error(invalidTokenError)
+		invalidTokenError.Notification = constants.InvalidTokenErrorMessage
+		return invalidTokenError
+	}
+
+	return nil
+}
+
+// CancelEmailChange removes the pending email change matching the hashed cancellation token.
+func (userRepository UserRepository) CancelEmailChange(ctx context.Context, cancelToken string) error {
+	query := bson.M{emailChangeCancelTokenKey: cancelToken}
+	update := bson.D{
+		{Key: model.Set, Value: bson.D{{Key: updatedAtKey, Value: time.Now()}}},
+		{Key: model.Unset, Value: emailChangeFields()},
+	}
+
+	result, updateOneError := userRepository.Users.UpdateOne(ctx, query, update)
+	if validator.IsError(updateOneError) {
+		internalError := domain.NewInternalError(location+"CancelEmailChange.UpdateOne", updateOneError.Error())
+		userRepository.Logger.Error(internalError)
+		return internalError
+	}
+	if result.MatchedCount == 0 {
+		invalidTokenError := domain.NewInvalidTokenError(location+"CancelEmailChange.UpdateOne.MatchedCount", emailChangeNotFound)
+		userRepository.Logger.Error(invalidTokenError)
+		invalidTokenError.Notification = constants.InvalidTokenErrorMessage
+		return invalidTokenError
+	}
+
+	return nil
+}
+
+// GetVerificationExpiry retrieves the expiry of the provided email verification code from the database.
+func (userRepository UserRepository) GetVerificationExpiry(ctx context.Context, verificationCode string) common.Result[user.UserVerificationExpiry] {
+	fetchedVerificationExpiry := repository.UserVerificationExpiryRepository{}
+	query := bson.M{verificationCodeKey: verificationCode}
+
+	userFindOneError := userRepository.Users.FindOne(ctx, query).Decode(&fetchedVerificationExpiry)
+	if validator.IsError(userFindOneError) {
+		if utility.IsMongoDBError(userFindOneError) {
+			internalError := domain.NewInternalError(location+"GetVerificationExpiry.FindOne.Decode", userFindOneError.Error())
+			userRepository.Logger.Error(internalError)
+			return common.NewResultOnFailure[user.UserVerificationExpiry](internalError)
+		}
+		invalidTokenError := domain.NewInvalidTokenError(location+"GetVerificationExpiry.Decode", userFindOneError.Error())
+		userRepository.Logger.Error(invalidTokenError)
+		invalidTokenError.Notification = constants.InvalidTokenErrorMessage
+		return common.NewResultOnFailure[user.UserVerificationExpiry](invalidTokenError)
+	}
+
+	return common.NewResultOnSuccess[user.UserVerificationExpiry](repository.UserVerificationExpiryRepositoryToUserVerificationExpiryMapper(fetchedVerificationExpiry))
+}
+
+// UpdateVerificationCode replaces a user's email verification code and its expiration time.
+func (userRepository UserRepository) UpdateVerificationCode(ctx context.Context, userVerificationCode user.UserVerificationCode) error {
+	userVerificationCodeRepository := repository.UserVerificationCodeToUserVerificationCodeRepositoryMapper(userVerificationCode)
+	userVerificationCodeBSON := model.DataToMongoDocumentMapper(userRepository.Logger, location+"UpdateVerificationCode", userVerificationCodeRepository)
+	if validator.IsError(userVerificationCodeBSON.Error) {
+		return userVerificationCodeBSON.Error
+	}
+
+	query := bson.D{{Key: emailKey, Value: userVerificationCode.Email}}
+	update := bson.D{{Key: model.Set, Value: userVerificationCodeBSON.Data}}
+	result, updateOneError := userRepository.Users.UpdateOne(ctx, query, update)
+	if validator.IsError(updateOneError) {
+		internalError := domain.NewInternalError(location+"UpdateVerificationCode.UpdateOne", updateOneError.Error())
+		userRepository.Logger.Error(internalError)
+		return internalError
+	}
+	if result.ModifiedCount == 0 {
+		internalError := domain.NewInternalError(location+"UpdateVerificationCode.UpdateOne.ModifiedCount", model.UpdateIsNotSuccessful)
+		userRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
+// VerifyEmail marks the user owning the provided verification code as verified and removes the code.
+func (userRepository UserRepository) VerifyEmail(ctx context.Context, verificationCode string) error {
+	query := bson.D{{Key: verificationCodeKey, Value: verificationCode}}
+	update := bson.D{
+		{Key: model.Set, Value: bson.D{
+			{Key: verifiedKey, Value: true},
+			{Key: updatedAtKey, Value: time.Now()},
+		}},
+		{Key: model.Unset, Value: bson.D{
+			{Key: verificationCodeKey, Value: ""},
+			{Key: verificationExpiryKey, Value: ""},
+		}},
+	}
+
+	result, updateOneError := userRepository.Users.UpdateOne(ctx, query, update)
+	if validator.IsError(updateOneError) {
+		internalError := domain.NewInternalError(location+"VerifyEmail.UpdateOne", updateOneError.Error())
+		userRepository.Logger.Error(internalError)
+		return internalError
+	}
+	if result.ModifiedCount == 0 {
+		internalError := domain.NewInternalError(location+"VerifyEmail.UpdateOne.ModifiedCount", model.UpdateIsNotSuccessful)
+		userRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
+// UpdateUserRole changes the role of the user.
+func (userRepository UserRepository) UpdateUserRole(ctx context.Context, userRoleUpdate user.UserRoleUpdate) error {
+	update := bson.D{{Key: roleKey, Value: userRoleUpdate.Role}}
+	return userRepository.updateUserFields(location+"UpdateUserRole", ctx, userRoleUpdate.ID, update)
+}
+
+// UpdateUserVerification marks the user as verified or unverified.
+func (userRepository UserRepository) UpdateUserVerification(ctx context.Context, userID string, verified bool) error {
+	update := bson.D{{Key: verifiedKey, Value: verified}}
+	return userRepository.updateUserFields(location+"UpdateUserVerification", ctx, userID, update)
+}
+
+// SuspendUser suspends the user with the provided reason, a zero SuspendedUntil means a permanent suspension.
+func (userRepository UserRepository) SuspendUser(ctx context.Context, userSuspension user.UserSuspension) error {
+	userObjectID := model.HexToObjectIDMapper(userRepository.Logger, location+"SuspendUser", userSuspension.ID)
+	if validator.IsError(userObjectID.Error) {
+		return userObjectID.Error
+	}
+
+	set := bson.D{
+		{Key: suspendedKey, Value: true},
+		{Key: suspensionReasonKey, Value: userSuspension.Reason},
+		{Key: updatedAtKey, Value: time.Now()},
+	}
+	if !userSuspension.SuspendedUntil.IsZero() {
+		set = append(set, bson.E{Key: suspendedUntilKey, Value: userSuspension.SuspendedUntil})
+	}
+
+	update := bson.D{{Key: model.Set, Value: set}}
+	if userSuspension.SuspendedUntil.IsZero() {
+		update = append(update, bson.E{Key: model.Unset, Value: bson.D{{Key: suspendedUntilKey, Value: ""}}})
+	}
+
+	query := bson.M{model.ID: userObjectID.Data}
+	return userRepository.updateUser(location+"SuspendUser", ctx, query, update)
+}
+
+// UnsuspendUser lifts the suspension of the user and removes its reason and expiry.
+func (userRepository UserRepository) UnsuspendUser(ctx context.Context, userID string) error {
+	userObjectID := model.HexToObjectIDMapper(userRepository.Logger, location+"UnsuspendUser", userID)
+	if validator.IsError(userObjectID.Error) {
+		return userObjectID.Error
+	}
+
+	query := bson.M{model.ID: userObjectID.Data}
+	return userRepository.updateUser(location+"UnsuspendUser", ctx, query, unsuspendUserUpdate())
+}
+
+// LiftExpiredSuspension lifts the suspension of the user only if it is temporary and has already expired,
+// so a newer suspension set in the meantime is kept.
+func (userRepository UserRepository) LiftExpiredSuspension(ctx context.Context, userID string) error {
+	userObjectID := model.HexToObjectIDMapper(userRepository.Logger, location+"LiftExpiredSuspension", userID)
+	if validator.IsError(userObjectID.Error) {
+		return userObjectID.Error
+	}
+
+	query := bson.M{
+		model.ID:          userObjectID.Data,
+		suspendedKey:      true,
+		suspendedUntilKey: bson.M{model.LessThanOrEqual: time.Now()},
+	}
+	_, updateOneError := userRepository.Users.UpdateOne(ctx, query, unsuspendUserUpdate())
+	if validator.IsError(updateOneError) {
+		internalError := domain.NewInternalError(location+"LiftExpiredSuspension.UpdateOne", updateOneError.Error())
+		userRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
+// EnrollTwoFactor stores the TOTP secret and the hashed recovery codes of a pending enrollment.
+// Two-factor authentication stays disabled until the enrollment is confirmed, and an enabled one is never replaced.
+func (userRepository UserRepository) EnrollTwoFactor(ctx context.Context, userTwoFactorEnrollment user.UserTwoFactorEnrollment) error {
+	userObjectID := model.HexToObjectIDMapper(userRepository.Logger, location+"EnrollTwoFactor", userTwoFactorEnrollment.ID)
+	if validator.IsError(userObjectID.Error) {
+		return userObjectID.Error
+	}
+
+	// Recovery codes are stored hashed like passwords, so they cannot be read back from the database.
+	hashedRecoveryCodes := make([]string, 0, len(userTwoFactorEnrollment.RecoveryCodes))
+	for _, recoveryCode := range userTwoFactorEnrollment.RecoveryCodes {
+		hashedRecoveryCode := userRepository.PasswordHasher.HashPassword(location+"EnrollTwoFactor", recoveryCode)
+		if validator.IsError(hashedRecoveryCode.Error) {
+			return hashedRecoveryCode.Error
+		}
+
+		hashedRecoveryCodes = append(hashedRecoveryCodes, hashedRecoveryCode.Data)
+	}
+
+	query := bson.M{
+		model.ID:            userObjectID.Data,
+		twoFactorEnabledKey: bson.M{model.NotEqual: true},
+	}
+	update := bson.D{{Key: model.Set, Value: bson.D{
+		{Key: twoFactorEnabledKey, Value: false},
+		{Key: twoFactorSecretKey, Value: userTwoFactorEnrollment.Secret},
+		{Key: recoveryCodesKey, Value: hashedRecoveryCodes},
+		{Key: updatedAtKey, Value: time.Now()},
+	}}}
+	return userRepository.updateUser(location+"EnrollTwoFactor", ctx, query, update)
+}
+
+// EnableTwoFactor enables two-factor authentication after the enrollment has been confirmed.
+func (userRepository UserRepository) EnableTwoFactor(ctx context.Context, userID string) error {
+	return userRepository.updateUserFields(location+"EnableTwoFactor", ctx, userID, bson.D{{Key: twoFactorEnabledKey, Value: true}})
+}
+
+// DisableTwoFactor disables two-factor authentication and removes the secret, the recovery codes and a pending MFA token.
+func (userRepository UserRepository) DisableTwoFactor(ctx context.Context, userID string) error {
+	userObjectID := model.HexToObjectIDMapper(userRepository.Logger, location+"DisableTwoFactor", userID)
+	if validator.IsError(userObjectID.Error) {
+		return userObjectID.Error
+	}
+
+	query := bson.M{model.ID: userObjectID.Data}
+	update := bson.D{
+		{Key: model.Set, Value: bson.D{
+			{Key: twoFactorEnabledKey, Value: false},
+			{Key: updatedAtKey, Value: time.Now()},
+		}},
+		{Key: model.Unset, Value: bson.D{
+			{Key: twoFactorSecretKey, Value: ""},
+			{Key: recoveryCodesKey, Value: ""},
+			{Key: mfaTokenKey, Value: ""},
+			{Key: mfaExpiryKey, Value: ""},
+		}},
+	}
+	return userRepository.updateUser(location+"DisableTwoFactor", ctx, query, update)
+}
+
+// UpdateTwoFactorLastStep stores the TOTP time step of an accepted code, only if it is later than the stored one.
+// Two requests with the same code race for the same update, so only one of them gets the code accepted.
+func (userRepository UserRepository) UpdateTwoFactorLastStep(ctx context.Context, userID string, step int64) error {
+	userObjectID := model.HexToObjectIDMapper(userRepository.Logger, location+"UpdateTwoFactorLastStep", userID)
+	if validator.IsError(userObjectID.Error) {
+		return userObjectID.Error
+	}
+
+	query := bson.M{
+		model.ID: userObjectID.Data,
+		model.Or: bson.A{
+			bson.M{twoFactorLastStepKey: bson.M{model.LessThan: step}},
+			bson.M{twoFactorLastStepKey: bson.M{model.Exists: false}},
+		},
+	}
+	update := bson.D{{Key: model.Set, Value: bson.D{
+		{Key: twoFactorLastStepKey, Value: step},
+		{Key: updatedAtKey, Value: time.Now()},
+	}}}
+	return userRepository.updateUser(location+"UpdateTwoFactorLastStep", ctx, query, update)
+}
+
+// UpdateMFAToken stores the hashed MFA token of a login that is waiting for the second factor.
+func (userRepository UserRepository) UpdateMFAToken(ctx context.Context, userMFAToken user.UserMFAToken) error {
+	fields := bson.D{
+		{Key: mfaTokenKey, Value: userMFAToken.MFAToken},
+		{Key: mfaExpiryKey, Value: userMFAToken.MFAExpiry},
+	}
+	return userRepository.updateUserFields(location+"UpdateMFAToken", ctx, userMFAToken.ID, fields)
+}
+
+// GetUserByMFAToken retrieves the user of a pending login by the hashed MFA token, as long as the token has not expired.
+func (userRepository UserRepository) GetUserByMFAToken(ctx context.Context, mfaToken string) common.Result[user.User] {
+	fetchedUser := repository.UserRepository{}
+	query := bson.M{
+		mfaTokenKey:  mfaToken,
+		mfaExpiryKey: bson.M{model.GreaterThan: time.Now()},
+	}
+
+	userFindOneError := userRepository.Users.FindOne(ctx, query).Decode(&fetchedUser)
+	if validator.IsError(userFindOneError) {
+		if utility.IsMongoDBError(userFindOneError) {
+			internalError := domain.NewInternalError(location+"GetUserByMFAToken.FindOne.Decode", userFindOneError.Error())
+			userRepository.Logger.Error(internalError)
+			return common.NewResultOnFailure[user.User](internalError)
+		}
+		invalidTokenError := domain.NewInvalidTokenError(location+"GetUserByMFAToken.Decode", mfaTokenNotValid)
+		userRepository.Logger.Error(invalidTokenError)
+		invalidTokenError.Notification = constants.InvalidTokenErrorMessage
+		return common.NewResultOnFailure[user.User](invalidTokenError)
+	}
+
+	return common.NewResultOnSuccess[user.User](repository.UserRepositoryToUserMapper(fetchedUser))
+}
+
+// CompleteTwoFactorLogin removes the hashed MFA token and the hashed recovery code used for the login, if any.
+// Both are matched in the same update, so neither the token nor the recovery code can be used twice.
+func (userRepository UserRepository) CompleteTwoFactorLogin(ctx context.Context, mfaToken, recoveryCode string) error {
+	query := bson.M{mfaTokenKey: mfaToken}
+	update := bson.D{
+		{Key: model.Set, Value: bson.D{{Key: updatedAtKey, Value: time.Now()}}},
+		{Key: model.Unset, Value: bson.D{
+			{Key: mfaTokenKey, Value: ""},
+			{Key: mfaExpiryKey, Value: ""},
+		}},
+	}
+	if validator.IsValueNotEmpty(recoveryCode) {
+		query[recoveryCodesKey] = recoveryCode
+		update = append(update, bson.E{Key: model.Pull, Value: bson.D{{Key: recoveryCodesKey, Value: recoveryCode}}})
+	}
+
+	result, updateOneError := userRepository.Users.UpdateOne(ctx, query, update)
+	if validator.IsError(updateOneError) {
+		internalError := domain.NewInternalError(location+"CompleteTwoFactorLogin.UpdateOne", updateOneError.Error())
+		userRepository.Logger.Error(internalError)
+		return internalError
+	}
+	if result.MatchedCount == 0 {
+		invalidTokenError := domain.NewInvalidTokenError(location+"CompleteTwoFactorLogin.UpdateOne.MatchedCount", mfaTokenNotValid)
+		userRepository.Logger.Error(invalidTokenError)
+		invalidTokenError.Notification = constants.InvalidTokenErrorMessage
+		return invalidTokenError
+	}
+
+	return nil
+}
+
+// UpdateMagicLinkToken stores the hashed token of a magic login link, replacing the previous link of the user.
+func (userRepository UserRepository) UpdateMagicLinkToken(ctx context.Context, userMagicLink user.UserMagicLink) error {
+	fields := bson.D{
+		{Key: magicLinkTokenKey, Value: userMagicLink.MagicLinkToken},
+		{Key: magicLinkExpiryKey, Value: userMagicLink.MagicLinkExpiry},
+	}
+	return userRepository.updateUserFields(location+"UpdateMagicLinkToken", ctx, userMagicLink.ID, fields)
+}
+
+// ConsumeMagicLinkToken retrieves the user by the hashed magic link token and removes the token in the same operation,
+// so a link can only be used once. Expired tokens are not matched.
+func (userRepository UserRepository) ConsumeMagicLinkToken(ctx context.Context, magicLinkToken string) common.Result[user.User] {
+	fetchedUser := repository.UserRepository{}
+	query := bson.M{
+		magicLinkTokenKey:  magicLinkToken,
+		magicLinkExpiryKey: bson.M{model.GreaterThan: time.Now()},
+	}
+	update := bson.D{
+		{Key: model.Set, Value: bson.D{{Key: updatedAtKey, Value: time.Now()}}},
+		{Key: model.Unset, Value: bson.D{
+			{Key: magicLinkTokenKey, Value: ""},
+			{Key: magicLinkExpiryKey, Value: ""},
+		}},
+	}
+
+	userFindOneAndUpdateError := userRepository.Users.FindOneAndUpdate(ctx, query, update).Decode(&fetchedUser)
+	if validator.IsError(userFindOneAndUpdateError) {
+		if utility.IsMongoDBError(userFindOneAndUpdateError) {
+			internalError := domain.NewInternalError(location+"ConsumeMagicLinkToken.FindOneAndUpdate.Decode", userFindOneAndUpdateError.Error())
+			userRepository.Logger.Error(internalError)
+			return common.NewResultOnFailure[user.User](internalError)
+		}
+		invalidTokenError := domain.NewInvalidTokenError(location+"ConsumeMagicLinkToken.Decode", magicLinkTokenNotValid)
+		userRepository.Logger.Error(invalidTokenError)
+		invalidTokenError.Notification = constants.InvalidTokenErrorMessage
+		return common.NewResultOnFailure[user.User](invalidTokenError)
+	}
+
+	return common.NewResultOnSuccess[user.User](repository.UserRepositoryToUserMapper(fetchedUser))
+}
+
+// updateUserFields sets the provided fields on the user with the provided ID.
+func (userRepository UserRepository) updateUserFields(location string, ctx context.Context, userID string, fields bson.D) error {
+	userObjectID := model.HexToObjectIDMapper(userRepository.Logger, location+".updateUserFields", userID)
+	if validator.IsError(userObjectID.Error) {
+		return userObjectID.Error
+	}
+
+	query := bson.M{model.ID: userObjectID.Data}
+	update := bson.D{{Key: model.Set, Value: append(fields, bson.E{Key: updatedAtKey, Value: time.Now()})}}
+	return userRepository.updateUser(location+".updateUserFields", ctx, query, update)
+}
+
+// updateUser applies the provided update to the user matching the query.
+func (userRepository UserRepository) updateUser(location string, ctx context.Context, query bson.M, update bson.D) error {
+	result, updateOneError := userRepository.Users.UpdateOne(ctx, query, update)
+	if validator.IsError(updateOneError) {
+		internalError := domain.NewInternalError(location+".updateUser.UpdateOne", updateOneError.Error())
+		userRepository.Logger.Error(internalError)
+		return internalError
+	}
+	if result.MatchedCount == 0 {
+		itemNotFoundError := domain.NewItemNotFoundError(location+".updateUser.UpdateOne.MatchedCount", utility.BSONToStringMapper(query), model.UpdateIsNotSuccessful)
+		userRepository.Logger.Error(itemNotFoundError)
+		return itemNotFoundError
+	}
+
+	return nil
+}
+
 // ensureUniqueEmailIndex creates a unique index on the email field to enforce email uniqueness in the database.
 func (userRepository UserRepository) ensureUniqueEmailIndex(ctx context.Context, location string) error {
 	option := options.Index()
@@ -346,10 +963,100 @@ func (userRepository UserRepository) ensureUniqueEmailIndex(ctx context.Context,
 	return nil
 }
 
+// ensureUniqueHandleIndex creates a unique index on the handle field, which compares handles ignoring case.
+func (userRepository UserRepository) ensureUniqueHandleIndex(ctx context.Context, location string) error {
+	option := options.Index()
+	option.SetUnique(true)
+	option.SetName(handleIndexName)
+	option.SetCollation(handleCollation)
+
+	index := mongo.IndexModel{Keys: bson.M{handleKey: 1}, Options: option}
+	_, userIndexesCreateOneError := userRepository.Users.Indexes().CreateOne(ctx, index)
+	if validator.IsError(userIndexesCreateOneError) {
+		internalError := domain.NewInternalError(location+".ensureUniqueHandleIndex.Indexes.CreateOne", userIndexesCreateOneError.Error())
+		userRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
+// migrateUserHandles gives the users created before handles were introduced the handle of their username.
+// The oldest users are migrated first, so they keep the plain handle and the later users sharing it get a numbered suffix.
+// The usernames themselves are kept.
+func (userRepository UserRepository) migrateUserHandles(ctx context.Context, location string) error {
+	query := bson.M{handleKey: bson.M{model.Exists: false}}
+	option := options.Find()
+	option.SetSort(bson.D{{Key: createdAtKey, Value: 1}, {Key: model.ID, Value: 1}})
+	option.SetProjection(bson.M{usernameKey: 1})
+	cursor, usersFindError := userRepository.Users.Find(ctx, query, option)
+	if validator.IsError(usersFindError) {
+		internalError := domain.NewInternalError(location+".migrateUserHandles.Find", usersFindError.Error())
+		userRepository.Logger.Error(internalError)
+		return internalError
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		userInstance := repository.UserRepository{}
+		decodeError := cursor.Decode(&userInstance)
+		if validator.IsError(decodeError) {
+			internalError := domain.NewInternalError(location+".migrateUserHandles.cursor.Decode", decodeError.Error())
+			userRepository.Logger.Error(internalError)
+			return internalError
+		}
+
+		handle := userRepository.findFreeHandle(ctx, location+".migrateUserHandles", domainUtility.NormalizeHandle(userInstance.Username))
+		if validator.IsError(handle.Error) {
+			return handle.Error
+		}
+
+		update := bson.M{model.Set: bson.M{handleKey: handle.Data}}
+		_, updateOneError := userRepository.Users.UpdateOne(ctx, bson.M{model.ID: userInstance.ID}, update)
+		if validator.IsError(updateOneError) {
+			internalError := domain.NewInternalError(location+".migrateUserHandles.UpdateOne", updateOneError.Error())
+			userRepository.Logger.Error(internalError)
+			return internalError
+		}
+	}
+
+	cursorError := cursor.Err()
+	if validator.IsError(cursorError) {
+		internalError := domain.NewInternalError(location+".migrateUserHandles.cursor.Err", cursorError.Error())
+		userRepository.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
+// findFreeHandle returns the first candidate of the handle that no user has yet.
+func (userRepository UserRepository) findFreeHandle(ctx context.Context, location, handle string) common.Result[string] {
+	for attempt := 1; ; attempt++ {
+		candidate := domainUtility.HandleCandidate(handle, attempt)
+		count, countDocumentsError := userRepository.Users.CountDocuments(ctx, bson.M{handleKey: candidate}, options.Count().SetCollation(handleCollation))
+		if validator.IsError(countDocumentsError) {
+			internalError := domain.NewInternalError(location+".findFreeHandle.CountDocuments", countDocumentsError.Error())
+			userRepository.Logger.Error(internalError)
+			return common.NewResultOnFailure[string](internalError)
+		}
+		if count == 0 {
+			return common.NewResultOnSuccess[string](candidate)
+		}
+	}
+}
+
+// usernameDuplicateError reports a username whose handle is already taken.
+func (userRepository UserRepository) usernameDuplicateError(location string) error {
+	validationError := domain.NewValidationError(location, useCase.UsernameField, constants.FieldRequired, constants.UsernameAlreadyExists)
+	userRepository.Logger.Error(validationError)
+	return validationError
+}
+
 // getUserByQuery retrieves a user based on the provided query from the database.
-func (userRepository UserRepository) getUserByQuery(location string, ctx context.Context, query bson.M) common.Result[user.User] {
+func (userRepository UserRepository) getUserByQuery(location string, ctx context.Context, query bson.M, findOneOptions ...*options.FindOneOptions) common.Result[user.User] {
 	fetchedUser := repository.UserRepository{}
-	userFindOneError := userRepository.Users.FindOne(ctx, query).Decode(&fetchedUser)
+	userFindOneError := userRepository.Users.FindOne(ctx, query, findOneOptions...).Decode(&fetchedUser)
 	if validator.IsError(userFindOneError) {
 		if utility.IsMongoDBError(userFindOneError) {
 			internalError := domain.NewInternalError(location+".getUserByQuery.FindOne.Decode", userFindOneError.Error())
@@ -363,3 +1070,51 @@ func (userRepository UserRepository) getUserByQuery(location string, ctx context
 
 	return common.NewResultOnSuccess[user.User](repository.UserRepositoryToUserMapper(fetchedUser))
 }
+
+// unsuspendUserUpdate builds an update that lifts the suspension and removes its reason and expiry.
+func unsuspendUserUpdate() bson.D {
+	return bson.D{
+		{Key: model.Set, Value: bson.D{
+			{Key: suspendedKey, Value: false},
+			{Key: updatedAtKey, Value: time.Now()},
+		}},
+		{Key: model.Unset, Value: bson.D{
+			{Key: suspensionReasonKey, Value: ""},
+			{Key: suspendedUntilKey, Value: ""},
+		}},
+	}
+}
+
+// emailChangeFields lists the fields of a pending email change, so they can be removed once it is confirmed or cancelled.
+func emailChangeFields() bson.D {
+	return bson.D{
+		{Key: pendingEmailKey, Value: ""},
+		{Key: emailChangeTokenKey, Value: ""},
+		{Key: emailChangeCancelTokenKey, Value: ""},
+		{Key: emailChangeExpiryKey, Value: ""},
+	}
+}
+
+// userFilterQuery builds a query from the provided filter, skipping the fields that are not set.
+func userFilterQuery(userFilter user.UserFilter) bson.M {
+	query := bson.M{}
+	if validator.IsValueNotEmpty(userFilter.Role) {
+		query[roleKey] = userFilter.Role
+	}
+	if userFilter.Verified != nil {
+		query[verifiedKey] = *userFilter.Verified
+	}
+
+	createdAt := bson.M{}
+	if !userFilter.CreatedFrom.IsZero() {
+		createdAt[model.GreaterThanOrEqual] = userFilter.CreatedFrom
+	}
+	if !userFilter.CreatedTo.IsZero() {
+		createdAt[model.LessThanOrEqual] = userFilter.CreatedTo
+	}
+	if len(createdAt) > 0 {
+		query[createdAtKey] = createdAt
+	}
+
+	return query
+}
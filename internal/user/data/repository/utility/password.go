@@ -1,21 +0,0 @@
-package utility
-
-import (
-	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
-	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
-	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
-	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
-	"golang.org/x/crypto/bcrypt"
-)
-
-// HashPassword generates a hashed password from a plain text password.
-func HashPassword(logger interfaces.Logger, location, password string) common.Result[string] {
-	hashedPassword, generateFromPasswordError := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-	if validator.IsError(generateFromPasswordError) {
-		internalError := domain.NewInternalError(location+".HashPassword.GenerateFromPassword", generateFromPasswordError.Error())
-		logger.Error(internalError)
-		return common.NewResultOnFailure[string](internalError)
-	}
-
-	return common.NewResultOnSuccess(string(hashedPassword))
-}
@@ -39,9 +39,8 @@ func (userGrpcServer *UserGrpcServer) Login(ctx context.Context, request *pb.Log
 	accessToken := utility.GenerateJWTToken(
 		userGrpcServer.Logger,
 		location+"Login",
-		"",
+		userGrpcServer.userUseCase.KeyRings.AccessToken,
 		time.Duration(2),
-		// userGrpcServer.applicationConfig.AccessToken.PrivateKey,
 		// userGrpcServer.applicationConfig.AccessToken.ExpiredIn,
 		userTokenPayload,
 	)
@@ -52,9 +51,8 @@ func (userGrpcServer *UserGrpcServer) Login(ctx context.Context, request *pb.Log
 	refreshToken := utility.GenerateJWTToken(
 		userGrpcServer.Logger,
 		location+"Login",
-		"",
+		userGrpcServer.userUseCase.KeyRings.RefreshToken,
 		time.Duration(2),
-		// userGrpcServer.applicationConfig.RefreshToken.PrivateKey,
 		// userGrpcServer.applicationConfig.RefreshToken.ExpiredIn,
 		userTokenPayload,
 	)
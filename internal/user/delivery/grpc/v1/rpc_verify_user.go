@@ -2,11 +2,9 @@ package v1
 
 import (
 	"context"
-	"time"
 
 	pb "github.com/yachnytskyi/golang-mongo-grpc/internal/user/delivery/grpc/v1/model/pb"
 	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/common"
-	"go.mongodb.org/mongo-driver/bson"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -15,17 +13,9 @@ func (userGrpcServer *UserGrpcServer) VerifyEmail(ctx context.Context, request *
 	code := request.GetVerificationCode()
 
 	verificationCode := utility.Encode(code)
-
-	query := bson.D{{Key: "verificationCode", Value: verificationCode}}
-	update := bson.D{{Key: "$set", Value: bson.D{{Key: "verified", Value: true}, {Key: "updated_at", Value: time.Now()}}}, {Key: "$unset", Value: bson.D{{Key: "verificationCode", Value: ""}}}}
-	result, err := userGrpcServer.userCollection.UpdateOne(ctx, query, update)
-
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error(), "error")
-	}
-
-	if result.MatchedCount == 0 {
-		return nil, status.Errorf(codes.PermissionDenied, "Could not verify email address")
+	verifyEmailError := userGrpcServer.userUseCase.VerifyEmail(ctx, verificationCode)
+	if verifyEmailError != nil {
+		return nil, status.Errorf(codes.PermissionDenied, verifyEmailError.Error(), "error")
 	}
 
 	res := &pb.GenericResponse{
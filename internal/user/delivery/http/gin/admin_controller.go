@@ -0,0 +1,233 @@
+package gin
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	view "github.com/yachnytskyi/golang-mongo-grpc/internal/user/delivery/http/model"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/usecase"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	commonModel "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/delivery/http"
+	delivery "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/delivery/http"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/delivery/http/gin/common"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+type AdminController struct {
+	Config       *config.ApplicationConfig
+	Logger       interfaces.Logger
+	AdminUseCase domain.AdminUseCase
+}
+
+func NewAdminController(config *config.ApplicationConfig, logger interfaces.Logger, adminUseCase domain.AdminUseCase) AdminController {
+	return AdminController{
+		Config:       config,
+		Logger:       logger,
+		AdminUseCase: adminUseCase,
+	}
+}
+
+func (adminController AdminController) GetAllUsers(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	var userFilterViewData view.UserFilterView
+	shouldBindQuery := ginContext.ShouldBindQuery(&userFilterViewData)
+	if validator.IsError(shouldBindQuery) {
+		common.HandleJSONBindingError(ginContext, adminController.Logger, location+"GetAllUsers", shouldBindQuery)
+		return
+	}
+
+	paginationQuery := common.ParsePaginationQuery(ginContext)
+	userFilterData := view.UserFilterViewToUserFilterMapper(userFilterViewData)
+	fetchedUsers := adminController.AdminUseCase.GetAllUsers(ctx, paginationQuery, userFilterData)
+	if validator.IsError(fetchedUsers.Error) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(fetchedUsers.Error)))
+		return
+	}
+
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.UsersToUsersAdminViewMapper(fetchedUsers.Data)))
+}
+
+func (adminController AdminController) GetUserById(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	userID := ginContext.Param(constants.ItemIdParam)
+	fetchedUser := adminController.AdminUseCase.GetUserById(ctx, userID)
+	writeUserAdminResponse(ginContext, fetchedUser)
+}
+
+func (adminController AdminController) UpdateUserRole(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	var userRoleUpdateViewData view.UserRoleUpdateView
+	shouldBindJSON := ginContext.ShouldBindJSON(&userRoleUpdateViewData)
+	if validator.IsError(shouldBindJSON) {
+		common.HandleJSONBindingError(ginContext, adminController.Logger, location+"UpdateUserRole", shouldBindJSON)
+		return
+	}
+
+	currentUserID := ctx.Value(constants.ID).(string)
+	currentUserRole := ctx.Value(constants.UserRole).(string)
+	userID := ginContext.Param(constants.ItemIdParam)
+	userRoleUpdateData := view.UserRoleUpdateViewToUserRoleUpdateMapper(userID, userRoleUpdateViewData)
+	updatedUser := adminController.AdminUseCase.UpdateUserRole(ctx, userRoleUpdateData, currentUserID, currentUserRole)
+	writeUserAdminResponse(ginContext, updatedUser)
+}
+
+func (adminController AdminController) VerifyUser(controllerContext any) {
+	adminController.updateUserVerification(controllerContext, true)
+}
+
+func (adminController AdminController) UnverifyUser(controllerContext any) {
+	adminController.updateUserVerification(controllerContext, false)
+}
+
+func (adminController AdminController) SuspendUser(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	var userSuspensionViewData view.UserSuspensionView
+	shouldBindJSON := ginContext.ShouldBindJSON(&userSuspensionViewData)
+	if validator.IsError(shouldBindJSON) {
+		common.HandleJSONBindingError(ginContext, adminController.Logger, location+"SuspendUser", shouldBindJSON)
+		return
+	}
+
+	currentUserID := ctx.Value(constants.ID).(string)
+	currentUserRole := ctx.Value(constants.UserRole).(string)
+	userID := ginContext.Param(constants.ItemIdParam)
+	userSuspensionData := view.UserSuspensionViewToUserSuspensionMapper(userID, userSuspensionViewData)
+	suspendedUser := adminController.AdminUseCase.SuspendUser(ctx, userSuspensionData, currentUserID, currentUserRole)
+	writeUserAdminResponse(ginContext, suspendedUser)
+}
+
+func (adminController AdminController) UnsuspendUser(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	currentUserID := ctx.Value(constants.ID).(string)
+	currentUserRole := ctx.Value(constants.UserRole).(string)
+	userID := ginContext.Param(constants.ItemIdParam)
+	unsuspendedUser := adminController.AdminUseCase.UnsuspendUser(ctx, userID, currentUserID, currentUserRole)
+	writeUserAdminResponse(ginContext, unsuspendedUser)
+}
+
+func (adminController AdminController) ForcePasswordReset(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	currentUserID := ctx.Value(constants.ID).(string)
+	currentUserRole := ctx.Value(constants.UserRole).(string)
+	userID := ginContext.Param(constants.ItemIdParam)
+	forcePasswordResetError := adminController.AdminUseCase.ForcePasswordReset(ctx, userID, currentUserID, currentUserRole)
+	if validator.IsError(forcePasswordResetError) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(forcePasswordResetError)))
+		return
+	}
+
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.NewWelcomeMessageView(constants.ForcePasswordResetNotification)))
+}
+
+func (adminController AdminController) DeleteUserById(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	currentUserID := ctx.Value(constants.ID).(string)
+	currentUserRole := ctx.Value(constants.UserRole).(string)
+	userID := ginContext.Param(constants.ItemIdParam)
+	deletedUserError := adminController.AdminUseCase.DeleteUserById(ctx, userID, currentUserID, currentUserRole)
+	if validator.IsError(deletedUserError) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(deletedUserError)))
+		return
+	}
+
+	ginContext.JSON(http.StatusNoContent, nil)
+}
+
+func (adminController AdminController) CreateInvitation(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	currentUserID := ctx.Value(constants.ID).(string)
+	var invitationCreateViewData view.InvitationCreateView
+	shouldBindJSON := ginContext.ShouldBindJSON(&invitationCreateViewData)
+	if validator.IsError(shouldBindJSON) {
+		common.HandleJSONBindingError(ginContext, adminController.Logger, location+"CreateInvitation", shouldBindJSON)
+		return
+	}
+
+	invitationCreate := view.InvitationCreateViewToInvitationCreateMapper(currentUserID, invitationCreateViewData)
+	createdInvitation := adminController.AdminUseCase.CreateInvitation(ctx, invitationCreate)
+	if validator.IsError(createdInvitation.Error) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(createdInvitation.Error)))
+		return
+	}
+
+	ginContext.JSON(http.StatusCreated, model.NewJSONResponseOnSuccess(view.InvitationCreatedToInvitationCreatedViewMapper(createdInvitation.Data)))
+}
+
+func (adminController AdminController) GetInvitations(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	fetchedInvitations := adminController.AdminUseCase.GetInvitations(ctx)
+	if validator.IsError(fetchedInvitations.Error) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(fetchedInvitations.Error)))
+		return
+	}
+
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.InvitationsToInvitationsViewMapper(fetchedInvitations.Data)))
+}
+
+func (adminController AdminController) RevokeInvitation(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	invitationID := ginContext.Param(constants.ItemIdParam)
+	revokeInvitationError := adminController.AdminUseCase.RevokeInvitation(ctx, invitationID)
+	if validator.IsError(revokeInvitationError) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(revokeInvitationError)))
+		return
+	}
+
+	ginContext.JSON(http.StatusNoContent, nil)
+}
+
+func (adminController AdminController) updateUserVerification(controllerContext any, verified bool) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	currentUserID := ctx.Value(constants.ID).(string)
+	currentUserRole := ctx.Value(constants.UserRole).(string)
+	userID := ginContext.Param(constants.ItemIdParam)
+	updatedUser := adminController.AdminUseCase.UpdateUserVerification(ctx, userID, verified, currentUserID, currentUserRole)
+	writeUserAdminResponse(ginContext, updatedUser)
+}
+
+func writeUserAdminResponse(ginContext *gin.Context, fetchedUser commonModel.Result[user.User]) {
+	if validator.IsError(fetchedUser.Error) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(fetchedUser.Error)))
+		return
+	}
+
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.UserToUserAdminViewMapper(fetchedUser.Data)))
+}
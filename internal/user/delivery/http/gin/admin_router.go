@@ -0,0 +1,99 @@
+package gin
+
+import (
+	"github.com/gin-gonic/gin"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	domainUtility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	middleware "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/delivery/http/gin/middleware"
+)
+
+type AdminRouter struct {
+	Config           *config.ApplicationConfig
+	Logger           interfaces.Logger
+	KeyRings         domainUtility.KeyRings
+	AdminController  interfaces.AdminController
+	SessionValidator interfaces.SessionValidator
+}
+
+func NewAdminRouter(config *config.ApplicationConfig, logger interfaces.Logger, keyRings domainUtility.KeyRings, adminController interfaces.AdminController, sessionValidator interfaces.SessionValidator) AdminRouter {
+	return AdminRouter{
+		Config:           config,
+		Logger:           logger,
+		KeyRings:         keyRings,
+		AdminController:  adminController,
+		SessionValidator: sessionValidator,
+	}
+}
+
+// Router defines the user management routes for administrators and connects them to the corresponding controller methods.
+func (adminRouter AdminRouter) Router(routerGroup any) {
+	ginRouterGroup := routerGroup.(*gin.RouterGroup)
+	router := ginRouterGroup.Group(constants.AdminGroupPath + constants.UsersGroupPath)
+	router.Use(middleware.AuthenticationMiddleware(adminRouter.Config, adminRouter.Logger, adminRouter.KeyRings.AccessToken, adminRouter.SessionValidator))
+
+	// Routes available to moderators and administrators.
+	suspensionRoutes := router.Group("")
+	suspensionRoutes.Use(middleware.RequirePermission(adminRouter.Logger, constants.UserSuspendPermission))
+	{
+		suspensionRoutes.PATCH(constants.SuspendUserPath, func(ginContext *gin.Context) {
+			adminRouter.AdminController.SuspendUser(ginContext)
+		})
+
+		suspensionRoutes.PATCH(constants.UnsuspendUserPath, func(ginContext *gin.Context) {
+			adminRouter.AdminController.UnsuspendUser(ginContext)
+		})
+	}
+
+	// Routes available to administrators only.
+	managementRoutes := router.Group("")
+	managementRoutes.Use(middleware.RequirePermission(adminRouter.Logger, constants.UserManagePermission))
+	{
+		managementRoutes.GET(constants.GetAllItemsURL, func(ginContext *gin.Context) {
+			adminRouter.AdminController.GetAllUsers(ginContext)
+		})
+
+		managementRoutes.GET(constants.GetItemByIdURL, func(ginContext *gin.Context) {
+			adminRouter.AdminController.GetUserById(ginContext)
+		})
+
+		managementRoutes.PATCH(constants.UserRolePath, func(ginContext *gin.Context) {
+			adminRouter.AdminController.UpdateUserRole(ginContext)
+		})
+
+		managementRoutes.PATCH(constants.VerifyUserPath, func(ginContext *gin.Context) {
+			adminRouter.AdminController.VerifyUser(ginContext)
+		})
+
+		managementRoutes.PATCH(constants.UnverifyUserPath, func(ginContext *gin.Context) {
+			adminRouter.AdminController.UnverifyUser(ginContext)
+		})
+
+		managementRoutes.POST(constants.ForcePasswordResetPath, func(ginContext *gin.Context) {
+			adminRouter.AdminController.ForcePasswordReset(ginContext)
+		})
+
+		managementRoutes.DELETE(constants.GetItemByIdURL, func(ginContext *gin.Context) {
+			adminRouter.AdminController.DeleteUserById(ginContext)
+		})
+	}
+
+	// Invitations for the invite-only registration, available to administrators only.
+	invitationRoutes := ginRouterGroup.Group(constants.AdminGroupPath + constants.InvitationsGroupPath)
+	invitationRoutes.Use(middleware.AuthenticationMiddleware(adminRouter.Config, adminRouter.Logger, adminRouter.KeyRings.AccessToken, adminRouter.SessionValidator))
+	invitationRoutes.Use(middleware.RequirePermission(adminRouter.Logger, constants.UserManagePermission))
+	{
+		invitationRoutes.POST(constants.GetAllItemsURL, func(ginContext *gin.Context) {
+			adminRouter.AdminController.CreateInvitation(ginContext)
+		})
+
+		invitationRoutes.GET(constants.GetAllItemsURL, func(ginContext *gin.Context) {
+			adminRouter.AdminController.GetInvitations(ginContext)
+		})
+
+		invitationRoutes.DELETE(constants.GetItemByIdURL, func(ginContext *gin.Context) {
+			adminRouter.AdminController.RevokeInvitation(ginContext)
+		})
+	}
+}
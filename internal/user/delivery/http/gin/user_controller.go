@@ -9,6 +9,7 @@ import (
 	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
 	utility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/delivery/http/gin/utility/cookie"
 	view "github.com/yachnytskyi/golang-mongo-grpc/internal/user/delivery/http/model"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
 	domain "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/usecase"
 	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
 	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
@@ -82,6 +83,22 @@ func (userController UserController) GetUserById(controllerContext any) {
 	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.UserToUserViewMapper(fetchedUser.Data)))
 }
 
+// GetUserByHandle returns the public profile of the user with the handle.
+func (userController UserController) GetUserByHandle(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	handle := ginContext.Param(constants.HandleParam)
+	fetchedUser := userController.UserUseCase.GetUserByHandle(ctx, handle)
+	if validator.IsError(fetchedUser.Error) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(fetchedUser.Error)))
+		return
+	}
+
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.UserToUserProfileViewMapper(fetchedUser.Data)))
+}
+
 func (userController UserController) Register(controllerContext any) {
 	ginContext := controllerContext.(*gin.Context)
 	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
@@ -129,6 +146,54 @@ func (userController UserController) UpdateCurrentUser(controllerContext any) {
 	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.UserToUserViewMapper(updatedUser.Data)))
 }
 
+func (userController UserController) UpdatePassword(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	currentUserID := ctx.Value(constants.ID).(string)
+	currentSessionID := ctx.Value(constants.SessionID).(string)
+	var userPasswordUpdateViewData view.UserPasswordUpdateView
+	shouldBindJSON := ginContext.ShouldBindJSON(&userPasswordUpdateViewData)
+	if validator.IsError(shouldBindJSON) {
+		common.HandleJSONBindingError(ginContext, userController.Logger, location+"UpdatePassword", shouldBindJSON)
+		return
+	}
+
+	userPasswordUpdate := view.UserPasswordUpdateViewToUserPasswordUpdateMapper(currentUserID, userPasswordUpdateViewData)
+	updatePasswordError := userController.UserUseCase.UpdatePassword(ctx, userPasswordUpdate, currentSessionID, getUserDevice(ginContext))
+	if validator.IsError(updatePasswordError) {
+		abortWithThrottleError(ginContext, updatePasswordError)
+		return
+	}
+
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.NewWelcomeMessageView(constants.PasswordUpdateSuccessNotification)))
+}
+
+func (userController UserController) RequestEmailChange(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	currentUserID := ctx.Value(constants.ID).(string)
+	var userEmailChangeViewData view.UserEmailChangeView
+	shouldBindJSON := ginContext.ShouldBindJSON(&userEmailChangeViewData)
+	if validator.IsError(shouldBindJSON) {
+		common.HandleJSONBindingError(ginContext, userController.Logger, location+"RequestEmailChange", shouldBindJSON)
+		return
+	}
+
+	userEmailChange := view.UserEmailChangeViewToUserEmailChangeMapper(currentUserID, userEmailChangeViewData)
+	requestEmailChangeError := userController.UserUseCase.RequestEmailChange(ctx, userEmailChange, getUserDevice(ginContext))
+	if validator.IsError(requestEmailChangeError) {
+		abortWithThrottleError(ginContext, requestEmailChangeError)
+		return
+	}
+
+	ginContext.JSON(http.StatusOK,
+		model.NewJSONResponseOnSuccess(view.NewWelcomeMessageView(fmt.Sprintf(constants.EmailChangeRequestedNotification, userEmailChange.Email))))
+}
+
 func (userController UserController) DeleteCurrentUser(controllerContext any) {
 	ginContext := controllerContext.(*gin.Context)
 	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
@@ -145,6 +210,122 @@ func (userController UserController) DeleteCurrentUser(controllerContext any) {
 	ginContext.JSON(http.StatusNoContent, nil)
 }
 
+// GetJSONWebKeySet serves the public keys of the access tokens in the standard JWKS format,
+// so the response is not wrapped like the other responses of the API.
+func (userController UserController) GetJSONWebKeySet(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	jsonWebKeySet := userController.UserUseCase.GetJSONWebKeySet()
+	ginContext.JSON(http.StatusOK, view.JSONWebKeySetToJSONWebKeySetViewMapper(jsonWebKeySet))
+}
+
+func (userController UserController) GetSessions(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	currentUserID := ctx.Value(constants.ID).(string)
+	currentSessionID := ctx.Value(constants.SessionID).(string)
+	fetchedSessions := userController.UserUseCase.GetSessions(ctx, currentUserID, currentSessionID)
+	if validator.IsError(fetchedSessions.Error) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(fetchedSessions.Error)))
+		return
+	}
+
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.UserSessionsToUserSessionsViewMapper(fetchedSessions.Data)))
+}
+
+func (userController UserController) RevokeSession(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	currentUserID := ctx.Value(constants.ID).(string)
+	sessionID := ginContext.Param(constants.ItemIdParam)
+	revokeSessionError := userController.UserUseCase.RevokeSession(ctx, currentUserID, sessionID)
+	if validator.IsError(revokeSessionError) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(revokeSessionError)))
+		return
+	}
+
+	// Revoking the current session is a logout from this device.
+	if sessionID == ctx.Value(constants.SessionID).(string) {
+		utility.CleanCookies(ginContext, userController.Config, path)
+	}
+
+	ginContext.JSON(http.StatusNoContent, nil)
+}
+
+func (userController UserController) RevokeAllSessions(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	currentUserID := ctx.Value(constants.ID).(string)
+	revokeAllSessionsError := userController.UserUseCase.RevokeAllSessions(ctx, currentUserID)
+	if validator.IsError(revokeAllSessionsError) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(revokeAllSessionsError)))
+		return
+	}
+
+	utility.CleanCookies(ginContext, userController.Config, path)
+	ginContext.JSON(http.StatusNoContent, nil)
+}
+
+func (userController UserController) CreatePersonalAccessToken(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	currentUserID := ctx.Value(constants.ID).(string)
+	var personalAccessTokenCreateViewData view.PersonalAccessTokenCreateView
+	shouldBindJSON := ginContext.ShouldBindJSON(&personalAccessTokenCreateViewData)
+	if validator.IsError(shouldBindJSON) {
+		common.HandleJSONBindingError(ginContext, userController.Logger, location+"CreatePersonalAccessToken", shouldBindJSON)
+		return
+	}
+
+	personalAccessTokenCreate := view.PersonalAccessTokenCreateViewToPersonalAccessTokenCreateMapper(currentUserID, personalAccessTokenCreateViewData)
+	createdPersonalAccessToken := userController.UserUseCase.CreatePersonalAccessToken(ctx, personalAccessTokenCreate)
+	if validator.IsError(createdPersonalAccessToken.Error) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(createdPersonalAccessToken.Error)))
+		return
+	}
+
+	ginContext.JSON(http.StatusCreated,
+		model.NewJSONResponseOnSuccess(view.PersonalAccessTokenCreatedToPersonalAccessTokenCreatedViewMapper(createdPersonalAccessToken.Data)))
+}
+
+func (userController UserController) GetPersonalAccessTokens(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	currentUserID := ctx.Value(constants.ID).(string)
+	fetchedPersonalAccessTokens := userController.UserUseCase.GetPersonalAccessTokens(ctx, currentUserID)
+	if validator.IsError(fetchedPersonalAccessTokens.Error) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(fetchedPersonalAccessTokens.Error)))
+		return
+	}
+
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.PersonalAccessTokensToPersonalAccessTokensViewMapper(fetchedPersonalAccessTokens.Data)))
+}
+
+func (userController UserController) RevokePersonalAccessToken(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	currentUserID := ctx.Value(constants.ID).(string)
+	personalAccessTokenID := ginContext.Param(constants.ItemIdParam)
+	revokePersonalAccessTokenError := userController.UserUseCase.RevokePersonalAccessToken(ctx, currentUserID, personalAccessTokenID)
+	if validator.IsError(revokePersonalAccessTokenError) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(revokePersonalAccessTokenError)))
+		return
+	}
+
+	ginContext.JSON(http.StatusNoContent, nil)
+}
+
 func (userController UserController) Login(controllerContext any) {
 	ginContext := controllerContext.(*gin.Context)
 	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
@@ -158,17 +339,199 @@ func (userController UserController) Login(controllerContext any) {
 	}
 
 	userLoginData := view.UserLoginViewToUserLoginMapper(userLoginViewData)
-	userToken := userController.UserUseCase.Login(ctx, userLoginData)
+	userToken := userController.UserUseCase.Login(ctx, userLoginData, getUserDevice(ginContext))
+	if validator.IsError(userToken.Error) {
+		abortWithThrottleError(ginContext, userToken.Error)
+		return
+	}
+
+	// The tokens are only issued after the second login step for users with two-factor authentication.
+	if validator.IsValueNotEmpty(userToken.Data.MFAToken) {
+		ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.NewUserMFAPendingView(userToken.Data.MFAToken)))
+		return
+	}
+
+	userTokenView := view.UserTokenToUserTokenViewMapper(userToken.Data)
+	setAccessLoginCookies(ginContext, userController.Config, userTokenView.AccessToken, userTokenView.RefreshToken)
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(userTokenView))
+}
+
+func (userController UserController) RequestMagicLink(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	var userMagicLinkViewData view.UserMagicLinkView
+	shouldBindJSON := ginContext.ShouldBindJSON(&userMagicLinkViewData)
+	if validator.IsError(shouldBindJSON) {
+		common.HandleJSONBindingError(ginContext, userController.Logger, location+"RequestMagicLink", shouldBindJSON)
+		return
+	}
+
+	userMagicLinkData := view.UserMagicLinkViewToUserMagicLinkMapper(userMagicLinkViewData)
+	requestMagicLinkError := userController.UserUseCase.RequestMagicLink(ctx, userMagicLinkData)
+	if validator.IsError(requestMagicLinkError) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(requestMagicLinkError)))
+		return
+	}
+
+	ginContext.JSON(http.StatusCreated, model.NewJSONResponseOnSuccess(view.NewWelcomeMessageView(constants.SendingEmailWithInstructionsNotification)))
+}
+
+func (userController UserController) LoginWithMagicLink(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	magicLinkToken := ginContext.Param(constants.ItemIdParam)
+	userToken := userController.UserUseCase.LoginWithMagicLink(ctx, magicLinkToken, getUserDevice(ginContext))
 	if validator.IsError(userToken.Error) {
 		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(userToken.Error)))
 		return
 	}
 
+	// The tokens are only issued after the second login step for users with two-factor authentication.
+	if validator.IsValueNotEmpty(userToken.Data.MFAToken) {
+		ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.NewUserMFAPendingView(userToken.Data.MFAToken)))
+		return
+	}
+
 	userTokenView := view.UserTokenToUserTokenViewMapper(userToken.Data)
 	setAccessLoginCookies(ginContext, userController.Config, userTokenView.AccessToken, userTokenView.RefreshToken)
 	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(userTokenView))
 }
 
+// StartOAuthLogin redirects the user to the provider to sign in there.
+func (userController UserController) StartOAuthLogin(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	authorizationURL := userController.UserUseCase.StartOAuthLogin(ctx, ginContext.Param(constants.ProviderParam))
+	if validator.IsError(authorizationURL.Error) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(authorizationURL.Error)))
+		return
+	}
+
+	ginContext.Redirect(http.StatusFound, authorizationURL.Data)
+}
+
+// CompleteOAuthLogin signs the user in once the provider redirects back, just like a login with a password.
+func (userController UserController) CompleteOAuthLogin(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	var oauthCallbackViewData view.OAuthCallbackView
+	shouldBindQuery := ginContext.ShouldBindQuery(&oauthCallbackViewData)
+	if validator.IsError(shouldBindQuery) {
+		common.HandleJSONBindingError(ginContext, userController.Logger, location+"CompleteOAuthLogin", shouldBindQuery)
+		return
+	}
+
+	oauthLoginData := view.OAuthCallbackViewToOAuthLoginMapper(ginContext.Param(constants.ProviderParam), oauthCallbackViewData)
+	userToken := userController.UserUseCase.CompleteOAuthLogin(ctx, oauthLoginData, getUserDevice(ginContext))
+	if validator.IsError(userToken.Error) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(userToken.Error)))
+		return
+	}
+
+	// The tokens are only issued after the second login step for users with two-factor authentication.
+	if validator.IsValueNotEmpty(userToken.Data.MFAToken) {
+		ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.NewUserMFAPendingView(userToken.Data.MFAToken)))
+		return
+	}
+
+	userTokenView := view.UserTokenToUserTokenViewMapper(userToken.Data)
+	setAccessLoginCookies(ginContext, userController.Config, userTokenView.AccessToken, userTokenView.RefreshToken)
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(userTokenView))
+}
+
+func (userController UserController) VerifyTwoFactorLogin(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	var userTwoFactorLoginViewData view.UserTwoFactorLoginView
+	shouldBindJSON := ginContext.ShouldBindJSON(&userTwoFactorLoginViewData)
+	if validator.IsError(shouldBindJSON) {
+		common.HandleJSONBindingError(ginContext, userController.Logger, location+"VerifyTwoFactorLogin", shouldBindJSON)
+		return
+	}
+
+	userTwoFactorLoginData := view.UserTwoFactorLoginViewToUserTwoFactorLoginMapper(userTwoFactorLoginViewData)
+	userToken := userController.UserUseCase.VerifyTwoFactorLogin(ctx, userTwoFactorLoginData, getUserDevice(ginContext))
+	if validator.IsError(userToken.Error) {
+		abortWithThrottleError(ginContext, userToken.Error)
+		return
+	}
+
+	userTokenView := view.UserTokenToUserTokenViewMapper(userToken.Data)
+	setAccessLoginCookies(ginContext, userController.Config, userTokenView.AccessToken, userTokenView.RefreshToken)
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(userTokenView))
+}
+
+func (userController UserController) EnrollTwoFactor(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	currentUserID := ctx.Value(constants.ID).(string)
+	userTwoFactorEnrollment := userController.UserUseCase.EnrollTwoFactor(ctx, currentUserID)
+	if validator.IsError(userTwoFactorEnrollment.Error) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(userTwoFactorEnrollment.Error)))
+		return
+	}
+
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.UserTwoFactorEnrollmentToUserTwoFactorEnrollmentViewMapper(userTwoFactorEnrollment.Data)))
+}
+
+func (userController UserController) ConfirmTwoFactor(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	currentUserID := ctx.Value(constants.ID).(string)
+	var userTwoFactorCodeViewData view.UserTwoFactorCodeView
+	shouldBindJSON := ginContext.ShouldBindJSON(&userTwoFactorCodeViewData)
+	if validator.IsError(shouldBindJSON) {
+		common.HandleJSONBindingError(ginContext, userController.Logger, location+"ConfirmTwoFactor", shouldBindJSON)
+		return
+	}
+
+	userTwoFactorCode := view.UserTwoFactorCodeViewToUserTwoFactorCodeMapper(currentUserID, userTwoFactorCodeViewData)
+	confirmTwoFactorError := userController.UserUseCase.ConfirmTwoFactor(ctx, userTwoFactorCode)
+	if validator.IsError(confirmTwoFactorError) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(confirmTwoFactorError)))
+		return
+	}
+
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.NewWelcomeMessageView(constants.TwoFactorEnabledNotification)))
+}
+
+func (userController UserController) DisableTwoFactor(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	currentUserID := ctx.Value(constants.ID).(string)
+	var userTwoFactorDisableViewData view.UserTwoFactorDisableView
+	shouldBindJSON := ginContext.ShouldBindJSON(&userTwoFactorDisableViewData)
+	if validator.IsError(shouldBindJSON) {
+		common.HandleJSONBindingError(ginContext, userController.Logger, location+"DisableTwoFactor", shouldBindJSON)
+		return
+	}
+
+	userTwoFactorDisable := view.UserTwoFactorDisableViewToUserTwoFactorDisableMapper(currentUserID, userTwoFactorDisableViewData)
+	disableTwoFactorError := userController.UserUseCase.DisableTwoFactor(ctx, userTwoFactorDisable, getUserDevice(ginContext))
+	if validator.IsError(disableTwoFactorError) {
+		abortWithThrottleError(ginContext, disableTwoFactorError)
+		return
+	}
+
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.NewWelcomeMessageView(constants.TwoFactorDisabledNotification)))
+}
+
 func (userController UserController) RefreshAccessToken(controllerContext any) {
 	ginContext := controllerContext.(*gin.Context)
 	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
@@ -181,7 +544,8 @@ func (userController UserController) RefreshAccessToken(controllerContext any) {
 		return
 	}
 
-	userToken := userController.UserUseCase.RefreshAccessToken(ctx, currentUser.Data)
+	refreshTokenID := ctx.Value(constants.TokenID).(string)
+	userToken := userController.UserUseCase.RefreshAccessToken(ctx, currentUser.Data, refreshTokenID, getUserDevice(ginContext))
 	if validator.IsError(userToken.Error) {
 		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(userToken.Error)))
 		return
@@ -194,7 +558,18 @@ func (userController UserController) RefreshAccessToken(controllerContext any) {
 
 func (userController UserController) Logout(controllerContext any) {
 	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	// The cookies are cleaned in any case, so the client is logged out even if the revocation fails.
+	refreshTokenID := ctx.Value(constants.TokenID).(string)
+	logoutError := userController.UserUseCase.Logout(ctx, refreshTokenID)
 	utility.CleanCookies(ginContext, userController.Config, path)
+	if validator.IsError(logoutError) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(logoutError)))
+		return
+	}
+
 	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.NewWelcomeMessageView(constants.LogoutNotificationMessage)))
 }
 
@@ -246,6 +621,89 @@ func (userController UserController) ResetUserPassword(controllerContext any) {
 	ginContext.JSON(http.StatusCreated, model.NewJSONResponseOnSuccess(view.NewWelcomeMessageView(constants.PasswordResetSuccessNotification)))
 }
 
+func (userController UserController) VerifyEmail(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	verificationCode := ginContext.Param(constants.ItemIdParam)
+	verifyEmailError := userController.UserUseCase.VerifyEmail(ctx, verificationCode)
+	if validator.IsError(verifyEmailError) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(verifyEmailError)))
+		return
+	}
+
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.NewWelcomeMessageView(constants.EmailVerificationSuccessNotification)))
+}
+
+func (userController UserController) UnlockAccount(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	unlockToken := ginContext.Param(constants.ItemIdParam)
+	unlockAccountError := userController.UserUseCase.UnlockAccount(ctx, unlockToken, getUserDevice(ginContext))
+	if validator.IsError(unlockAccountError) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(unlockAccountError)))
+		return
+	}
+
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.NewWelcomeMessageView(constants.AccountUnlockSuccessNotification)))
+}
+
+func (userController UserController) ConfirmEmailChange(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	confirmationToken := ginContext.Param(constants.ItemIdParam)
+	confirmEmailChangeError := userController.UserUseCase.ConfirmEmailChange(ctx, confirmationToken)
+	if validator.IsError(confirmEmailChangeError) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(confirmEmailChangeError)))
+		return
+	}
+
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.NewWelcomeMessageView(constants.EmailChangeConfirmedNotification)))
+}
+
+func (userController UserController) CancelEmailChange(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	cancelToken := ginContext.Param(constants.ItemIdParam)
+	cancelEmailChangeError := userController.UserUseCase.CancelEmailChange(ctx, cancelToken)
+	if validator.IsError(cancelEmailChangeError) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(cancelEmailChangeError)))
+		return
+	}
+
+	ginContext.JSON(http.StatusOK, model.NewJSONResponseOnSuccess(view.NewWelcomeMessageView(constants.EmailChangeCancelledNotification)))
+}
+
+func (userController UserController) ResendVerificationCode(controllerContext any) {
+	ginContext := controllerContext.(*gin.Context)
+	ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+	defer cancel()
+
+	var userVerificationCodeView view.UserVerificationCodeView
+	shouldBindJSON := ginContext.ShouldBindJSON(&userVerificationCodeView)
+	if validator.IsError(shouldBindJSON) {
+		common.HandleJSONBindingError(ginContext, userController.Logger, location+"ResendVerificationCode", shouldBindJSON)
+		return
+	}
+
+	userVerificationCode := view.UserVerificationCodeViewToUserVerificationCode(userVerificationCodeView)
+	resendVerificationCodeError := userController.UserUseCase.ResendVerificationCode(ctx, userVerificationCode)
+	if validator.IsError(resendVerificationCodeError) {
+		ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(delivery.HandleError(resendVerificationCodeError)))
+		return
+	}
+
+	ginContext.JSON(http.StatusOK,
+		model.NewJSONResponseOnSuccess(view.NewWelcomeMessageView(fmt.Sprintf(constants.ResendVerificationCodeNotification, userVerificationCode.Email))))
+}
+
 func setAccessLoginCookies(ginContext *gin.Context, config *config.ApplicationConfig, accessToken, refreshToken string) {
 	ginContext.SetCookie(
 		constants.AccessTokenValue,
@@ -311,3 +769,21 @@ func setRefreshTokenCookies(ginContext *gin.Context, config *config.ApplicationC
 		config.Security.HTTPOnly,
 	)
 }
+
+// abortWithThrottleError responds with the error, throttled requests get the Retry-After header.
+func abortWithThrottleError(ginContext *gin.Context, throttledError error) {
+	httpError := delivery.HandleError(throttledError)
+	httpTooManyRequestsError, isTooManyRequestsError := httpError.(delivery.HTTPTooManyRequestsError)
+	if isTooManyRequestsError {
+		ginContext.Header(constants.RetryAfterHeader, httpTooManyRequestsError.RetryAfter)
+		ginContext.JSON(http.StatusTooManyRequests, model.NewJSONResponseOnFailure(httpError))
+		return
+	}
+
+	ginContext.JSON(http.StatusBadRequest, model.NewJSONResponseOnFailure(httpError))
+}
+
+// getUserDevice extracts the client information stored together with the refresh token.
+func getUserDevice(ginContext *gin.Context) user.UserDevice {
+	return user.NewUserDevice(ginContext.Request.UserAgent(), ginContext.ClientIP())
+}
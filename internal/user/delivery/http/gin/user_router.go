@@ -3,22 +3,27 @@ package gin
 import (
 	"github.com/gin-gonic/gin"
 	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	domainUtility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
 	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
 	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
 	middleware "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/delivery/http/gin/middleware"
 )
 
 type UserRouter struct {
-	Config         *config.ApplicationConfig
-	Logger         interfaces.Logger
-	UserController interfaces.UserController
+	Config           *config.ApplicationConfig
+	Logger           interfaces.Logger
+	KeyRings         domainUtility.KeyRings
+	UserController   interfaces.UserController
+	SessionValidator interfaces.SessionValidator
 }
 
-func NewUserRouter(config *config.ApplicationConfig, logger interfaces.Logger, userController interfaces.UserController) UserRouter {
+func NewUserRouter(config *config.ApplicationConfig, logger interfaces.Logger, keyRings domainUtility.KeyRings, userController interfaces.UserController, sessionValidator interfaces.SessionValidator) UserRouter {
 	return UserRouter{
-		Config:         config,
-		Logger:         logger,
-		UserController: userController,
+		Config:           config,
+		Logger:           logger,
+		KeyRings:         keyRings,
+		UserController:   userController,
+		SessionValidator: sessionValidator,
 	}
 }
 
@@ -27,6 +32,11 @@ func (userRouter UserRouter) Router(routerGroup any) {
 	ginRouterGroup := routerGroup.(*gin.RouterGroup)
 	router := ginRouterGroup.Group(constants.UsersGroupPath)
 
+	// The key set is served next to the other well-known documents, outside of the users group.
+	ginRouterGroup.GET(constants.JWKSPath, func(ginContext *gin.Context) {
+		userRouter.UserController.GetJSONWebKeySet(ginContext)
+	})
+
 	// Public routes.
 	publicRoutes := router.Group("")
 	{
@@ -38,6 +48,10 @@ func (userRouter UserRouter) Router(routerGroup any) {
 			userRouter.UserController.GetUserById(ginContext)
 		})
 
+		publicRoutes.GET(constants.GetUserByHandlePath, func(ginContext *gin.Context) {
+			userRouter.UserController.GetUserByHandle(ginContext)
+		})
+
 		publicRoutes.POST(constants.ForgottenPasswordPath, func(ginContext *gin.Context) {
 			userRouter.UserController.ForgottenPassword(ginContext)
 		})
@@ -45,6 +59,26 @@ func (userRouter UserRouter) Router(routerGroup any) {
 		publicRoutes.PATCH(constants.ResetPasswordPath, func(ginContext *gin.Context) {
 			userRouter.UserController.ResetUserPassword(ginContext)
 		})
+
+		publicRoutes.GET(constants.VerifyEmailPath, func(ginContext *gin.Context) {
+			userRouter.UserController.VerifyEmail(ginContext)
+		})
+
+		publicRoutes.POST(constants.ResendVerificationCodePath, func(ginContext *gin.Context) {
+			userRouter.UserController.ResendVerificationCode(ginContext)
+		})
+
+		publicRoutes.GET(constants.UnlockAccountPath, func(ginContext *gin.Context) {
+			userRouter.UserController.UnlockAccount(ginContext)
+		})
+
+		publicRoutes.GET(constants.ConfirmEmailChangePath, func(ginContext *gin.Context) {
+			userRouter.UserController.ConfirmEmailChange(ginContext)
+		})
+
+		publicRoutes.GET(constants.CancelEmailChangePath, func(ginContext *gin.Context) {
+			userRouter.UserController.CancelEmailChange(ginContext)
+		})
 	}
 
 	// Public routes with anonymous middleware.
@@ -55,14 +89,37 @@ func (userRouter UserRouter) Router(routerGroup any) {
 			userRouter.UserController.Login(ginContext)
 		})
 
+		publicAnonymousRoutes.POST(constants.TwoFactorLoginPath, func(ginContext *gin.Context) {
+			userRouter.UserController.VerifyTwoFactorLogin(ginContext)
+		})
+
+		publicAnonymousRoutes.POST(constants.MagicLinkPath, func(ginContext *gin.Context) {
+			userRouter.UserController.RequestMagicLink(ginContext)
+		})
+
+		// The link is opened by the client, which posts the token, so mail scanners following the link cannot use it up.
+		publicAnonymousRoutes.POST(constants.MagicLinkLoginPath, func(ginContext *gin.Context) {
+			userRouter.UserController.LoginWithMagicLink(ginContext)
+		})
+
 		publicAnonymousRoutes.POST(constants.RegisterPath, func(ginContext *gin.Context) {
 			userRouter.UserController.Register(ginContext)
 		})
+
+		publicAnonymousRoutes.GET(constants.OAuthLoginPath, func(ginContext *gin.Context) {
+			userRouter.UserController.StartOAuthLogin(ginContext)
+		})
+
+		publicAnonymousRoutes.GET(constants.OAuthCallbackPath, func(ginContext *gin.Context) {
+			userRouter.UserController.CompleteOAuthLogin(ginContext)
+		})
 	}
 
 	// Authenticated routes with authentication middleware.
+	// Managing the account needs a signed-in session, personal access tokens are rejected.
 	authenticatedRoutes := router.Group("")
-	authenticatedRoutes.Use(middleware.AuthenticationMiddleware(userRouter.Config, userRouter.Logger))
+	authenticatedRoutes.Use(middleware.AuthenticationMiddleware(userRouter.Config, userRouter.Logger, userRouter.KeyRings.AccessToken, userRouter.SessionValidator))
+	authenticatedRoutes.Use(middleware.RequireSession(userRouter.Logger))
 	{
 		authenticatedRoutes.GET(constants.GetCurrentUserPath, func(ginContext *gin.Context) {
 			userRouter.UserController.GetCurrentUser(ginContext)
@@ -72,14 +129,58 @@ func (userRouter UserRouter) Router(routerGroup any) {
 			userRouter.UserController.UpdateCurrentUser(ginContext)
 		})
 
+		authenticatedRoutes.PUT(constants.UpdatePasswordPath, func(ginContext *gin.Context) {
+			userRouter.UserController.UpdatePassword(ginContext)
+		})
+
+		authenticatedRoutes.PUT(constants.UpdateEmailPath, func(ginContext *gin.Context) {
+			userRouter.UserController.RequestEmailChange(ginContext)
+		})
+
 		authenticatedRoutes.DELETE(constants.DeleteCurrentUserPath, func(ginContext *gin.Context) {
 			userRouter.UserController.DeleteCurrentUser(ginContext)
 		})
+
+		authenticatedRoutes.GET(constants.SessionsPath, func(ginContext *gin.Context) {
+			userRouter.UserController.GetSessions(ginContext)
+		})
+
+		authenticatedRoutes.DELETE(constants.SessionPath, func(ginContext *gin.Context) {
+			userRouter.UserController.RevokeSession(ginContext)
+		})
+
+		authenticatedRoutes.DELETE(constants.SessionsPath, func(ginContext *gin.Context) {
+			userRouter.UserController.RevokeAllSessions(ginContext)
+		})
+
+		authenticatedRoutes.POST(constants.TwoFactorEnrollPath, func(ginContext *gin.Context) {
+			userRouter.UserController.EnrollTwoFactor(ginContext)
+		})
+
+		authenticatedRoutes.POST(constants.TwoFactorConfirmPath, func(ginContext *gin.Context) {
+			userRouter.UserController.ConfirmTwoFactor(ginContext)
+		})
+
+		authenticatedRoutes.POST(constants.TwoFactorDisablePath, func(ginContext *gin.Context) {
+			userRouter.UserController.DisableTwoFactor(ginContext)
+		})
+
+		authenticatedRoutes.GET(constants.PersonalAccessTokensPath, func(ginContext *gin.Context) {
+			userRouter.UserController.GetPersonalAccessTokens(ginContext)
+		})
+
+		authenticatedRoutes.POST(constants.PersonalAccessTokensPath, func(ginContext *gin.Context) {
+			userRouter.UserController.CreatePersonalAccessToken(ginContext)
+		})
+
+		authenticatedRoutes.DELETE(constants.PersonalAccessTokenPath, func(ginContext *gin.Context) {
+			userRouter.UserController.RevokePersonalAccessToken(ginContext)
+		})
 	}
 
 	// Token-related routes with refresh token middleware.
 	tokenRoutes := router.Group("")
-	tokenRoutes.Use(middleware.RefreshTokenMiddleware(userRouter.Config, userRouter.Logger))
+	tokenRoutes.Use(middleware.RefreshTokenMiddleware(userRouter.Config, userRouter.Logger, userRouter.KeyRings.RefreshToken, userRouter.SessionValidator))
 	{
 		tokenRoutes.GET(constants.RefreshTokenPath, func(ginContext *gin.Context) {
 			userRouter.UserController.RefreshAccessToken(ginContext)
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"time"
+
+	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/delivery/http"
+)
+
+type UsersAdminView struct {
+	UsersView              []UserAdminView              `json:"users"`
+	HTTPPaginationResponse model.HTTPPaginationResponse `json:"pagination_response"`
+}
+
+type UserAdminView struct {
+	model.BaseEntity
+	Username         string     `json:"username"`
+	Email            string     `json:"email"`
+	Role             string     `json:"role"`
+	Verified         bool       `json:"verified"`
+	Suspended        bool       `json:"suspended"`
+	SuspensionReason string     `json:"suspension_reason,omitempty"`
+	SuspendedUntil   *time.Time `json:"suspended_until,omitempty"`
+}
+
+type UserFilterView struct {
+	Role        string    `form:"role"`
+	Verified    *bool     `form:"verified"`
+	CreatedFrom time.Time `form:"created_from" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedTo   time.Time `form:"created_to" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+type UserRoleUpdateView struct {
+	Role string `json:"role"`
+}
+
+// UserSuspensionView describes a suspension, an empty suspended_until means the suspension is permanent.
+type UserSuspensionView struct {
+	Reason         string    `json:"reason"`
+	SuspendedUntil time.Time `json:"suspended_until"`
+}
+
+func NewUsersAdminView(users []UserAdminView, paginationResponse model.HTTPPaginationResponse) UsersAdminView {
+	return UsersAdminView{
+		UsersView:              users,
+		HTTPPaginationResponse: paginationResponse,
+	}
+}
+
+func NewUserAdminView(id string, username, email, role string, verified, suspended bool, suspensionReason string, suspendedUntil *time.Time, createdAt, updatedAt time.Time) UserAdminView {
+	return UserAdminView{
+		BaseEntity:       model.NewBaseEntity(id, createdAt, updatedAt),
+		Username:         username,
+		Email:            email,
+		Role:             role,
+		Verified:         verified,
+		Suspended:        suspended,
+		SuspensionReason: suspensionReason,
+		SuspendedUntil:   suspendedUntil,
+	}
+}
+
+func NewUserRoleUpdateView(role string) UserRoleUpdateView {
+	return UserRoleUpdateView{
+		Role: role,
+	}
+}
+
+func NewUserSuspensionView(reason string, suspendedUntil time.Time) UserSuspensionView {
+	return UserSuspensionView{
+		Reason:         reason,
+		SuspendedUntil: suspendedUntil,
+	}
+}
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+
+	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/delivery/http"
+)
+
+type InvitationsView struct {
+	Invitations []InvitationView `json:"invitations"`
+}
+
+// InvitationView describes an invitation without its code, which is only shown on creation.
+type InvitationView struct {
+	model.BaseEntity
+	CreatedBy string    `json:"created_by"`
+	MaxUses   int       `json:"max_uses"`
+	Uses      int       `json:"uses"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type InvitationCreatedView struct {
+	Invitation InvitationView `json:"invitation"`
+	Code       string         `json:"code"`
+}
+
+// InvitationCreateView describes a new invitation, by default it can be used once and expires after a week.
+type InvitationCreateView struct {
+	MaxUses   int       `json:"max_uses"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func NewInvitationsView(invitations []InvitationView) InvitationsView {
+	return InvitationsView{
+		Invitations: invitations,
+	}
+}
+
+func NewInvitationView(id, createdBy string, maxUses, uses int, expiresAt, createdAt, updatedAt time.Time) InvitationView {
+	return InvitationView{
+		BaseEntity: model.NewBaseEntity(id, createdAt, updatedAt),
+		CreatedBy:  createdBy,
+		MaxUses:    maxUses,
+		Uses:       uses,
+		ExpiresAt:  expiresAt,
+	}
+}
+
+func NewInvitationCreatedView(invitation InvitationView, code string) InvitationCreatedView {
+	return InvitationCreatedView{
+		Invitation: invitation,
+		Code:       code,
+	}
+}
@@ -0,0 +1,37 @@
+package model
+
+type JSONWebKeySetView struct {
+	Keys []JSONWebKeyView `json:"keys"`
+}
+
+type JSONWebKeyView struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	Curve     string `json:"crv,omitempty"`
+	Modulus   string `json:"n,omitempty"`
+	Exponent  string `json:"e,omitempty"`
+	X         string `json:"x,omitempty"`
+	Y         string `json:"y,omitempty"`
+}
+
+func NewJSONWebKeySetView(keys []JSONWebKeyView) JSONWebKeySetView {
+	return JSONWebKeySetView{
+		Keys: keys,
+	}
+}
+
+func NewJSONWebKeyView(keyType, keyID, use, algorithm, curve, modulus, exponent, x, y string) JSONWebKeyView {
+	return JSONWebKeyView{
+		KeyType:   keyType,
+		KeyID:     keyID,
+		Use:       use,
+		Algorithm: algorithm,
+		Curve:     curve,
+		Modulus:   modulus,
+		Exponent:  exponent,
+		X:         x,
+		Y:         y,
+	}
+}
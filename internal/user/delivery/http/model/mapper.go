@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
 	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/delivery/http"
 )
@@ -11,6 +13,7 @@ func UserCreateViewToUserCreateMapper(userCreateView UserCreateView) user.UserCr
 		userCreateView.Email,
 		userCreateView.Password,
 		userCreateView.PasswordConfirm,
+		userCreateView.InvitationCode,
 	)
 }
 
@@ -21,6 +24,23 @@ func UserUpdateViewToUserUpdateMapper(userUpdateView UserUpdateView) user.UserUp
 	)
 }
 
+func UserPasswordUpdateViewToUserPasswordUpdateMapper(userID string, userPasswordUpdateView UserPasswordUpdateView) user.UserPasswordUpdate {
+	return user.NewUserPasswordUpdate(
+		userID,
+		userPasswordUpdateView.CurrentPassword,
+		userPasswordUpdateView.Password,
+		userPasswordUpdateView.PasswordConfirm,
+	)
+}
+
+func UserEmailChangeViewToUserEmailChangeMapper(userID string, userEmailChangeView UserEmailChangeView) user.UserEmailChange {
+	return user.NewUserEmailChange(
+		userID,
+		userEmailChangeView.Email,
+		userEmailChangeView.Password,
+	)
+}
+
 func UserLoginViewToUserLoginMapper(userLoginView UserLoginView) user.UserLogin {
 	return user.NewUserLogin(
 		userLoginView.Email,
@@ -28,12 +48,43 @@ func UserLoginViewToUserLoginMapper(userLoginView UserLoginView) user.UserLogin
 	)
 }
 
+func UserTwoFactorCodeViewToUserTwoFactorCodeMapper(userID string, userTwoFactorCodeView UserTwoFactorCodeView) user.UserTwoFactorCode {
+	return user.NewUserTwoFactorCode(
+		userID,
+		userTwoFactorCodeView.Code,
+		userTwoFactorCodeView.RecoveryCode,
+	)
+}
+
+func UserTwoFactorDisableViewToUserTwoFactorDisableMapper(userID string, userTwoFactorDisableView UserTwoFactorDisableView) user.UserTwoFactorDisable {
+	return user.NewUserTwoFactorDisable(
+		userID,
+		userTwoFactorDisableView.CurrentPassword,
+		userTwoFactorDisableView.Code,
+		userTwoFactorDisableView.RecoveryCode,
+	)
+}
+
+func UserTwoFactorLoginViewToUserTwoFactorLoginMapper(userTwoFactorLoginView UserTwoFactorLoginView) user.UserTwoFactorLogin {
+	return user.NewUserTwoFactorLogin(
+		userTwoFactorLoginView.MFAToken,
+		userTwoFactorLoginView.Code,
+		userTwoFactorLoginView.RecoveryCode,
+	)
+}
+
 func UserForgottenPasswordViewToUserForgottenPassword(userForgottenPasswordView UserForgottenPasswordView) user.UserForgottenPassword {
 	return user.NewUserForgottenPassword(
 		userForgottenPasswordView.Email,
 	)
 }
 
+func UserMagicLinkViewToUserMagicLinkMapper(userMagicLinkView UserMagicLinkView) user.UserMagicLink {
+	return user.NewUserMagicLink(
+		userMagicLinkView.Email,
+	)
+}
+
 func UserResetPasswordViewToUserResetPassword(userResetPasswordView UserResetPasswordView) user.UserResetPassword {
 	return user.NewUserResetPassword(
 		userResetPasswordView.ResetToken,
@@ -42,6 +93,12 @@ func UserResetPasswordViewToUserResetPassword(userResetPasswordView UserResetPas
 	)
 }
 
+func UserVerificationCodeViewToUserVerificationCode(userVerificationCodeView UserVerificationCodeView) user.UserVerificationCode {
+	return user.NewUserVerificationCode(
+		userVerificationCodeView.Email,
+	)
+}
+
 func UsersToUsersViewMapper(users user.Users) UsersView {
 	usersView := make([]UserView, len(users.Users))
 	for index, user := range users.Users {
@@ -58,6 +115,7 @@ func UserToUserViewMapper(user user.User) UserView {
 	return NewUserView(
 		user.ID,
 		user.Username,
+		user.Handle,
 		user.Email,
 		user.Role,
 		user.CreatedAt,
@@ -65,6 +123,16 @@ func UserToUserViewMapper(user user.User) UserView {
 	)
 }
 
+func UserToUserProfileViewMapper(user user.User) UserProfileView {
+	return NewUserProfileView(
+		user.ID,
+		user.Username,
+		user.Handle,
+		user.CreatedAt,
+		user.UpdatedAt,
+	)
+}
+
 func UserLoginToUserLoginViewMapper(userLogin user.UserLogin) UserLoginView {
 	return NewUserLoginView(
 		userLogin.Email,
@@ -79,8 +147,201 @@ func UserTokenToUserTokenViewMapper(userToken user.UserToken) UserTokenView {
 	)
 }
 
+func UserTwoFactorEnrollmentToUserTwoFactorEnrollmentViewMapper(userTwoFactorEnrollment user.UserTwoFactorEnrollment) UserTwoFactorEnrollmentView {
+	return NewUserTwoFactorEnrollmentView(
+		userTwoFactorEnrollment.URI,
+		userTwoFactorEnrollment.Secret,
+		userTwoFactorEnrollment.RecoveryCodes,
+	)
+}
+
 func UserForgottenPasswordToUserForgottenPasswordViewMapper(userForgottenPassword user.UserForgottenPassword) UserForgottenPasswordView {
 	return NewUserForgottenPasswordView(
 		userForgottenPassword.Email,
 	)
 }
+
+func UserSessionsToUserSessionsViewMapper(userSessions user.UserSessions) UserSessionsView {
+	userSessionsView := make([]UserSessionView, len(userSessions.Sessions))
+	for index, userSession := range userSessions.Sessions {
+		userSessionsView[index] = UserSessionToUserSessionViewMapper(userSession)
+	}
+
+	return NewUserSessionsView(userSessionsView)
+}
+
+func UserSessionToUserSessionViewMapper(userSession user.UserSession) UserSessionView {
+	return NewUserSessionView(
+		userSession.ID,
+		userSession.UserAgent,
+		userSession.IPAddress,
+		userSession.LastUsedAt,
+		userSession.ExpiresAt,
+		userSession.Current,
+	)
+}
+
+func UsersToUsersAdminViewMapper(users user.Users) UsersAdminView {
+	usersAdminView := make([]UserAdminView, len(users.Users))
+	for index, user := range users.Users {
+		usersAdminView[index] = UserToUserAdminViewMapper(user)
+	}
+
+	return NewUsersAdminView(
+		usersAdminView,
+		model.NewHTTPPaginationResponse(users.PaginationResponse),
+	)
+}
+
+func UserToUserAdminViewMapper(user user.User) UserAdminView {
+	var suspendedUntil *time.Time
+	if !user.SuspendedUntil.IsZero() {
+		suspendedUntil = &user.SuspendedUntil
+	}
+
+	return NewUserAdminView(
+		user.ID,
+		user.Username,
+		user.Email,
+		user.Role,
+		user.Verified,
+		user.Suspended,
+		user.SuspensionReason,
+		suspendedUntil,
+		user.CreatedAt,
+		user.UpdatedAt,
+	)
+}
+
+func UserFilterViewToUserFilterMapper(userFilterView UserFilterView) user.UserFilter {
+	return user.NewUserFilter(
+		userFilterView.Role,
+		userFilterView.Verified,
+		userFilterView.CreatedFrom,
+		userFilterView.CreatedTo,
+	)
+}
+
+func UserRoleUpdateViewToUserRoleUpdateMapper(userID string, userRoleUpdateView UserRoleUpdateView) user.UserRoleUpdate {
+	return user.NewUserRoleUpdate(
+		userID,
+		userRoleUpdateView.Role,
+	)
+}
+
+func UserSuspensionViewToUserSuspensionMapper(userID string, userSuspensionView UserSuspensionView) user.UserSuspension {
+	return user.NewUserSuspension(
+		userID,
+		userSuspensionView.Reason,
+		userSuspensionView.SuspendedUntil,
+	)
+}
+
+func JSONWebKeySetToJSONWebKeySetViewMapper(jsonWebKeySet user.JSONWebKeySet) JSONWebKeySetView {
+	jsonWebKeysView := make([]JSONWebKeyView, len(jsonWebKeySet.Keys))
+	for index, jsonWebKey := range jsonWebKeySet.Keys {
+		jsonWebKeysView[index] = NewJSONWebKeyView(
+			jsonWebKey.KeyType,
+			jsonWebKey.KeyID,
+			jsonWebKey.Use,
+			jsonWebKey.Algorithm,
+			jsonWebKey.Curve,
+			jsonWebKey.Modulus,
+			jsonWebKey.Exponent,
+			jsonWebKey.X,
+			jsonWebKey.Y,
+		)
+	}
+
+	return NewJSONWebKeySetView(jsonWebKeysView)
+}
+
+func PersonalAccessTokenCreateViewToPersonalAccessTokenCreateMapper(userID string, personalAccessTokenCreateView PersonalAccessTokenCreateView) user.PersonalAccessTokenCreate {
+	return user.NewPersonalAccessTokenCreate(
+		userID,
+		personalAccessTokenCreateView.Name,
+		personalAccessTokenCreateView.Scopes,
+		personalAccessTokenCreateView.ExpiresAt,
+	)
+}
+
+func PersonalAccessTokensToPersonalAccessTokensViewMapper(personalAccessTokens user.PersonalAccessTokens) PersonalAccessTokensView {
+	personalAccessTokensView := make([]PersonalAccessTokenView, len(personalAccessTokens.PersonalAccessTokens))
+	for index, personalAccessToken := range personalAccessTokens.PersonalAccessTokens {
+		personalAccessTokensView[index] = PersonalAccessTokenToPersonalAccessTokenViewMapper(personalAccessToken)
+	}
+
+	return NewPersonalAccessTokensView(personalAccessTokensView)
+}
+
+func PersonalAccessTokenToPersonalAccessTokenViewMapper(personalAccessToken user.PersonalAccessToken) PersonalAccessTokenView {
+	var lastUsedAt *time.Time
+	if !personalAccessToken.LastUsedAt.IsZero() {
+		lastUsedAt = &personalAccessToken.LastUsedAt
+	}
+	var expiresAt *time.Time
+	if !personalAccessToken.ExpiresAt.IsZero() {
+		expiresAt = &personalAccessToken.ExpiresAt
+	}
+
+	return NewPersonalAccessTokenView(
+		personalAccessToken.ID,
+		personalAccessToken.Name,
+		personalAccessToken.Scopes,
+		lastUsedAt,
+		expiresAt,
+		personalAccessToken.CreatedAt,
+		personalAccessToken.UpdatedAt,
+	)
+}
+
+func PersonalAccessTokenCreatedToPersonalAccessTokenCreatedViewMapper(personalAccessTokenCreated user.PersonalAccessTokenCreated) PersonalAccessTokenCreatedView {
+	return NewPersonalAccessTokenCreatedView(
+		PersonalAccessTokenToPersonalAccessTokenViewMapper(personalAccessTokenCreated.PersonalAccessToken),
+		personalAccessTokenCreated.Token,
+	)
+}
+
+func InvitationCreateViewToInvitationCreateMapper(createdBy string, invitationCreateView InvitationCreateView) user.InvitationCreate {
+	return user.NewInvitationCreate(
+		createdBy,
+		invitationCreateView.MaxUses,
+		invitationCreateView.ExpiresAt,
+	)
+}
+
+func InvitationsToInvitationsViewMapper(invitations user.Invitations) InvitationsView {
+	invitationsView := make([]InvitationView, len(invitations.Invitations))
+	for index, invitation := range invitations.Invitations {
+		invitationsView[index] = InvitationToInvitationViewMapper(invitation)
+	}
+
+	return NewInvitationsView(invitationsView)
+}
+
+func InvitationToInvitationViewMapper(invitation user.Invitation) InvitationView {
+	return NewInvitationView(
+		invitation.ID,
+		invitation.CreatedBy,
+		invitation.MaxUses,
+		invitation.Uses,
+		invitation.ExpiresAt,
+		invitation.CreatedAt,
+		invitation.UpdatedAt,
+	)
+}
+
+func InvitationCreatedToInvitationCreatedViewMapper(invitationCreated user.InvitationCreated) InvitationCreatedView {
+	return NewInvitationCreatedView(
+		InvitationToInvitationViewMapper(invitationCreated.Invitation),
+		invitationCreated.Code,
+	)
+}
+
+func OAuthCallbackViewToOAuthLoginMapper(provider string, oauthCallbackView OAuthCallbackView) user.OAuthLogin {
+	return user.NewOAuthLogin(
+		provider,
+		oauthCallbackView.Code,
+		oauthCallbackView.State,
+	)
+}
@@ -0,0 +1,7 @@
+package model
+
+// OAuthCallbackView is the query the provider redirects back with after the user has signed in there.
+type OAuthCallbackView struct {
+	Code  string `form:"code"`
+	State string `form:"state"`
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"time"
+
+	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/delivery/http"
+)
+
+type PersonalAccessTokensView struct {
+	PersonalAccessTokens []PersonalAccessTokenView `json:"personal_access_tokens"`
+}
+
+// PersonalAccessTokenView describes a personal access token without its value, which is only shown on creation.
+type PersonalAccessTokenView struct {
+	model.BaseEntity
+	Name       string     `json:"name"`
+	Scopes     []string   `json:"scopes"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+}
+
+type PersonalAccessTokenCreatedView struct {
+	PersonalAccessToken PersonalAccessTokenView `json:"personal_access_token"`
+	Token               string                  `json:"token"`
+}
+
+// PersonalAccessTokenCreateView describes a new personal access token, an empty expires_at means the token doesn't expire.
+type PersonalAccessTokenCreateView struct {
+	Name      string    `json:"name"`
+	Scopes    []string  `json:"scopes"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func NewPersonalAccessTokensView(personalAccessTokens []PersonalAccessTokenView) PersonalAccessTokensView {
+	return PersonalAccessTokensView{
+		PersonalAccessTokens: personalAccessTokens,
+	}
+}
+
+func NewPersonalAccessTokenView(id, name string, scopes []string, lastUsedAt, expiresAt *time.Time, createdAt, updatedAt time.Time) PersonalAccessTokenView {
+	return PersonalAccessTokenView{
+		BaseEntity: model.NewBaseEntity(id, createdAt, updatedAt),
+		Name:       name,
+		Scopes:     scopes,
+		LastUsedAt: lastUsedAt,
+		ExpiresAt:  expiresAt,
+	}
+}
+
+func NewPersonalAccessTokenCreatedView(personalAccessToken PersonalAccessTokenView, token string) PersonalAccessTokenCreatedView {
+	return PersonalAccessTokenCreatedView{
+		PersonalAccessToken: personalAccessToken,
+		Token:               token,
+	}
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+)
+
+type UserSessionsView struct {
+	Sessions []UserSessionView `json:"sessions"`
+}
+
+type UserSessionView struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"`
+}
+
+func NewUserSessionsView(sessions []UserSessionView) UserSessionsView {
+	return UserSessionsView{
+		Sessions: sessions,
+	}
+}
+
+func NewUserSessionView(id, userAgent, ipAddress string, lastUsedAt, expiresAt time.Time, current bool) UserSessionView {
+	return UserSessionView{
+		ID:         id,
+		UserAgent:  userAgent,
+		IPAddress:  ipAddress,
+		LastUsedAt: lastUsedAt,
+		ExpiresAt:  expiresAt,
+		Current:    current,
+	}
+}
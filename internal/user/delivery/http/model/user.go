@@ -18,15 +18,24 @@ type UsersView struct {
 type UserView struct {
 	model.BaseEntity
 	Username string `json:"username"`
+	Handle   string `json:"handle"`
 	Email    string `json:"email"`
 	Role     string `json:"role"`
 }
 
+// UserProfileView is the public profile of a user, it leaves out the email and the role.
+type UserProfileView struct {
+	model.BaseEntity
+	Username string `json:"username"`
+	Handle   string `json:"handle"`
+}
+
 type UserCreateView struct {
 	Username        string `json:"username"`
 	Email           string `json:"email"`
 	Password        string `json:"password"`
 	PasswordConfirm string `json:"password_confirm"`
+	InvitationCode  string `json:"invitation_code,omitempty"`
 }
 
 type UserUpdateView struct {
@@ -34,6 +43,17 @@ type UserUpdateView struct {
 	Username string `json:"username"`
 }
 
+type UserPasswordUpdateView struct {
+	CurrentPassword string `json:"current_password"`
+	Password        string `json:"password"`
+	PasswordConfirm string `json:"password_confirm"`
+}
+
+type UserEmailChangeView struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
 type UserLoginView struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
@@ -44,16 +64,52 @@ type UserTokenView struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
+type UserMFAPendingView struct {
+	MFARequired bool   `json:"mfa_required"`
+	MFAToken    string `json:"mfa_token"`
+}
+
+type UserTwoFactorEnrollmentView struct {
+	OTPAuthURI    string   `json:"otpauth_uri"`
+	Secret        string   `json:"secret"`
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type UserTwoFactorCodeView struct {
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recovery_code"`
+}
+
+type UserTwoFactorDisableView struct {
+	CurrentPassword string `json:"current_password"`
+	Code            string `json:"code"`
+	RecoveryCode    string `json:"recovery_code"`
+}
+
+type UserTwoFactorLoginView struct {
+	MFAToken     string `json:"mfa_token"`
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recovery_code"`
+}
+
 type UserForgottenPasswordView struct {
 	Email string `json:"email"`
 }
 
+type UserMagicLinkView struct {
+	Email string `json:"email"`
+}
+
 type UserResetPasswordView struct {
 	ResetToken      string `json:"reset_token"`
 	Password        string `json:"password"`
 	PasswordConfirm string `json:"password_confirm"`
 }
 
+type UserVerificationCodeView struct {
+	Email string `json:"email"`
+}
+
 type UserWelcomeMessageView struct {
 	Notification string `json:"notification"`
 }
@@ -71,20 +127,31 @@ func NewUsersView(users []UserView, paginationResponse model.HTTPPaginationRespo
 	}
 }
 
-func NewUserView(id string, username, email, role string, createdAt, updatedAt time.Time) UserView {
+func NewUserView(id string, username, handle, email, role string, createdAt, updatedAt time.Time) UserView {
 	return UserView{
 		BaseEntity: model.NewBaseEntity(id, createdAt, updatedAt),
 		Username:   username,
+		Handle:     handle,
 		Email:      email,
 		Role:       role,
 	}
 }
 
-func NewUserCreateView(username, email, password, passwordConfirm string) UserCreateView {
+func NewUserProfileView(id string, username, handle string, createdAt, updatedAt time.Time) UserProfileView {
+	return UserProfileView{
+		BaseEntity: model.NewBaseEntity(id, createdAt, updatedAt),
+		Username:   username,
+		Handle:     handle,
+	}
+}
+
+func NewUserCreateView(username, email, password, passwordConfirm, invitationCode string) UserCreateView {
 	return UserCreateView{
+		Username:        username,
 		Email:           email,
 		Password:        password,
 		PasswordConfirm: passwordConfirm,
+		InvitationCode:  invitationCode,
 	}
 }
 
@@ -108,6 +175,12 @@ func NewUserForgottenPasswordView(email string) UserForgottenPasswordView {
 	}
 }
 
+func NewUserMagicLinkView(email string) UserMagicLinkView {
+	return UserMagicLinkView{
+		Email: email,
+	}
+}
+
 func NewUserResetPasswordView(resetToken, password, passwordConfirm string) UserResetPasswordView {
 	return UserResetPasswordView{
 		ResetToken:      resetToken,
@@ -116,9 +189,30 @@ func NewUserResetPasswordView(resetToken, password, passwordConfirm string) User
 	}
 }
 
+func NewUserVerificationCodeView(email string) UserVerificationCodeView {
+	return UserVerificationCodeView{
+		Email: email,
+	}
+}
+
 func NewUserTokenView(accessToken, refreshToken string) UserTokenView {
 	return UserTokenView{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
 	}
 }
+
+func NewUserMFAPendingView(mfaToken string) UserMFAPendingView {
+	return UserMFAPendingView{
+		MFARequired: true,
+		MFAToken:    mfaToken,
+	}
+}
+
+func NewUserTwoFactorEnrollmentView(otpAuthURI, secret string, recoveryCodes []string) UserTwoFactorEnrollmentView {
+	return UserTwoFactorEnrollmentView{
+		OTPAuthURI:    otpAuthURI,
+		Secret:        secret,
+		RecoveryCodes: recoveryCodes,
+	}
+}
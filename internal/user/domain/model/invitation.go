@@ -0,0 +1,67 @@
+package model
+
+import (
+	"time"
+
+	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/domain"
+)
+
+type Invitations struct {
+	Invitations []Invitation
+}
+
+// Invitation lets users register while the registration is invite-only.
+// Only the hash of the code is stored, the code can be used MaxUses times until it expires or is revoked.
+type Invitation struct {
+	model.BaseEntity
+	CreatedBy string
+	MaxUses   int
+	Uses      int
+	ExpiresAt time.Time
+	Revoked   bool
+}
+
+type InvitationCreate struct {
+	CreatedBy string
+	MaxUses   int
+	ExpiresAt time.Time
+	CodeHash  string
+}
+
+// InvitationCreated holds the invitation code, which is only shown once right after the creation.
+type InvitationCreated struct {
+	Invitation Invitation
+	Code       string
+}
+
+func NewInvitations(invitations []Invitation) Invitations {
+	return Invitations{
+		Invitations: invitations,
+	}
+}
+
+func NewInvitation(id, createdBy string, maxUses, uses int, expiresAt time.Time, revoked bool, createdAt, updatedAt time.Time) Invitation {
+	return Invitation{
+		BaseEntity: model.NewBaseEntity(id, createdAt, updatedAt),
+		CreatedBy:  createdBy,
+		MaxUses:    maxUses,
+		Uses:       uses,
+		ExpiresAt:  expiresAt,
+		Revoked:    revoked,
+	}
+}
+
+func NewInvitationCreate(createdBy string, maxUses int, expiresAt time.Time) InvitationCreate {
+	return InvitationCreate{
+		CreatedBy: createdBy,
+		MaxUses:   maxUses,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func NewInvitationCreated(invitation Invitation, code string) InvitationCreated {
+	return InvitationCreated{
+		Invitation: invitation,
+		Code:       code,
+	}
+}
@@ -0,0 +1,41 @@
+package model
+
+// JSONWebKeySet holds the public keys that verify the tokens issued by the application (RFC 7517).
+type JSONWebKeySet struct {
+	Keys []JSONWebKey
+}
+
+// JSONWebKey is the public part of a signing key, the key values are base64url encoded without padding.
+// RSA keys carry the modulus and the exponent, elliptic curve keys the curve and its coordinates
+// and Ed25519 keys the curve and the X coordinate only.
+type JSONWebKey struct {
+	KeyType   string
+	KeyID     string
+	Use       string
+	Algorithm string
+	Curve     string
+	Modulus   string
+	Exponent  string
+	X         string
+	Y         string
+}
+
+func NewJSONWebKeySet(keys []JSONWebKey) JSONWebKeySet {
+	return JSONWebKeySet{
+		Keys: keys,
+	}
+}
+
+func NewJSONWebKey(keyType, keyID, use, algorithm, curve, modulus, exponent, x, y string) JSONWebKey {
+	return JSONWebKey{
+		KeyType:   keyType,
+		KeyID:     keyID,
+		Use:       use,
+		Algorithm: algorithm,
+		Curve:     curve,
+		Modulus:   modulus,
+		Exponent:  exponent,
+		X:         x,
+		Y:         y,
+	}
+}
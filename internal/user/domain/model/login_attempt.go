@@ -0,0 +1,40 @@
+package model
+
+import "time"
+
+// LoginAttempt holds the failed login attempts made for a single key, either an email or an IP address.
+type LoginAttempt struct {
+	Key            string
+	FailedAttempts int
+	BlockedUntil   time.Time
+	Locked         bool
+	ExpiresAt      time.Time
+}
+
+// LoginAttemptBlock blocks further login attempts for a key until the provided time.
+type LoginAttemptBlock struct {
+	Key          string
+	BlockedUntil time.Time
+	Locked       bool
+	UnlockToken  string
+	ExpiresAt    time.Time
+}
+
+func NewLoginAttempt(key string, failedAttempts int, blockedUntil time.Time, locked bool, expiresAt time.Time) LoginAttempt {
+	return LoginAttempt{
+		Key:            key,
+		FailedAttempts: failedAttempts,
+		BlockedUntil:   blockedUntil,
+		Locked:         locked,
+		ExpiresAt:      expiresAt,
+	}
+}
+
+func NewLoginAttemptBlock(key string, blockedUntil time.Time, locked bool, expiresAt time.Time) LoginAttemptBlock {
+	return LoginAttemptBlock{
+		Key:          key,
+		BlockedUntil: blockedUntil,
+		Locked:       locked,
+		ExpiresAt:    expiresAt,
+	}
+}
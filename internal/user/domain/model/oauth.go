@@ -0,0 +1,95 @@
+package model
+
+import (
+	"time"
+
+	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/domain"
+)
+
+// OAuthState is a pending social login. It binds the callback to the provider the login was started with
+// and keeps the PKCE code verifier, only the hash of the state is stored.
+type OAuthState struct {
+	StateHash    string
+	Provider     string
+	CodeVerifier string
+	ExpiresAt    time.Time
+}
+
+// OAuthLogin is the callback of a provider, carrying the authorization code and the state of the login.
+type OAuthLogin struct {
+	Provider string
+	Code     string
+	State    string
+}
+
+// OAuthIdentity is the account of the user at the provider, the subject identifies it for good,
+// while the email is only trusted to link an existing user when the provider has verified it.
+type OAuthIdentity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Username      string
+}
+
+// UserIdentity links an external identity to a user, so later logins don't depend on the email.
+type UserIdentity struct {
+	model.BaseEntity
+	UserID   string
+	Provider string
+	Subject  string
+	Email    string
+}
+
+type UserIdentityCreate struct {
+	UserID   string
+	Provider string
+	Subject  string
+	Email    string
+}
+
+func NewOAuthState(stateHash, provider, codeVerifier string, expiresAt time.Time) OAuthState {
+	return OAuthState{
+		StateHash:    stateHash,
+		Provider:     provider,
+		CodeVerifier: codeVerifier,
+		ExpiresAt:    expiresAt,
+	}
+}
+
+func NewOAuthLogin(provider, code, state string) OAuthLogin {
+	return OAuthLogin{
+		Provider: provider,
+		Code:     code,
+		State:    state,
+	}
+}
+
+func NewOAuthIdentity(provider, subject, email string, emailVerified bool, username string) OAuthIdentity {
+	return OAuthIdentity{
+		Provider:      provider,
+		Subject:       subject,
+		Email:         email,
+		EmailVerified: emailVerified,
+		Username:      username,
+	}
+}
+
+func NewUserIdentity(id, userID, provider, subject, email string, createdAt, updatedAt time.Time) UserIdentity {
+	return UserIdentity{
+		BaseEntity: model.NewBaseEntity(id, createdAt, updatedAt),
+		UserID:     userID,
+		Provider:   provider,
+		Subject:    subject,
+		Email:      email,
+	}
+}
+
+func NewUserIdentityCreate(userID, provider, subject, email string) UserIdentityCreate {
+	return UserIdentityCreate{
+		UserID:   userID,
+		Provider: provider,
+		Subject:  subject,
+		Email:    email,
+	}
+}
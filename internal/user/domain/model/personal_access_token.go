@@ -0,0 +1,71 @@
+package model
+
+import (
+	"time"
+
+	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/domain"
+)
+
+type PersonalAccessTokens struct {
+	PersonalAccessTokens []PersonalAccessToken
+}
+
+// PersonalAccessToken is a long-lived credential of an API client, limited to its scopes.
+// Only the hash of the token is stored, a zero ExpiresAt means the token doesn't expire.
+type PersonalAccessToken struct {
+	model.BaseEntity
+	UserID     string
+	Name       string
+	Scopes     []string
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+	Revoked    bool
+}
+
+type PersonalAccessTokenCreate struct {
+	UserID    string
+	Name      string
+	Scopes    []string
+	TokenHash string
+	ExpiresAt time.Time
+}
+
+// PersonalAccessTokenCreated holds the token value, which is only shown once right after the creation.
+type PersonalAccessTokenCreated struct {
+	PersonalAccessToken PersonalAccessToken
+	Token               string
+}
+
+func NewPersonalAccessTokens(personalAccessTokens []PersonalAccessToken) PersonalAccessTokens {
+	return PersonalAccessTokens{
+		PersonalAccessTokens: personalAccessTokens,
+	}
+}
+
+func NewPersonalAccessToken(id, userID, name string, scopes []string, lastUsedAt, expiresAt time.Time, revoked bool, createdAt, updatedAt time.Time) PersonalAccessToken {
+	return PersonalAccessToken{
+		BaseEntity: model.NewBaseEntity(id, createdAt, updatedAt),
+		UserID:     userID,
+		Name:       name,
+		Scopes:     scopes,
+		LastUsedAt: lastUsedAt,
+		ExpiresAt:  expiresAt,
+		Revoked:    revoked,
+	}
+}
+
+func NewPersonalAccessTokenCreate(userID, name string, scopes []string, expiresAt time.Time) PersonalAccessTokenCreate {
+	return PersonalAccessTokenCreate{
+		UserID:    userID,
+		Name:      name,
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func NewPersonalAccessTokenCreated(personalAccessToken PersonalAccessToken, token string) PersonalAccessTokenCreated {
+	return PersonalAccessTokenCreated{
+		PersonalAccessToken: personalAccessToken,
+		Token:               token,
+	}
+}
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"time"
+
+	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/domain"
+)
+
+type RefreshToken struct {
+	model.BaseEntity
+	TokenID    string
+	FamilyID   string
+	UserID     string
+	UserAgent  string
+	IPAddress  string
+	ExpiresAt  time.Time
+	Revoked    bool
+	ReplacedBy string
+}
+
+type RefreshTokenCreate struct {
+	TokenID   string
+	FamilyID  string
+	UserID    string
+	UserAgent string
+	IPAddress string
+	ExpiresAt time.Time
+}
+
+type UserDevice struct {
+	UserAgent string
+	IPAddress string
+}
+
+func NewRefreshToken(id, tokenID, familyID, userID, userAgent, ipAddress string, expiresAt time.Time, revoked bool, replacedBy string, createdAt, updatedAt time.Time) RefreshToken {
+	return RefreshToken{
+		BaseEntity: model.NewBaseEntity(id, createdAt, updatedAt),
+		TokenID:    tokenID,
+		FamilyID:   familyID,
+		UserID:     userID,
+		UserAgent:  userAgent,
+		IPAddress:  ipAddress,
+		ExpiresAt:  expiresAt,
+		Revoked:    revoked,
+		ReplacedBy: replacedBy,
+	}
+}
+
+func NewRefreshTokenCreate(tokenID, familyID, userID string, userDevice UserDevice, expiresAt time.Time) RefreshTokenCreate {
+	return RefreshTokenCreate{
+		TokenID:   tokenID,
+		FamilyID:  familyID,
+		UserID:    userID,
+		UserAgent: userDevice.UserAgent,
+		IPAddress: userDevice.IPAddress,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func NewUserDevice(userAgent, ipAddress string) UserDevice {
+	return UserDevice{
+		UserAgent: userAgent,
+		IPAddress: ipAddress,
+	}
+}
+
+type UserSessions struct {
+	Sessions []UserSession
+}
+
+// UserSession describes a login on a device, it is identified by the refresh token family.
+type UserSession struct {
+	ID         string
+	UserAgent  string
+	IPAddress  string
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+	Current    bool
+}
+
+func NewUserSessions(sessions []UserSession) UserSessions {
+	return UserSessions{
+		Sessions: sessions,
+	}
+}
+
+func NewUserSession(id, userAgent, ipAddress string, lastUsedAt, expiresAt time.Time) UserSession {
+	return UserSession{
+		ID:         id,
+		UserAgent:  userAgent,
+		IPAddress:  ipAddress,
+		LastUsedAt: lastUsedAt,
+		ExpiresAt:  expiresAt,
+	}
+}
@@ -12,28 +12,78 @@ type Users struct {
 	PaginationResponse common.PaginationResponse
 }
 
+// User is identified in profile URLs by the handle, the normalized username, which is unique ignoring case.
 type User struct {
 	model.BaseEntity
-	Username string
-	Email    string
-	Password string
-	Role     string
-	Verified bool
+	Username          string
+	Handle            string
+	Email             string
+	Password          string
+	MustResetPassword bool
+	Role              string
+	Verified          bool
+	Suspended         bool
+	SuspensionReason  string
+	SuspendedUntil    time.Time
+	TwoFactorEnabled  bool
+	TwoFactorSecret   string
+	TwoFactorLastStep int64
+	RecoveryCodes     []string
 }
 
+type UserFilter struct {
+	Role        string
+	Verified    *bool
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+}
+
+type UserRoleUpdate struct {
+	ID   string
+	Role string
+}
+
+type UserSuspension struct {
+	ID             string
+	Reason         string
+	SuspendedUntil time.Time
+}
+
+// UserCreate holds the invitation code when the registration is invite-only, the code itself is not stored with the user.
 type UserCreate struct {
-	Username         string
-	Email            string
-	Password         string
-	PasswordConfirm  string
-	Role             string
-	Verified         bool
-	VerificationCode string
+	Username           string
+	Handle             string
+	Email              string
+	Password           string
+	PasswordConfirm    string
+	InvitationCode     string
+	Role               string
+	Verified           bool
+	VerificationCode   string
+	VerificationExpiry time.Time
 }
 
 type UserUpdate struct {
-	ID        string
-	Username  string
+	ID       string
+	Username string
+	Handle   string
+}
+
+type UserPasswordUpdate struct {
+	ID              string
+	CurrentPassword string
+	Password        string
+	PasswordConfirm string
+}
+
+// UserEmailChange is a pending change of the email address, it is applied once the new address is confirmed.
+type UserEmailChange struct {
+	ID                string
+	Email             string
+	Password          string
+	ConfirmationToken string
+	CancelToken       string
+	Expiry            time.Time
 }
 
 type UserLogin struct {
@@ -41,15 +91,66 @@ type UserLogin struct {
 	Password string
 }
 
+// UserToken holds either the issued token pair or, for users with two-factor authentication,
+// the MFA token that has to be exchanged for the token pair in the second login step.
 type UserToken struct {
 	AccessToken  string
 	RefreshToken string
+	MFAToken     string
+}
+
+// UserMFAToken is the short-lived token of a login that is waiting for the second factor.
+type UserMFAToken struct {
+	ID        string
+	MFAToken  string
+	MFAExpiry time.Time
+}
+
+// UserTwoFactorEnrollment holds the TOTP secret and the recovery codes of a pending enrollment.
+type UserTwoFactorEnrollment struct {
+	ID            string
+	Secret        string
+	URI           string
+	RecoveryCodes []string
+}
+
+// UserTwoFactorCode is a TOTP code or a recovery code presented by an authenticated user.
+type UserTwoFactorCode struct {
+	ID           string
+	Code         string
+	RecoveryCode string
 }
 
+// UserTwoFactorDisable is the current password of the user with a TOTP code or a recovery code.
+type UserTwoFactorDisable struct {
+	ID              string
+	CurrentPassword string
+	Code            string
+	RecoveryCode    string
+}
+
+// UserTwoFactorLogin is the second login step, the MFA token with a TOTP code or a recovery code.
+type UserTwoFactorLogin struct {
+	MFAToken     string
+	Code         string
+	RecoveryCode string
+}
+
+// UserMagicLink is a passwordless login request, the hashed token of the link sent by email can be used once.
+type UserMagicLink struct {
+	ID              string
+	Email           string
+	MagicLinkToken  string
+	MagicLinkExpiry time.Time
+}
+
+// UserForgottenPassword marks the password as one that must be reset when the reset is forced by an administrator,
+// until then the user can't log in.
 type UserForgottenPassword struct {
-	Email       string
-	ResetToken  string
-	ResetExpiry time.Time
+	Email             string
+	ResetToken        string
+	ResetExpiry       time.Time
+	MustResetPassword bool
 }
 
 type UserResetPassword struct {
@@ -58,10 +159,23 @@ type UserResetPassword struct {
 	PasswordConfirm string
 }
 
+// UserResetExpiry also holds the username and the email, the new password must not contain them.
 type UserResetExpiry struct {
+	Username    string
+	Email       string
 	ResetExpiry time.Time
 }
 
+type UserVerificationCode struct {
+	Email              string
+	VerificationCode   string
+	VerificationExpiry time.Time
+}
+
+type UserVerificationExpiry struct {
+	VerificationExpiry time.Time
+}
+
 func NewUsers(users []User, paginationResponse common.PaginationResponse) Users {
 	return Users{
 		Users:              users,
@@ -69,23 +183,57 @@ func NewUsers(users []User, paginationResponse common.PaginationResponse) Users
 	}
 }
 
-func NewUser(id string, username, email, password, role string, verified bool, createdAt, updatedAt time.Time) User {
+func NewUser(id string, username, handle, email, password string, mustResetPassword bool, role string, verified, suspended bool, suspensionReason string, suspendedUntil time.Time, twoFactorEnabled bool, twoFactorSecret string, twoFactorLastStep int64, recoveryCodes []string, createdAt, updatedAt time.Time) User {
 	return User{
-		BaseEntity: model.NewBaseEntity(id, createdAt, updatedAt),
-		Username:   username,
-		Email:      email,
-		Password:   password,
-		Role:       role,
-		Verified:   verified,
+		BaseEntity:        model.NewBaseEntity(id, createdAt, updatedAt),
+		Username:          username,
+		Handle:            handle,
+		Email:             email,
+		Password:          password,
+		MustResetPassword: mustResetPassword,
+		Role:              role,
+		Verified:          verified,
+		Suspended:         suspended,
+		SuspensionReason:  suspensionReason,
+		SuspendedUntil:    suspendedUntil,
+		TwoFactorEnabled:  twoFactorEnabled,
+		TwoFactorSecret:   twoFactorSecret,
+		TwoFactorLastStep: twoFactorLastStep,
+		RecoveryCodes:     recoveryCodes,
 	}
 }
 
-func NewUserCreate(username, email, password, passwordConfirm string) UserCreate {
+func NewUserFilter(role string, verified *bool, createdFrom, createdTo time.Time) UserFilter {
+	return UserFilter{
+		Role:        role,
+		Verified:    verified,
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
+	}
+}
+
+func NewUserRoleUpdate(id, role string) UserRoleUpdate {
+	return UserRoleUpdate{
+		ID:   id,
+		Role: role,
+	}
+}
+
+func NewUserSuspension(id, reason string, suspendedUntil time.Time) UserSuspension {
+	return UserSuspension{
+		ID:             id,
+		Reason:         reason,
+		SuspendedUntil: suspendedUntil,
+	}
+}
+
+func NewUserCreate(username, email, password, passwordConfirm, invitationCode string) UserCreate {
 	return UserCreate{
 		Username:        username,
 		Email:           email,
 		Password:        password,
 		PasswordConfirm: passwordConfirm,
+		InvitationCode:  invitationCode,
 	}
 }
 
@@ -96,6 +244,23 @@ func NewUserUpdate(id, username string) UserUpdate {
 	}
 }
 
+func NewUserPasswordUpdate(id, currentPassword, password, passwordConfirm string) UserPasswordUpdate {
+	return UserPasswordUpdate{
+		ID:              id,
+		CurrentPassword: currentPassword,
+		Password:        password,
+		PasswordConfirm: passwordConfirm,
+	}
+}
+
+func NewUserEmailChange(id, email, password string) UserEmailChange {
+	return UserEmailChange{
+		ID:       id,
+		Email:    email,
+		Password: password,
+	}
+}
+
 func NewUserLogin(email, password string) UserLogin {
 	return UserLogin{
 		Email:    email,
@@ -110,6 +275,54 @@ func NewUserToken(accessToken, refreshToken string) UserToken {
 	}
 }
 
+func NewUserMFAToken(id, mfaToken string, mfaExpiry time.Time) UserMFAToken {
+	return UserMFAToken{
+		ID:        id,
+		MFAToken:  mfaToken,
+		MFAExpiry: mfaExpiry,
+	}
+}
+
+func NewUserMagicLink(email string) UserMagicLink {
+	return UserMagicLink{
+		Email: email,
+	}
+}
+
+func NewUserTwoFactorEnrollment(id, secret, uri string, recoveryCodes []string) UserTwoFactorEnrollment {
+	return UserTwoFactorEnrollment{
+		ID:            id,
+		Secret:        secret,
+		URI:           uri,
+		RecoveryCodes: recoveryCodes,
+	}
+}
+
+func NewUserTwoFactorCode(id, code, recoveryCode string) UserTwoFactorCode {
+	return UserTwoFactorCode{
+		ID:           id,
+		Code:         code,
+		RecoveryCode: recoveryCode,
+	}
+}
+
+func NewUserTwoFactorDisable(id, currentPassword, code, recoveryCode string) UserTwoFactorDisable {
+	return UserTwoFactorDisable{
+		ID:              id,
+		CurrentPassword: currentPassword,
+		Code:            code,
+		RecoveryCode:    recoveryCode,
+	}
+}
+
+func NewUserTwoFactorLogin(mfaToken, code, recoveryCode string) UserTwoFactorLogin {
+	return UserTwoFactorLogin{
+		MFAToken:     mfaToken,
+		Code:         code,
+		RecoveryCode: recoveryCode,
+	}
+}
+
 func NewUserForgottenPassword(email string) UserForgottenPassword {
 	return UserForgottenPassword{
 		Email: email,
@@ -124,8 +337,22 @@ func NewUserResetPassword(resetToken, password, passwordConfirm string) UserRese
 	}
 }
 
-func NewUserResetExpiry(resetExpiry time.Time) UserResetExpiry {
+func NewUserResetExpiry(username, email string, resetExpiry time.Time) UserResetExpiry {
 	return UserResetExpiry{
+		Username:    username,
+		Email:       email,
 		ResetExpiry: resetExpiry,
 	}
 }
+
+func NewUserVerificationCode(email string) UserVerificationCode {
+	return UserVerificationCode{
+		Email: email,
+	}
+}
+
+func NewUserVerificationExpiry(verificationExpiry time.Time) UserVerificationExpiry {
+	return UserVerificationExpiry{
+		VerificationExpiry: verificationExpiry,
+	}
+}
@@ -1,8 +1,13 @@
 package model
 
+// UserTokenPayload identifies the user of a request. Requests authenticated with a personal access token
+// carry its scopes instead of a session.
 type UserTokenPayload struct {
-	UserID string
-	Role   string
+	UserID    string
+	Role      string
+	TokenID   string
+	SessionID string
+	Scopes    []string
 }
 
 func NewUserTokenPayload(userID, role string) UserTokenPayload {
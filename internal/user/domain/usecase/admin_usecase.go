@@ -0,0 +1,278 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/thanhpk/randstr"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/common"
+	policy "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/policy"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	actingOnYourself  = "Users can't change their own role or suspend themselves."
+	lastAdminDemotion = "Sorry, the last administrator can't be demoted or deleted."
+)
+
+// AdminUseCase contains the user management operations available to administrators.
+type AdminUseCase struct {
+	Config                 *config.ApplicationConfig
+	Logger                 interfaces.Logger
+	Email                  interfaces.Email
+	UserRepository         interfaces.UserRepository
+	RefreshTokenRepository interfaces.RefreshTokenRepository
+	InvitationRepository   interfaces.InvitationRepository
+}
+
+func NewAdminUseCase(config *config.ApplicationConfig, logger interfaces.Logger, email interfaces.Email, userRepository interfaces.UserRepository, refreshTokenRepository interfaces.RefreshTokenRepository, invitationRepository interfaces.InvitationRepository) AdminUseCase {
+	return AdminUseCase{
+		Config:                 config,
+		Logger:                 logger,
+		Email:                  email,
+		UserRepository:         userRepository,
+		RefreshTokenRepository: refreshTokenRepository,
+		InvitationRepository:   invitationRepository,
+	}
+}
+
+func (adminUseCase AdminUseCase) GetAllUsers(ctx context.Context, paginationQuery common.PaginationQuery, userFilterData user.UserFilter) common.Result[user.Users] {
+	userFilter := validateUserFilter(adminUseCase.Logger, userFilterData)
+	if validator.IsError(userFilter.Error) {
+		return common.NewResultOnFailure[user.Users](domain.HandleError(userFilter.Error))
+	}
+
+	fetchedUsers := adminUseCase.UserRepository.GetAllUsersByFilter(ctx, paginationQuery, userFilter.Data)
+	if validator.IsError(fetchedUsers.Error) {
+		return common.NewResultOnFailure[user.Users](domain.HandleError(fetchedUsers.Error))
+	}
+
+	return fetchedUsers
+}
+
+func (adminUseCase AdminUseCase) GetUserById(ctx context.Context, userID string) common.Result[user.User] {
+	fetchedUser := adminUseCase.UserRepository.GetUserById(ctx, userID)
+	if validator.IsError(fetchedUser.Error) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(fetchedUser.Error))
+	}
+
+	return fetchedUser
+}
+
+// UpdateUserRole changes the role of the user and logs the user out everywhere,
+// so the new role is applied to the next issued tokens.
+// The current user can change only the role of a less privileged user, can't grant a role higher than their own,
+// and the last administrator can't be demoted.
+func (adminUseCase AdminUseCase) UpdateUserRole(ctx context.Context, userRoleUpdateData user.UserRoleUpdate, currentUserID, currentUserRole string) common.Result[user.User] {
+	userRoleUpdate := validateUserRoleUpdate(adminUseCase.Logger, userRoleUpdateData)
+	if validator.IsError(userRoleUpdate.Error) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(userRoleUpdate.Error))
+	}
+
+	targetUser := adminUseCase.checkTargetUser(ctx, location+"UpdateUserRole", userRoleUpdate.Data.ID, currentUserID)
+	if validator.IsError(targetUser.Error) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(targetUser.Error))
+	}
+
+	checkLastAdminError := adminUseCase.checkLastAdmin(ctx, targetUser.Data, userRoleUpdate.Data.Role)
+	if validator.IsError(checkLastAdminError) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(checkLastAdminError))
+	}
+
+	checkOutranksError := policy.CheckOutranks(adminUseCase.Logger, location+"UpdateUserRole", currentUserRole, targetUser.Data.Role)
+	if validator.IsError(checkOutranksError) {
+		return common.NewResultOnFailure[user.User](checkOutranksError)
+	}
+
+	checkRoleGrantError := policy.CheckRoleGrant(adminUseCase.Logger, location+"UpdateUserRole", currentUserRole, userRoleUpdate.Data.Role)
+	if validator.IsError(checkRoleGrantError) {
+		return common.NewResultOnFailure[user.User](checkRoleGrantError)
+	}
+
+	updateUserRoleError := adminUseCase.UserRepository.UpdateUserRole(ctx, userRoleUpdate.Data)
+	if validator.IsError(updateUserRoleError) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(updateUserRoleError))
+	}
+
+	revokeAllSessionsError := adminUseCase.RefreshTokenRepository.RevokeAllSessions(ctx, userRoleUpdate.Data.ID)
+	if validator.IsError(revokeAllSessionsError) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(revokeAllSessionsError))
+	}
+
+	return adminUseCase.GetUserById(ctx, userRoleUpdate.Data.ID)
+}
+
+// UpdateUserVerification marks the user as verified or unverified, the current user can change only a less privileged user.
+func (adminUseCase AdminUseCase) UpdateUserVerification(ctx context.Context, userID string, verified bool, currentUserID, currentUserRole string) common.Result[user.User] {
+	targetUser := adminUseCase.checkManagedUser(ctx, location+"UpdateUserVerification", userID, currentUserID, currentUserRole)
+	if validator.IsError(targetUser.Error) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(targetUser.Error))
+	}
+
+	updateUserVerificationError := adminUseCase.UserRepository.UpdateUserVerification(ctx, userID, verified)
+	if validator.IsError(updateUserVerificationError) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(updateUserVerificationError))
+	}
+
+	return adminUseCase.GetUserById(ctx, userID)
+}
+
+// SuspendUser suspends the user with a reason, either permanently or until the provided time,
+// and logs the user out everywhere. The current user can suspend only a less privileged user.
+func (adminUseCase AdminUseCase) SuspendUser(ctx context.Context, userSuspensionData user.UserSuspension, currentUserID, currentUserRole string) common.Result[user.User] {
+	userSuspension := validateUserSuspension(adminUseCase.Logger, userSuspensionData)
+	if validator.IsError(userSuspension.Error) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(userSuspension.Error))
+	}
+
+	targetUser := adminUseCase.checkManagedUser(ctx, location+"SuspendUser", userSuspension.Data.ID, currentUserID, currentUserRole)
+	if validator.IsError(targetUser.Error) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(targetUser.Error))
+	}
+
+	suspendUserError := adminUseCase.UserRepository.SuspendUser(ctx, userSuspension.Data)
+	if validator.IsError(suspendUserError) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(suspendUserError))
+	}
+
+	revokeAllSessionsError := adminUseCase.RefreshTokenRepository.RevokeAllSessions(ctx, userSuspension.Data.ID)
+	if validator.IsError(revokeAllSessionsError) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(revokeAllSessionsError))
+	}
+
+	return adminUseCase.GetUserById(ctx, userSuspension.Data.ID)
+}
+
+// UnsuspendUser lifts the suspension of the user before it expires, the current user can unsuspend only a less privileged user.
+func (adminUseCase AdminUseCase) UnsuspendUser(ctx context.Context, userID, currentUserID, currentUserRole string) common.Result[user.User] {
+	targetUser := adminUseCase.checkManagedUser(ctx, location+"UnsuspendUser", userID, currentUserID, currentUserRole)
+	if validator.IsError(targetUser.Error) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(targetUser.Error))
+	}
+
+	unsuspendUserError := adminUseCase.UserRepository.UnsuspendUser(ctx, userID)
+	if validator.IsError(unsuspendUserError) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(unsuspendUserError))
+	}
+
+	return adminUseCase.GetUserById(ctx, userID)
+}
+
+// ForcePasswordReset logs the user out everywhere and sends a password reset email,
+// the user can't log in until the password is reset.
+// The current user can force only a less privileged user to reset the password.
+func (adminUseCase AdminUseCase) ForcePasswordReset(ctx context.Context, userID, currentUserID, currentUserRole string) error {
+	fetchedUser := adminUseCase.checkManagedUser(ctx, location+"ForcePasswordReset", userID, currentUserID, currentUserRole)
+	if validator.IsError(fetchedUser.Error) {
+		return domain.HandleError(fetchedUser.Error)
+	}
+
+	token := randstr.String(resetTokenLength)
+	encodedToken := utility.Encode(token)
+	userForgottenPassword := user.NewUserForgottenPassword(fetchedUser.Data.Email)
+	userForgottenPassword.ResetToken = token
+	userForgottenPassword.ResetExpiry = time.Now().Add(constants.PasswordResetTokenExpirationTime)
+	userForgottenPassword.MustResetPassword = true
+
+	forgottenPasswordError := adminUseCase.UserRepository.ForgottenPassword(ctx, userForgottenPassword)
+	if validator.IsError(forgottenPasswordError) {
+		return domain.HandleError(forgottenPasswordError)
+	}
+
+	revokeAllSessionsError := adminUseCase.RefreshTokenRepository.RevokeAllSessions(ctx, userID)
+	if validator.IsError(revokeAllSessionsError) {
+		return domain.HandleError(revokeAllSessionsError)
+	}
+
+	emailData := prepareEmailDataForForgottenPassword(adminUseCase.Config, fetchedUser.Data, encodedToken)
+	sendEmailError := adminUseCase.Email.SendEmail(adminUseCase.Config, adminUseCase.Logger, location+"ForcePasswordReset", fetchedUser.Data, emailData)
+	if validator.IsError(sendEmailError) {
+		return domain.HandleError(sendEmailError)
+	}
+
+	return nil
+}
+
+// DeleteUserById permanently deletes the user and revokes all the user's sessions.
+// The current user can delete only a less privileged user, and the last administrator can't be deleted.
+func (adminUseCase AdminUseCase) DeleteUserById(ctx context.Context, userID, currentUserID, currentUserRole string) error {
+	targetUser := adminUseCase.checkTargetUser(ctx, location+"DeleteUserById", userID, currentUserID)
+	if validator.IsError(targetUser.Error) {
+		return domain.HandleError(targetUser.Error)
+	}
+
+	checkLastAdminError := adminUseCase.checkLastAdmin(ctx, targetUser.Data, "")
+	if validator.IsError(checkLastAdminError) {
+		return domain.HandleError(checkLastAdminError)
+	}
+
+	checkOutranksError := policy.CheckOutranks(adminUseCase.Logger, location+"DeleteUserById", currentUserRole, targetUser.Data.Role)
+	if validator.IsError(checkOutranksError) {
+		return checkOutranksError
+	}
+
+	deletedUserError := adminUseCase.UserRepository.DeleteUserById(ctx, userID)
+	if validator.IsError(deletedUserError) {
+		return domain.HandleError(deletedUserError)
+	}
+
+	revokeAllSessionsError := adminUseCase.RefreshTokenRepository.RevokeAllSessions(ctx, userID)
+	if validator.IsError(revokeAllSessionsError) {
+		return domain.HandleError(revokeAllSessionsError)
+	}
+
+	return nil
+}
+
+// checkTargetUser fetches the user the current user acts on and returns an authorization error if it is the current user.
+func (adminUseCase AdminUseCase) checkTargetUser(ctx context.Context, location, targetUserID, currentUserID string) common.Result[user.User] {
+	if targetUserID == currentUserID {
+		authorizationError := domain.NewAuthorizationError(location+".checkTargetUser", actingOnYourself)
+		adminUseCase.Logger.Error(authorizationError)
+		return common.NewResultOnFailure[user.User](authorizationError)
+	}
+
+	return adminUseCase.UserRepository.GetUserById(ctx, targetUserID)
+}
+
+// checkManagedUser fetches the user the current user acts on and returns an authorization error
+// if it is the current user or a user with the same or a higher role.
+func (adminUseCase AdminUseCase) checkManagedUser(ctx context.Context, location, targetUserID, currentUserID, currentUserRole string) common.Result[user.User] {
+	targetUser := adminUseCase.checkTargetUser(ctx, location, targetUserID, currentUserID)
+	if validator.IsError(targetUser.Error) {
+		return targetUser
+	}
+
+	checkOutranksError := policy.CheckOutranks(adminUseCase.Logger, location+".checkManagedUser", currentUserRole, targetUser.Data.Role)
+	if validator.IsError(checkOutranksError) {
+		return common.NewResultOnFailure[user.User](checkOutranksError)
+	}
+
+	return targetUser
+}
+
+// checkLastAdmin returns a conflict error if the target user is the last administrator and would lose the role,
+// the role is the one the user gets and empty when the user is deleted.
+func (adminUseCase AdminUseCase) checkLastAdmin(ctx context.Context, targetUser user.User, role string) error {
+	if targetUser.Role != constants.AdminRoleValue || role == constants.AdminRoleValue {
+		return nil
+	}
+
+	totalAdmins := adminUseCase.UserRepository.CountUsersByRole(ctx, constants.AdminRoleValue)
+	if validator.IsError(totalAdmins.Error) {
+		return totalAdmins.Error
+	}
+	if totalAdmins.Data <= 1 {
+		conflictError := domain.NewConflictError(location+"checkLastAdmin", roleField, lastAdminDemotion)
+		adminUseCase.Logger.Error(conflictError)
+		return conflictError
+	}
+
+	return nil
+}
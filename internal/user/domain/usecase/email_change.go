@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/thanhpk/randstr"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/common"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	emailChangeTokenLength int = 20
+)
+
+// RequestEmailChange starts the change of the email address of the authenticated user, which requires the password.
+// The new address receives a confirmation link and the current one a cancellation link,
+// the email is only changed once the new address has been confirmed.
+func (userUseCase UserUseCase) RequestEmailChange(ctx context.Context, userEmailChangeData user.UserEmailChange, userDevice user.UserDevice) error {
+	userEmailChange := validateUserEmailChange(userUseCase.Logger, userUseCase.Config.Security.PasswordPolicy, userEmailChangeData)
+	if validator.IsError(userEmailChange.Error) {
+		return domain.HandleError(userEmailChange.Error)
+	}
+
+	checkAllowedEmailDomainError := userUseCase.checkAllowedEmailDomain(location+"RequestEmailChange", userEmailChange.Data.Email)
+	if validator.IsError(checkAllowedEmailDomainError) {
+		return domain.HandleError(checkAllowedEmailDomainError)
+	}
+
+	fetchedUser := userUseCase.UserRepository.GetUserById(ctx, userEmailChange.Data.ID)
+	if validator.IsError(fetchedUser.Error) {
+		return domain.HandleError(fetchedUser.Error)
+	}
+
+	currentEmail := fetchedUser.Data.Email
+	checkLoginThrottleError := userUseCase.checkLoginThrottle(ctx, location+"RequestEmailChange", emailLoginAttemptKeyPrefix+currentEmail, ipLoginAttemptKeyPrefix+userDevice.IPAddress)
+	if validator.IsError(checkLoginThrottleError) {
+		return checkLoginThrottleError
+	}
+
+	checkCurrentPasswordError := checkCurrentPassword(userUseCase.Logger, userUseCase.PasswordHasher, location+"RequestEmailChange", fetchedUser.Data.Password, userEmailChange.Data.Password)
+	if validator.IsError(checkCurrentPasswordError) {
+		return userUseCase.handleFailedLogin(ctx, location+"RequestEmailChange.checkCurrentPassword", checkCurrentPasswordError, currentEmail, userDevice.IPAddress)
+	}
+
+	checkEmailDuplicateError := userUseCase.UserRepository.CheckEmailDuplicate(ctx, userEmailChange.Data.Email)
+	if validator.IsError(checkEmailDuplicateError) {
+		return domain.HandleError(checkEmailDuplicateError)
+	}
+
+	confirmationToken := randstr.String(emailChangeTokenLength)
+	cancelToken := randstr.String(emailChangeTokenLength)
+	userEmailChange.Data.ConfirmationToken = utility.HashToken(confirmationToken)
+	userEmailChange.Data.CancelToken = utility.HashToken(cancelToken)
+	userEmailChange.Data.Expiry = time.Now().Add(constants.EmailChangeTokenExpirationTime)
+
+	requestEmailChangeError := userUseCase.UserRepository.RequestEmailChange(ctx, userEmailChange.Data)
+	if validator.IsError(requestEmailChangeError) {
+		return domain.HandleError(requestEmailChangeError)
+	}
+
+	// The confirmation link goes to the new address, so the user has to prove it can be reached.
+	newEmailUser := fetchedUser.Data
+	newEmailUser.Email = userEmailChange.Data.Email
+	confirmationEmailData := prepareEmailDataForEmailChangeConfirmation(userUseCase.Config, newEmailUser, utility.Encode(confirmationToken))
+	sendEmailError := userUseCase.Email.SendEmail(userUseCase.Config, userUseCase.Logger, location+"RequestEmailChange", newEmailUser, confirmationEmailData)
+	if validator.IsError(sendEmailError) {
+		return domain.HandleError(sendEmailError)
+	}
+
+	cancelEmailData := prepareEmailDataForEmailChangeCancel(userUseCase.Config, fetchedUser.Data, utility.Encode(cancelToken))
+	sendEmailError = userUseCase.Email.SendEmail(userUseCase.Config, userUseCase.Logger, location+"RequestEmailChange", fetchedUser.Data, cancelEmailData)
+	if validator.IsError(sendEmailError) {
+		return domain.HandleError(sendEmailError)
+	}
+
+	return nil
+}
+
+// ConfirmEmailChange applies the pending email change with the token sent to the new address.
+// The new address is checked for duplicates again, because it might have been registered in the meantime.
+func (userUseCase UserUseCase) ConfirmEmailChange(ctx context.Context, encodedConfirmationToken string) error {
+	confirmationToken := utility.Decode(userUseCase.Logger, location+"ConfirmEmailChange", encodedConfirmationToken)
+	if validator.IsError(confirmationToken.Error) {
+		return domain.HandleError(confirmationToken.Error)
+	}
+
+	fetchedEmailChange := userUseCase.UserRepository.GetEmailChange(ctx, utility.HashToken(confirmationToken.Data))
+	if validator.IsError(fetchedEmailChange.Error) {
+		return domain.HandleError(fetchedEmailChange.Error)
+	}
+	if validator.IsTimeNotValid(fetchedEmailChange.Data.Expiry) {
+		timeExpiredError := domain.NewTimeExpiredError(location+"ConfirmEmailChange.IsTimeNotValid", constants.TimeExpiredErrorNotification)
+		userUseCase.Logger.Error(timeExpiredError)
+		return domain.HandleError(timeExpiredError)
+	}
+
+	checkEmailDuplicateError := userUseCase.UserRepository.CheckEmailDuplicate(ctx, fetchedEmailChange.Data.Email)
+	if validator.IsError(checkEmailDuplicateError) {
+		return domain.HandleError(checkEmailDuplicateError)
+	}
+
+	confirmEmailChangeError := userUseCase.UserRepository.ConfirmEmailChange(ctx, fetchedEmailChange.Data)
+	if validator.IsError(confirmEmailChangeError) {
+		return domain.HandleError(confirmEmailChangeError)
+	}
+
+	return nil
+}
+
+// CancelEmailChange discards the pending email change with the token sent to the current address.
+func (userUseCase UserUseCase) CancelEmailChange(ctx context.Context, encodedCancelToken string) error {
+	cancelToken := utility.Decode(userUseCase.Logger, location+"CancelEmailChange", encodedCancelToken)
+	if validator.IsError(cancelToken.Error) {
+		return domain.HandleError(cancelToken.Error)
+	}
+
+	cancelEmailChangeError := userUseCase.UserRepository.CancelEmailChange(ctx, utility.HashToken(cancelToken.Data))
+	if validator.IsError(cancelEmailChangeError) {
+		return domain.HandleError(cancelEmailChangeError)
+	}
+
+	return nil
+}
+
+func prepareEmailDataForEmailChangeConfirmation(config *config.ApplicationConfig, user user.User, tokenValue string) interfaces.EmailData {
+	return prepareEmailData(
+		config,
+		user,
+		tokenValue,
+		constants.EmailChangeConfirmationSubject,
+		constants.EmailChangeConfirmationUrl,
+		config.Email.EmailChangeConfirmationTemplateName,
+		config.Email.EmailChangeConfirmationTemplatePath,
+	)
+}
+
+func prepareEmailDataForEmailChangeCancel(config *config.ApplicationConfig, user user.User, tokenValue string) interfaces.EmailData {
+	return prepareEmailData(
+		config,
+		user,
+		tokenValue,
+		constants.EmailChangeCancelSubject,
+		constants.EmailChangeCancelUrl,
+		config.Email.EmailChangeCancelTemplateName,
+		config.Email.EmailChangeCancelTemplatePath,
+	)
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/thanhpk/randstr"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/common"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	invitationCodeLength int = 20
+)
+
+// CreateInvitation creates an invitation that lets users register while the registration is invite-only.
+// Only the hash of the code is stored, so the code is returned once and can't be retrieved again.
+func (adminUseCase AdminUseCase) CreateInvitation(ctx context.Context, invitationCreateData user.InvitationCreate) common.Result[user.InvitationCreated] {
+	invitationCreate := validateInvitationCreate(adminUseCase.Logger, invitationCreateData)
+	if validator.IsError(invitationCreate.Error) {
+		return common.NewResultOnFailure[user.InvitationCreated](domain.HandleError(invitationCreate.Error))
+	}
+
+	code := randstr.String(invitationCodeLength)
+	invitationCreate.Data.CodeHash = utility.HashToken(code)
+	createdInvitation := adminUseCase.InvitationRepository.CreateInvitation(ctx, invitationCreate.Data)
+	if validator.IsError(createdInvitation.Error) {
+		return common.NewResultOnFailure[user.InvitationCreated](domain.HandleError(createdInvitation.Error))
+	}
+
+	return common.NewResultOnSuccess[user.InvitationCreated](user.NewInvitationCreated(createdInvitation.Data, code))
+}
+
+func (adminUseCase AdminUseCase) GetInvitations(ctx context.Context) common.Result[user.Invitations] {
+	fetchedInvitations := adminUseCase.InvitationRepository.GetInvitations(ctx)
+	if validator.IsError(fetchedInvitations.Error) {
+		return common.NewResultOnFailure[user.Invitations](domain.HandleError(fetchedInvitations.Error))
+	}
+
+	return fetchedInvitations
+}
+
+// RevokeInvitation revokes the invitation, users who already registered with it are not affected.
+func (adminUseCase AdminUseCase) RevokeInvitation(ctx context.Context, invitationID string) error {
+	revokeInvitationError := adminUseCase.InvitationRepository.RevokeInvitation(ctx, invitationID)
+	if validator.IsError(revokeInvitationError) {
+		return domain.HandleError(revokeInvitationError)
+	}
+
+	return nil
+}
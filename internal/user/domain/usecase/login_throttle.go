@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/thanhpk/randstr"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/common"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	emailLoginAttemptKeyPrefix     = "email:"
+	ipLoginAttemptKeyPrefix        = "ip:"
+	unlockTokenLength          int = 20
+
+	loginBlocked = "Login attempts for %s are blocked after %d failed attempts."
+	loginLocked  = "Login for %s has been locked after %d failed attempts."
+)
+
+// UnlockAccount lifts the login lockout with the token sent in the unlock email and resets the failed attempts
+// of the IP address the link is opened from, so the owner can sign in from there right away.
+// The IP address that caused the lockout is not reset, it may belong to someone guessing the password.
+func (userUseCase UserUseCase) UnlockAccount(ctx context.Context, encodedUnlockToken string, userDevice user.UserDevice) error {
+	unlockToken := utility.Decode(userUseCase.Logger, location+"UnlockAccount", encodedUnlockToken)
+	if validator.IsError(unlockToken.Error) {
+		return domain.HandleError(unlockToken.Error)
+	}
+
+	unlockLoginAttemptsError := userUseCase.LoginAttemptRepository.UnlockLoginAttempts(ctx, utility.HashToken(unlockToken.Data))
+	if validator.IsError(unlockLoginAttemptsError) {
+		return domain.HandleError(unlockLoginAttemptsError)
+	}
+
+	resetLoginAttemptsError := userUseCase.LoginAttemptRepository.ResetLoginAttempts(ctx, ipLoginAttemptKeyPrefix+userDevice.IPAddress)
+	if validator.IsError(resetLoginAttemptsError) {
+		return domain.HandleError(resetLoginAttemptsError)
+	}
+
+	return nil
+}
+
+// checkLoginThrottle rejects the login while any of the provided keys is blocked by previous failed attempts.
+func (userUseCase UserUseCase) checkLoginThrottle(ctx context.Context, location string, loginAttemptKeys ...string) error {
+	for _, loginAttemptKey := range loginAttemptKeys {
+		fetchedLoginAttempt := userUseCase.LoginAttemptRepository.GetLoginAttempt(ctx, loginAttemptKey)
+		if validator.IsError(fetchedLoginAttempt.Error) {
+			return domain.HandleError(fetchedLoginAttempt.Error)
+		}
+		if validator.IsTimeNotValid(fetchedLoginAttempt.Data.BlockedUntil) {
+			continue
+		}
+
+		message := loginBlocked
+		notification := constants.TooManyLoginAttemptsNotification
+		if fetchedLoginAttempt.Data.Locked {
+			message = loginLocked
+			notification = constants.LoginLockedNotification
+		}
+
+		tooManyRequestsError := domain.NewTooManyRequestsError(
+			location+".checkLoginThrottle",
+			retryAfter(fetchedLoginAttempt.Data.BlockedUntil),
+			fmt.Sprintf(message, loginAttemptKey, fetchedLoginAttempt.Data.FailedAttempts),
+		)
+		userUseCase.Logger.Info(tooManyRequestsError)
+		tooManyRequestsError.Notification = notification
+		return tooManyRequestsError
+	}
+
+	return nil
+}
+
+// handleFailedLogin counts the failed attempt when the credentials were wrong and returns the error to report.
+// The email and the IP address are throttled separately, and locking the email sends an unlock email to its owner.
+func (userUseCase UserUseCase) handleFailedLogin(ctx context.Context, location string, loginError error, email, ipAddress string) error {
+	_, isValidationError := loginError.(domain.ValidationError)
+	if !isValidationError {
+		return domain.HandleError(loginError)
+	}
+
+	loginThrottle := userUseCase.Config.Security.LoginThrottle
+	ipLoginAttemptBlock := userUseCase.blockLoginAttempts(ctx, location, ipLoginAttemptKeyPrefix+ipAddress, loginThrottle.MaxAttemptsPerIP, "")
+	if validator.IsError(ipLoginAttemptBlock.Error) {
+		return domain.HandleError(ipLoginAttemptBlock.Error)
+	}
+
+	unlockToken := randstr.String(unlockTokenLength)
+	emailLoginAttemptBlock := userUseCase.blockLoginAttempts(ctx, location, emailLoginAttemptKeyPrefix+email, loginThrottle.MaxAttemptsPerEmail, unlockToken)
+	if validator.IsError(emailLoginAttemptBlock.Error) {
+		return domain.HandleError(emailLoginAttemptBlock.Error)
+	}
+	if validator.IsValueNotEmpty(emailLoginAttemptBlock.Data.UnlockToken) {
+		sendUnlockEmailError := userUseCase.sendUnlockEmail(ctx, location, email, unlockToken)
+		if validator.IsError(sendUnlockEmailError) {
+			return domain.HandleError(sendUnlockEmailError)
+		}
+	}
+
+	return domain.HandleError(loginError)
+}
+
+// blockLoginAttempts counts a failed attempt for the key and blocks the key with an exponential backoff.
+// Reaching the maximum number of attempts locks the key for the lockout duration, the unlock token
+// is only stored when the key gets locked, so a single unlock email is sent per lockout.
+func (userUseCase UserUseCase) blockLoginAttempts(ctx context.Context, location, loginAttemptKey string, maxAttempts int, unlockToken string) common.Result[user.LoginAttemptBlock] {
+	loginThrottle := userUseCase.Config.Security.LoginThrottle
+	now := time.Now()
+	updatedLoginAttempt := userUseCase.LoginAttemptRepository.IncrementFailedLoginAttempts(ctx, loginAttemptKey, now.Add(loginThrottle.AttemptWindow))
+	if validator.IsError(updatedLoginAttempt.Error) {
+		return common.NewResultOnFailure[user.LoginAttemptBlock](updatedLoginAttempt.Error)
+	}
+
+	failedAttempts := updatedLoginAttempt.Data.FailedAttempts
+	loginAttemptBlock := user.NewLoginAttemptBlock(loginAttemptKey, now.Add(loginBackoff(loginThrottle, failedAttempts)), false, now.Add(loginThrottle.AttemptWindow))
+	if maxAttempts > 0 && failedAttempts >= maxAttempts {
+		lockedUntil := now.Add(loginThrottle.LockoutDuration)
+		loginAttemptBlock = user.NewLoginAttemptBlock(loginAttemptKey, lockedUntil, true, lockedUntil)
+		if failedAttempts == maxAttempts {
+			if validator.IsValueNotEmpty(unlockToken) {
+				loginAttemptBlock.UnlockToken = utility.HashToken(unlockToken)
+			}
+
+			tooManyRequestsError := domain.NewTooManyRequestsError(location+".blockLoginAttempts", retryAfter(lockedUntil), fmt.Sprintf(loginLocked, loginAttemptKey, failedAttempts))
+			userUseCase.Logger.Warn(tooManyRequestsError)
+		}
+	}
+
+	blockLoginAttemptsError := userUseCase.LoginAttemptRepository.BlockLoginAttempts(ctx, loginAttemptBlock)
+	if validator.IsError(blockLoginAttemptsError) {
+		return common.NewResultOnFailure[user.LoginAttemptBlock](blockLoginAttemptsError)
+	}
+
+	return common.NewResultOnSuccess[user.LoginAttemptBlock](loginAttemptBlock)
+}
+
+// sendUnlockEmail sends the unlock link to the owner of the locked email, if such a user exists.
+func (userUseCase UserUseCase) sendUnlockEmail(ctx context.Context, location, email, unlockToken string) error {
+	fetchedUser := userUseCase.UserRepository.GetUserByEmail(ctx, email)
+	if validator.IsError(fetchedUser.Error) {
+		return nil
+	}
+
+	emailData := prepareEmailDataForAccountUnlock(userUseCase.Config, fetchedUser.Data, utility.Encode(unlockToken))
+	return userUseCase.Email.SendEmail(userUseCase.Config, userUseCase.Logger, location+".sendUnlockEmail", fetchedUser.Data, emailData)
+}
+
+// resetLoginThrottle removes the failed attempts of the email after a successful login.
+// The IP address is not reset, so a single valid account cannot be used to keep guessing others.
+func (userUseCase UserUseCase) resetLoginThrottle(ctx context.Context, email string) error {
+	resetLoginAttemptsError := userUseCase.LoginAttemptRepository.ResetLoginAttempts(ctx, emailLoginAttemptKeyPrefix+email)
+	if validator.IsError(resetLoginAttemptsError) {
+		return domain.HandleError(resetLoginAttemptsError)
+	}
+
+	return nil
+}
+
+func prepareEmailDataForAccountUnlock(config *config.ApplicationConfig, user user.User, tokenValue string) interfaces.EmailData {
+	return prepareEmailData(
+		config,
+		user,
+		tokenValue,
+		constants.AccountUnlockSubject,
+		constants.AccountUnlockUrl,
+		config.Email.AccountUnlockTemplateName,
+		config.Email.AccountUnlockTemplatePath,
+	)
+}
+
+// loginBackoff doubles the delay with every failed attempt, up to the configured maximum.
+func loginBackoff(loginThrottle config.LoginThrottle, failedAttempts int) time.Duration {
+	backoff := loginThrottle.BackoffBase
+	for attempt := 1; attempt < failedAttempts && backoff < loginThrottle.BackoffMax; attempt++ {
+		backoff *= 2
+	}
+
+	return min(backoff, loginThrottle.BackoffMax)
+}
+
+// retryAfter returns the number of seconds left until the provided time.
+func retryAfter(blockedUntil time.Time) string {
+	return strconv.Itoa(int(math.Ceil(time.Until(blockedUntil).Seconds())))
+}
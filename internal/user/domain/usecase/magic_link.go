@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/thanhpk/randstr"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/common"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	magicLinkTokenLength int = 20
+)
+
+// RequestMagicLink sends a passwordless login link to the email of the user.
+// Only the hash of the token is stored, and requesting a new link replaces the previous one.
+// An unknown email is answered like a registered one, so the request doesn't reveal which emails are registered.
+func (userUseCase UserUseCase) RequestMagicLink(ctx context.Context, userMagicLinkData user.UserMagicLink) error {
+	userMagicLink := validateUserMagicLink(userUseCase.Logger, userMagicLinkData)
+	if validator.IsError(userMagicLink.Error) {
+		return domain.HandleError(userMagicLink.Error)
+	}
+
+	fetchedUser := userUseCase.UserRepository.GetUserByEmail(ctx, userMagicLink.Data.Email)
+	if validator.IsError(fetchedUser.Error) {
+		_, isInternalError := fetchedUser.Error.(domain.InternalError)
+		if isInternalError {
+			return domain.HandleError(fetchedUser.Error)
+		}
+
+		return nil
+	}
+
+	token := randstr.String(magicLinkTokenLength)
+	userMagicLink.Data.ID = fetchedUser.Data.ID
+	userMagicLink.Data.MagicLinkToken = utility.HashToken(token)
+	userMagicLink.Data.MagicLinkExpiry = time.Now().Add(constants.MagicLinkTokenExpirationTime)
+
+	updateMagicLinkTokenError := userUseCase.UserRepository.UpdateMagicLinkToken(ctx, userMagicLink.Data)
+	if validator.IsError(updateMagicLinkTokenError) {
+		return domain.HandleError(updateMagicLinkTokenError)
+	}
+
+	emailData := prepareEmailDataForMagicLink(userUseCase.Config, fetchedUser.Data, utility.Encode(token))
+	sendEmailError := userUseCase.Email.SendEmail(userUseCase.Config, userUseCase.Logger, location+"RequestMagicLink", fetchedUser.Data, emailData)
+	if validator.IsError(sendEmailError) {
+		return domain.HandleError(sendEmailError)
+	}
+
+	return nil
+}
+
+// LoginWithMagicLink signs the user in with the token of a magic link. The token is consumed before anything else
+// is checked, so a link cannot be used twice, and the login is subject to the same checks as a password login.
+func (userUseCase UserUseCase) LoginWithMagicLink(ctx context.Context, encodedMagicLinkToken string, userDevice user.UserDevice) common.Result[user.UserToken] {
+	magicLinkToken := utility.Decode(userUseCase.Logger, location+"LoginWithMagicLink", encodedMagicLinkToken)
+	if validator.IsError(magicLinkToken.Error) {
+		return common.NewResultOnFailure[user.UserToken](domain.HandleError(magicLinkToken.Error))
+	}
+
+	fetchedUser := userUseCase.UserRepository.ConsumeMagicLinkToken(ctx, utility.HashToken(magicLinkToken.Data))
+	if validator.IsError(fetchedUser.Error) {
+		return common.NewResultOnFailure[user.UserToken](domain.HandleError(fetchedUser.Error))
+	}
+
+	checkUserSuspensionError := userUseCase.checkUserSuspension(ctx, location+"LoginWithMagicLink", fetchedUser.Data)
+	if validator.IsError(checkUserSuspensionError) {
+		return common.NewResultOnFailure[user.UserToken](checkUserSuspensionError)
+	}
+	if !fetchedUser.Data.Verified {
+		emailNotVerifiedError := domain.NewEmailNotVerifiedError(location+"LoginWithMagicLink.Verified", constants.EmailNotVerifiedNotification)
+		userUseCase.Logger.Error(emailNotVerifiedError)
+		return common.NewResultOnFailure[user.UserToken](domain.HandleError(emailNotVerifiedError))
+	}
+	checkMustResetPasswordError := userUseCase.checkMustResetPassword(location+"LoginWithMagicLink", fetchedUser.Data)
+	if validator.IsError(checkMustResetPasswordError) {
+		return common.NewResultOnFailure[user.UserToken](checkMustResetPasswordError)
+	}
+
+	// The link replaces the password, not the second factor.
+	if fetchedUser.Data.TwoFactorEnabled {
+		return userUseCase.issueMFAToken(ctx, location+"LoginWithMagicLink", fetchedUser.Data)
+	}
+
+	return userUseCase.startSession(ctx, location+"LoginWithMagicLink", fetchedUser.Data, userDevice)
+}
+
+func prepareEmailDataForMagicLink(config *config.ApplicationConfig, user user.User, tokenValue string) interfaces.EmailData {
+	return prepareEmailData(
+		config,
+		user,
+		tokenValue,
+		constants.MagicLinkSubject,
+		constants.MagicLinkUrl,
+		config.Email.MagicLinkTemplateName,
+		config.Email.MagicLinkTemplatePath,
+	)
+}
@@ -0,0 +1,239 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/thanhpk/randstr"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	domainUtility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/common"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	oauthStateLength          int = 32
+	oauthCodeVerifierLength   int = 64
+	oauthPasswordLength       int = 32
+	oauthRandomUsernameLength int = 8
+	oauthRandomUsernamePrefix     = "user-"
+
+	oauthProviderNotFound      = "The OAuth provider %s is not configured."
+	oauthStateProviderMismatch = "The OAuth state was issued for the provider %s instead of %s."
+	oauthStateExpired          = "The OAuth state has expired."
+)
+
+var (
+	oauthUsernameRegex = regexp.MustCompile(`[^a-zA-Z0-9-_ ]`)
+)
+
+// StartOAuthLogin starts a social login with the provider and returns the URL the user is redirected to.
+// The state and the PKCE code verifier are kept until the provider redirects back, only the hash of the state is stored.
+func (userUseCase UserUseCase) StartOAuthLogin(ctx context.Context, providerName string) common.Result[string] {
+	oauthProvider := userUseCase.getOAuthProvider(location+"StartOAuthLogin", providerName)
+	if validator.IsError(oauthProvider.Error) {
+		return common.NewResultOnFailure[string](oauthProvider.Error)
+	}
+
+	state := randstr.String(oauthStateLength)
+	codeVerifier := randstr.String(oauthCodeVerifierLength)
+	oauthState := user.NewOAuthState(utility.HashToken(state), providerName, codeVerifier, time.Now().Add(userUseCase.Config.OAuth.StateExpiredIn))
+	createOAuthStateError := userUseCase.OAuthRepository.CreateOAuthState(ctx, oauthState)
+	if validator.IsError(createOAuthStateError) {
+		return common.NewResultOnFailure[string](domain.HandleError(createOAuthStateError))
+	}
+
+	authorizationURL := oauthProvider.Data.AuthorizationURL(ctx, state, domainUtility.CodeChallenge(codeVerifier))
+	if validator.IsError(authorizationURL.Error) {
+		return common.NewResultOnFailure[string](domain.HandleError(authorizationURL.Error))
+	}
+
+	return authorizationURL
+}
+
+// CompleteOAuthLogin finishes a social login once the provider redirects back and issues our own token pair.
+// The state is consumed, so the callback can't be replayed. The identity is linked to the user it was linked to before,
+// otherwise to the verified user with the same verified email, or to a new user created for it.
+func (userUseCase UserUseCase) CompleteOAuthLogin(ctx context.Context, oauthLoginData user.OAuthLogin, userDevice user.UserDevice) common.Result[user.UserToken] {
+	oauthLogin := validateOAuthLogin(userUseCase.Logger, oauthLoginData)
+	if validator.IsError(oauthLogin.Error) {
+		return common.NewResultOnFailure[user.UserToken](domain.HandleError(oauthLogin.Error))
+	}
+
+	oauthProvider := userUseCase.getOAuthProvider(location+"CompleteOAuthLogin", oauthLogin.Data.Provider)
+	if validator.IsError(oauthProvider.Error) {
+		return common.NewResultOnFailure[user.UserToken](oauthProvider.Error)
+	}
+
+	oauthState := userUseCase.OAuthRepository.ConsumeOAuthState(ctx, utility.HashToken(oauthLogin.Data.State))
+	if validator.IsError(oauthState.Error) {
+		return common.NewResultOnFailure[user.UserToken](domain.HandleError(oauthState.Error))
+	}
+	if oauthState.Data.Provider != oauthLogin.Data.Provider {
+		return common.NewResultOnFailure[user.UserToken](userUseCase.oauthStateError(location+"CompleteOAuthLogin.Provider", fmt.Sprintf(oauthStateProviderMismatch, oauthState.Data.Provider, oauthLogin.Data.Provider)))
+	}
+	if validator.IsTimeNotValid(oauthState.Data.ExpiresAt) {
+		return common.NewResultOnFailure[user.UserToken](userUseCase.oauthStateError(location+"CompleteOAuthLogin.ExpiresAt", oauthStateExpired))
+	}
+
+	oauthIdentity := oauthProvider.Data.Exchange(ctx, oauthLogin.Data.Code, oauthState.Data.CodeVerifier)
+	if validator.IsError(oauthIdentity.Error) {
+		return common.NewResultOnFailure[user.UserToken](domain.HandleError(oauthIdentity.Error))
+	}
+
+	fetchedUser := userUseCase.getOAuthUser(ctx, oauthIdentity.Data)
+	if validator.IsError(fetchedUser.Error) {
+		return common.NewResultOnFailure[user.UserToken](fetchedUser.Error)
+	}
+
+	checkUserSuspensionError := userUseCase.checkUserSuspension(ctx, location+"CompleteOAuthLogin", fetchedUser.Data)
+	if validator.IsError(checkUserSuspensionError) {
+		return common.NewResultOnFailure[user.UserToken](checkUserSuspensionError)
+	}
+
+	// The provider replaces the password, not the second factor.
+	if fetchedUser.Data.TwoFactorEnabled {
+		return userUseCase.issueMFAToken(ctx, location+"CompleteOAuthLogin", fetchedUser.Data)
+	}
+
+	return userUseCase.startSession(ctx, location+"CompleteOAuthLogin", fetchedUser.Data, userDevice)
+}
+
+// getOAuthProvider returns the configured provider with the provided name.
+func (userUseCase UserUseCase) getOAuthProvider(location, providerName string) common.Result[interfaces.OAuthProvider] {
+	oauthProvider, ok := userUseCase.OAuthProviders[providerName]
+	if !ok {
+		itemNotFoundError := domain.NewItemNotFoundError(location+".getOAuthProvider", providerName, fmt.Sprintf(oauthProviderNotFound, providerName))
+		userUseCase.Logger.Error(itemNotFoundError)
+		return common.NewResultOnFailure[interfaces.OAuthProvider](domain.HandleError(itemNotFoundError))
+	}
+
+	return common.NewResultOnSuccess[interfaces.OAuthProvider](oauthProvider)
+}
+
+// getOAuthUser returns the user linked to the identity, linking the identity first if it is new.
+// Only a verified user is linked by email, otherwise whoever registered the email without confirming it
+// would share the account with its owner.
+func (userUseCase UserUseCase) getOAuthUser(ctx context.Context, oauthIdentityData user.OAuthIdentity) common.Result[user.User] {
+	userIdentity := userUseCase.OAuthRepository.GetUserIdentity(ctx, oauthIdentityData.Provider, oauthIdentityData.Subject)
+	if validator.IsError(userIdentity.Error) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(userIdentity.Error))
+	}
+	if validator.IsValueNotEmpty(userIdentity.Data.UserID) {
+		return userUseCase.GetUserById(ctx, userIdentity.Data.UserID)
+	}
+
+	oauthIdentity := validateOAuthIdentity(userUseCase.Logger, oauthIdentityData)
+	if validator.IsError(oauthIdentity.Error) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(oauthIdentity.Error))
+	}
+
+	fetchedUser := userUseCase.getOrCreateOAuthUser(ctx, oauthIdentity.Data)
+	if validator.IsError(fetchedUser.Error) {
+		return fetchedUser
+	}
+	if !fetchedUser.Data.Verified {
+		emailNotVerifiedError := domain.NewEmailNotVerifiedError(location+"getOAuthUser.Verified", constants.EmailNotVerifiedNotification)
+		userUseCase.Logger.Error(emailNotVerifiedError)
+		return common.NewResultOnFailure[user.User](domain.HandleError(emailNotVerifiedError))
+	}
+
+	userIdentityCreate := user.NewUserIdentityCreate(fetchedUser.Data.ID, oauthIdentity.Data.Provider, oauthIdentity.Data.Subject, oauthIdentity.Data.Email)
+	createdUserIdentity := userUseCase.OAuthRepository.CreateUserIdentity(ctx, userIdentityCreate)
+	if validator.IsError(createdUserIdentity.Error) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(createdUserIdentity.Error))
+	}
+
+	return fetchedUser
+}
+
+// getOrCreateOAuthUser returns the user with the email of the identity or registers a new verified one,
+// if the registration mode allows it. Social logins carry no invitation code, so they can't register while
+// the registration is invite-only. The new user gets a random password, a password of its own can be set
+// with the forgotten password flow.
+func (userUseCase UserUseCase) getOrCreateOAuthUser(ctx context.Context, oauthIdentity user.OAuthIdentity) common.Result[user.User] {
+	fetchedUser := userUseCase.UserRepository.GetUserByEmail(ctx, oauthIdentity.Email)
+	if !validator.IsError(fetchedUser.Error) {
+		return fetchedUser
+	}
+	_, isInternalError := fetchedUser.Error.(domain.InternalError)
+	if isInternalError {
+		return common.NewResultOnFailure[user.User](domain.HandleError(fetchedUser.Error))
+	}
+
+	checkRegistrationError := userUseCase.checkRegistration(location+"getOrCreateOAuthUser", oauthIdentity.Email, "")
+	if validator.IsError(checkRegistrationError) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(checkRegistrationError))
+	}
+
+	username := userUseCase.getFreeOAuthUsername(ctx, oauthIdentity)
+	if validator.IsError(username.Error) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(username.Error))
+	}
+
+	password := randstr.String(oauthPasswordLength)
+	userCreate := user.UserCreate{
+		Username:        username.Data,
+		Handle:          domainUtility.NormalizeHandle(username.Data),
+		Email:           oauthIdentity.Email,
+		Password:        password,
+		PasswordConfirm: password,
+		Role:            constants.UserRoleValue,
+		Verified:        true,
+	}
+
+	createdUser := userUseCase.UserRepository.Register(ctx, userCreate)
+	if validator.IsError(createdUser.Error) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(createdUser.Error))
+	}
+
+	return createdUser
+}
+
+// oauthStateError rejects a callback whose state doesn't belong to the login.
+func (userUseCase UserUseCase) oauthStateError(location, message string) error {
+	invalidTokenError := domain.NewInvalidTokenError(location, message)
+	userUseCase.Logger.Error(invalidTokenError)
+	invalidTokenError.Notification = constants.InvalidTokenErrorMessage
+	return invalidTokenError
+}
+
+// getFreeOAuthUsername returns the username derived from the account at the provider,
+// or a random one if its handle is already taken.
+func (userUseCase UserUseCase) getFreeOAuthUsername(ctx context.Context, oauthIdentity user.OAuthIdentity) common.Result[string] {
+	username := oauthUsername(oauthIdentity)
+	checkUsernameDuplicateError := userUseCase.UserRepository.CheckUsernameDuplicate(ctx, "", domainUtility.NormalizeHandle(username))
+	if validator.IsError(checkUsernameDuplicateError) {
+		_, isValidationError := checkUsernameDuplicateError.(domain.ValidationError)
+		if !isValidationError {
+			return common.NewResultOnFailure[string](checkUsernameDuplicateError)
+		}
+		username = oauthRandomUsernamePrefix + randstr.String(oauthRandomUsernameLength)
+	}
+
+	return common.NewResultOnSuccess[string](username)
+}
+
+// oauthUsername derives a valid username from the account at the provider, falling back to the local part
+// of the email and then to a random one.
+func oauthUsername(oauthIdentity user.OAuthIdentity) string {
+	candidates := []string{oauthIdentity.Username, strings.Split(oauthIdentity.Email, "@")[0]}
+	for _, candidate := range candidates {
+		username := utility.SanitizeAndCollapseWhitespace(oauthUsernameRegex.ReplaceAllString(candidate, ""))
+		if len(username) > constants.DefaultMaxStringLength {
+			username = strings.TrimSpace(username[:constants.DefaultMaxStringLength])
+		}
+		if len(username) >= constants.DefaultMinStringLength {
+			return username
+		}
+	}
+
+	return oauthRandomUsernamePrefix + randstr.String(oauthRandomUsernameLength)
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	domainUtility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+// checkNewPassword applies the rules of the password policy that need more than the password itself.
+// The password must not contain the email or the username, if the policy disallows it, and must not be a breached one.
+// Only the prefix of its SHA-1 hash is passed to the breached passwords, the suffix is compared here.
+func (userUseCase UserUseCase) checkNewPassword(ctx context.Context, location, password, email, username string) error {
+	if userUseCase.Config.Security.PasswordPolicy.DisallowPersonalData && domainUtility.ContainsPersonalData(password, email, username) {
+		validationError := domain.NewValidationError(location+".checkNewPassword.ContainsPersonalData", passwordField, constants.FieldRequired, passwordPersonalData)
+		userUseCase.Logger.Debug(validationError)
+		return validationError
+	}
+
+	hashPrefix, hashSuffix := domainUtility.SplitPasswordHash(password)
+	hashSuffixes := userUseCase.BreachedPasswords.GetHashSuffixes(ctx, hashPrefix)
+	if validator.IsError(hashSuffixes.Error) {
+		return hashSuffixes.Error
+	}
+	if slices.Contains(hashSuffixes.Data, hashSuffix) {
+		validationError := domain.NewValidationError(location+".checkNewPassword.GetHashSuffixes", passwordField, constants.FieldRequired, passwordBreached)
+		userUseCase.Logger.Debug(validationError)
+		return validationError
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/thanhpk/randstr"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/common"
+	policy "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/policy"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	personalAccessTokenLength int = 40
+
+	// The last use is recorded at most once per interval, so a busy client doesn't write with every request.
+	personalAccessTokenLastUsedInterval = time.Minute
+
+	personalAccessTokenExpired = "The personal access token has expired."
+)
+
+// CreatePersonalAccessToken creates a personal access token limited to scopes granted to the role of the user.
+// Only the hash of the token is stored, so the token is returned once and can't be retrieved again.
+func (userUseCase UserUseCase) CreatePersonalAccessToken(ctx context.Context, personalAccessTokenCreateData user.PersonalAccessTokenCreate) common.Result[user.PersonalAccessTokenCreated] {
+	fetchedUser := userUseCase.UserRepository.GetUserById(ctx, personalAccessTokenCreateData.UserID)
+	if validator.IsError(fetchedUser.Error) {
+		return common.NewResultOnFailure[user.PersonalAccessTokenCreated](domain.HandleError(fetchedUser.Error))
+	}
+
+	personalAccessTokenCreate := validatePersonalAccessTokenCreate(userUseCase.Logger, personalAccessTokenCreateData, fetchedUser.Data.Role)
+	if validator.IsError(personalAccessTokenCreate.Error) {
+		return common.NewResultOnFailure[user.PersonalAccessTokenCreated](domain.HandleError(personalAccessTokenCreate.Error))
+	}
+
+	token := constants.PersonalAccessTokenPrefix + randstr.String(personalAccessTokenLength)
+	personalAccessTokenCreate.Data.TokenHash = utility.HashToken(token)
+	createdPersonalAccessToken := userUseCase.PersonalAccessTokenRepository.CreatePersonalAccessToken(ctx, personalAccessTokenCreate.Data)
+	if validator.IsError(createdPersonalAccessToken.Error) {
+		return common.NewResultOnFailure[user.PersonalAccessTokenCreated](domain.HandleError(createdPersonalAccessToken.Error))
+	}
+
+	return common.NewResultOnSuccess[user.PersonalAccessTokenCreated](user.NewPersonalAccessTokenCreated(createdPersonalAccessToken.Data, token))
+}
+
+func (userUseCase UserUseCase) GetPersonalAccessTokens(ctx context.Context, userID string) common.Result[user.PersonalAccessTokens] {
+	fetchedPersonalAccessTokens := userUseCase.PersonalAccessTokenRepository.GetPersonalAccessTokens(ctx, userID)
+	if validator.IsError(fetchedPersonalAccessTokens.Error) {
+		return common.NewResultOnFailure[user.PersonalAccessTokens](domain.HandleError(fetchedPersonalAccessTokens.Error))
+	}
+
+	return fetchedPersonalAccessTokens
+}
+
+// RevokePersonalAccessToken revokes the provided personal access token of the user, clients using it are rejected right away.
+func (userUseCase UserUseCase) RevokePersonalAccessToken(ctx context.Context, userID, personalAccessTokenID string) error {
+	revokePersonalAccessTokenError := userUseCase.PersonalAccessTokenRepository.RevokePersonalAccessToken(ctx, userID, personalAccessTokenID)
+	if validator.IsError(revokePersonalAccessTokenError) {
+		return domain.HandleError(revokePersonalAccessTokenError)
+	}
+
+	return nil
+}
+
+// ValidatePersonalAccessToken authenticates a personal access token that is neither revoked nor expired
+// and whose user is not suspended. The scopes are narrowed down to the permissions the role of the user
+// still grants, so a token created before a demotion can't do more than its user.
+func (userUseCase UserUseCase) ValidatePersonalAccessToken(ctx context.Context, token string) common.Result[user.UserTokenPayload] {
+	fetchedPersonalAccessToken := userUseCase.PersonalAccessTokenRepository.GetPersonalAccessTokenByHash(ctx, utility.HashToken(token))
+	if validator.IsError(fetchedPersonalAccessToken.Error) {
+		return common.NewResultOnFailure[user.UserTokenPayload](domain.HandleError(fetchedPersonalAccessToken.Error))
+	}
+
+	personalAccessToken := fetchedPersonalAccessToken.Data
+	if !personalAccessToken.ExpiresAt.IsZero() && validator.IsTimeNotValid(personalAccessToken.ExpiresAt) {
+		invalidTokenError := domain.NewInvalidTokenError(location+"ValidatePersonalAccessToken.IsTimeNotValid", personalAccessTokenExpired)
+		userUseCase.Logger.Error(invalidTokenError)
+		invalidTokenError.Notification = constants.InvalidTokenErrorMessage
+		return common.NewResultOnFailure[user.UserTokenPayload](invalidTokenError)
+	}
+
+	fetchedUser := userUseCase.UserRepository.GetUserById(ctx, personalAccessToken.UserID)
+	if validator.IsError(fetchedUser.Error) {
+		return common.NewResultOnFailure[user.UserTokenPayload](domain.HandleError(fetchedUser.Error))
+	}
+
+	checkUserSuspensionError := userUseCase.checkUserSuspension(ctx, location+"ValidatePersonalAccessToken", fetchedUser.Data)
+	if validator.IsError(checkUserSuspensionError) {
+		return common.NewResultOnFailure[user.UserTokenPayload](checkUserSuspensionError)
+	}
+
+	// A failure to record the last use is logged by the repository, it doesn't reject the request.
+	now := time.Now()
+	if now.Sub(personalAccessToken.LastUsedAt) > personalAccessTokenLastUsedInterval {
+		userUseCase.PersonalAccessTokenRepository.UpdatePersonalAccessTokenLastUsedAt(ctx, personalAccessToken.ID, now)
+	}
+
+	userTokenPayload := user.NewUserTokenPayload(fetchedUser.Data.ID, fetchedUser.Data.Role)
+	userTokenPayload.TokenID = personalAccessToken.ID
+	userTokenPayload.Scopes = policy.GrantedPermissions(fetchedUser.Data.Role, personalAccessToken.Scopes)
+	return common.NewResultOnSuccess[user.UserTokenPayload](userTokenPayload)
+}
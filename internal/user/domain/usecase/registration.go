@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	domainUtility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/common"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	registrationInviteOnly = "Sorry, registration is by invitation only."
+	invitationCodeNotValid = "Sorry, the invitation code is not valid, it may have been revoked, expired or used up."
+	emailDomainNotAllowed  = "Sorry, only email addresses of the allowed domains can be used."
+)
+
+// checkRegistration checks that the registration mode lets the user register.
+// In the invite-only mode only the presence of the invitation code is checked, the code is used by useInvitation.
+// An unknown mode rejects every registration.
+func (userUseCase UserUseCase) checkRegistration(location, email, invitationCode string) error {
+	registrationMode := userUseCase.Config.Security.Registration.Mode
+	switch registrationMode {
+	case constants.OpenRegistration:
+		return nil
+	case constants.InviteOnlyRegistration:
+		if invitationCode == "" {
+			validationError := domain.NewValidationError(location+".checkRegistration.InvitationCode", invitationCodeField, constants.FieldRequired, registrationInviteOnly)
+			userUseCase.Logger.Debug(validationError)
+			return validationError
+		}
+		return nil
+	case constants.DomainRestrictedRegistration:
+		return userUseCase.checkAllowedEmailDomain(location+".checkRegistration", email)
+	default:
+		internalError := domain.NewInternalError(location+".checkRegistration", fmt.Sprintf(constants.UnsupportedRegistration, registrationMode))
+		userUseCase.Logger.Error(internalError)
+		return internalError
+	}
+}
+
+// checkAllowedEmailDomain rejects email addresses outside the allowed domains while the registration is domain-restricted,
+// so users can't leave the allowed domains by changing their email either.
+func (userUseCase UserUseCase) checkAllowedEmailDomain(location, email string) error {
+	registration := userUseCase.Config.Security.Registration
+	if registration.Mode != constants.DomainRestrictedRegistration {
+		return nil
+	}
+	if !domainUtility.IsEmailDomainAllowed(registration.AllowedEmailDomains, email) {
+		validationError := domain.NewValidationError(location+".checkAllowedEmailDomain.IsEmailDomainAllowed", EmailField, constants.FieldRequired, emailDomainNotAllowed)
+		userUseCase.Logger.Debug(validationError)
+		return validationError
+	}
+
+	return nil
+}
+
+// useInvitation counts a use of the invitation code while the registration is invite-only.
+func (userUseCase UserUseCase) useInvitation(ctx context.Context, location, invitationCode string) error {
+	if userUseCase.Config.Security.Registration.Mode != constants.InviteOnlyRegistration {
+		return nil
+	}
+
+	useInvitationError := userUseCase.InvitationRepository.UseInvitation(ctx, utility.HashToken(invitationCode))
+	if validator.IsError(useInvitationError) {
+		_, isItemNotFoundError := useInvitationError.(domain.ItemNotFoundError)
+		if isItemNotFoundError {
+			validationError := domain.NewValidationError(location+".useInvitation.UseInvitation", invitationCodeField, constants.FieldRequired, invitationCodeNotValid)
+			userUseCase.Logger.Debug(validationError)
+			return validationError
+		}
+		return useInvitationError
+	}
+
+	return nil
+}
+
+// releaseInvitation gives back the use of the invitation code when the registration failed after it was counted.
+// A failure is logged by the repository, the invitation just loses a use then.
+func (userUseCase UserUseCase) releaseInvitation(ctx context.Context, invitationCode string) {
+	if userUseCase.Config.Security.Registration.Mode != constants.InviteOnlyRegistration {
+		return
+	}
+
+	userUseCase.InvitationRepository.ReleaseInvitation(ctx, utility.HashToken(invitationCode))
+}
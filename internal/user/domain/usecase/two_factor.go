@@ -0,0 +1,255 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thanhpk/randstr"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	domainUtility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/common"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	mfaTokenLength         int = 32
+	recoveryCodeLength     int = 10
+	recoveryCodesCount     int = 10
+	twoFactorCodeField         = "code"
+	invalidTwoFactorCode       = "Invalid authentication code."
+	twoFactorAlreadyActive     = "Two-factor authentication is already enabled."
+	twoFactorNotEnrolled       = "Two-factor authentication has not been enrolled yet."
+	twoFactorNotActive         = "Two-factor authentication is not enabled."
+)
+
+// EnrollTwoFactor generates a TOTP secret and recovery codes for the user.
+// The enrollment has to be confirmed with a code from the authenticator app before it is enabled,
+// and the recovery codes are only returned here, because they are stored hashed.
+func (userUseCase UserUseCase) EnrollTwoFactor(ctx context.Context, userID string) common.Result[user.UserTwoFactorEnrollment] {
+	fetchedUser := userUseCase.UserRepository.GetUserById(ctx, userID)
+	if validator.IsError(fetchedUser.Error) {
+		return common.NewResultOnFailure[user.UserTwoFactorEnrollment](domain.HandleError(fetchedUser.Error))
+	}
+	if fetchedUser.Data.TwoFactorEnabled {
+		return common.NewResultOnFailure[user.UserTwoFactorEnrollment](userUseCase.twoFactorValidationError(location+"EnrollTwoFactor.TwoFactorEnabled", twoFactorAlreadyActive))
+	}
+
+	secret := domainUtility.GenerateTOTPSecret(userUseCase.Logger, location+"EnrollTwoFactor")
+	if validator.IsError(secret.Error) {
+		return common.NewResultOnFailure[user.UserTwoFactorEnrollment](domain.HandleError(secret.Error))
+	}
+
+	recoveryCodes := make([]string, 0, recoveryCodesCount)
+	for range recoveryCodesCount {
+		recoveryCodes = append(recoveryCodes, randstr.String(recoveryCodeLength))
+	}
+
+	userTwoFactorEnrollment := user.NewUserTwoFactorEnrollment(
+		fetchedUser.Data.ID,
+		secret.Data,
+		domainUtility.GenerateTOTPURI(constants.TwoFactorIssuer, fetchedUser.Data.Email, secret.Data),
+		recoveryCodes,
+	)
+
+	enrollTwoFactorError := userUseCase.UserRepository.EnrollTwoFactor(ctx, userTwoFactorEnrollment)
+	if validator.IsError(enrollTwoFactorError) {
+		return common.NewResultOnFailure[user.UserTwoFactorEnrollment](domain.HandleError(enrollTwoFactorError))
+	}
+
+	return common.NewResultOnSuccess[user.UserTwoFactorEnrollment](userTwoFactorEnrollment)
+}
+
+// ConfirmTwoFactor enables two-factor authentication once the user proves the authenticator app is set up.
+func (userUseCase UserUseCase) ConfirmTwoFactor(ctx context.Context, userTwoFactorCodeData user.UserTwoFactorCode) error {
+	userTwoFactorCode := validateUserTwoFactorCode(userUseCase.Logger, userTwoFactorCodeData, false)
+	if validator.IsError(userTwoFactorCode.Error) {
+		return domain.HandleError(userTwoFactorCode.Error)
+	}
+
+	fetchedUser := userUseCase.UserRepository.GetUserById(ctx, userTwoFactorCode.Data.ID)
+	if validator.IsError(fetchedUser.Error) {
+		return domain.HandleError(fetchedUser.Error)
+	}
+	if fetchedUser.Data.TwoFactorEnabled {
+		return userUseCase.twoFactorValidationError(location+"ConfirmTwoFactor.TwoFactorEnabled", twoFactorAlreadyActive)
+	}
+	if validator.IsValueEmpty(fetchedUser.Data.TwoFactorSecret) {
+		return userUseCase.twoFactorValidationError(location+"ConfirmTwoFactor.TwoFactorSecret", twoFactorNotEnrolled)
+	}
+	useTOTPCodeError := userUseCase.useTOTPCode(ctx, location+"ConfirmTwoFactor", fetchedUser.Data, userTwoFactorCode.Data.Code)
+	if validator.IsError(useTOTPCodeError) {
+		return useTOTPCodeError
+	}
+
+	enableTwoFactorError := userUseCase.UserRepository.EnableTwoFactor(ctx, fetchedUser.Data.ID)
+	if validator.IsError(enableTwoFactorError) {
+		return domain.HandleError(enableTwoFactorError)
+	}
+
+	return nil
+}
+
+// DisableTwoFactor disables two-factor authentication, which requires the current password and a valid code or recovery code,
+// so a stolen session alone can't remove the second factor. Wrong passwords and codes are throttled like failed logins.
+func (userUseCase UserUseCase) DisableTwoFactor(ctx context.Context, userTwoFactorDisableData user.UserTwoFactorDisable, userDevice user.UserDevice) error {
+	userTwoFactorDisable := validateUserTwoFactorDisable(userUseCase.Logger, userUseCase.Config.Security.PasswordPolicy, userTwoFactorDisableData)
+	if validator.IsError(userTwoFactorDisable.Error) {
+		return domain.HandleError(userTwoFactorDisable.Error)
+	}
+
+	fetchedUser := userUseCase.UserRepository.GetUserById(ctx, userTwoFactorDisable.Data.ID)
+	if validator.IsError(fetchedUser.Error) {
+		return domain.HandleError(fetchedUser.Error)
+	}
+	if !fetchedUser.Data.TwoFactorEnabled {
+		return userUseCase.twoFactorValidationError(location+"DisableTwoFactor.TwoFactorEnabled", twoFactorNotActive)
+	}
+
+	email := fetchedUser.Data.Email
+	checkLoginThrottleError := userUseCase.checkLoginThrottle(ctx, location+"DisableTwoFactor", emailLoginAttemptKeyPrefix+email, ipLoginAttemptKeyPrefix+userDevice.IPAddress)
+	if validator.IsError(checkLoginThrottleError) {
+		return checkLoginThrottleError
+	}
+
+	checkCurrentPasswordError := checkCurrentPassword(userUseCase.Logger, userUseCase.PasswordHasher, location+"DisableTwoFactor", fetchedUser.Data.Password, userTwoFactorDisable.Data.CurrentPassword)
+	if validator.IsError(checkCurrentPasswordError) {
+		return userUseCase.handleFailedLogin(ctx, location+"DisableTwoFactor.checkCurrentPassword", checkCurrentPasswordError, email, userDevice.IPAddress)
+	}
+
+	checkTwoFactorCode := userUseCase.checkTwoFactorCode(ctx, location+"DisableTwoFactor", fetchedUser.Data, userTwoFactorDisable.Data.Code, userTwoFactorDisable.Data.RecoveryCode)
+	if validator.IsError(checkTwoFactorCode.Error) {
+		return userUseCase.handleFailedLogin(ctx, location+"DisableTwoFactor.checkTwoFactorCode", checkTwoFactorCode.Error, email, userDevice.IPAddress)
+	}
+
+	disableTwoFactorError := userUseCase.UserRepository.DisableTwoFactor(ctx, fetchedUser.Data.ID)
+	if validator.IsError(disableTwoFactorError) {
+		return domain.HandleError(disableTwoFactorError)
+	}
+
+	return nil
+}
+
+// VerifyTwoFactorLogin completes the login of a user with two-factor authentication.
+// The MFA token returned by Login is exchanged for the token pair with a TOTP code or a recovery code,
+// wrong codes are throttled together with the failed passwords.
+func (userUseCase UserUseCase) VerifyTwoFactorLogin(ctx context.Context, userTwoFactorLoginData user.UserTwoFactorLogin, userDevice user.UserDevice) common.Result[user.UserToken] {
+	userTwoFactorLogin := validateUserTwoFactorLogin(userUseCase.Logger, userTwoFactorLoginData)
+	if validator.IsError(userTwoFactorLogin.Error) {
+		return common.NewResultOnFailure[user.UserToken](domain.HandleError(userTwoFactorLogin.Error))
+	}
+
+	hashedMFAToken := utility.HashToken(userTwoFactorLogin.Data.MFAToken)
+	fetchedUser := userUseCase.UserRepository.GetUserByMFAToken(ctx, hashedMFAToken)
+	if validator.IsError(fetchedUser.Error) {
+		return common.NewResultOnFailure[user.UserToken](domain.HandleError(fetchedUser.Error))
+	}
+
+	email := fetchedUser.Data.Email
+	checkLoginThrottleError := userUseCase.checkLoginThrottle(ctx, location+"VerifyTwoFactorLogin", emailLoginAttemptKeyPrefix+email, ipLoginAttemptKeyPrefix+userDevice.IPAddress)
+	if validator.IsError(checkLoginThrottleError) {
+		return common.NewResultOnFailure[user.UserToken](checkLoginThrottleError)
+	}
+
+	matchedRecoveryCode := userUseCase.checkTwoFactorCode(ctx, location+"VerifyTwoFactorLogin", fetchedUser.Data, userTwoFactorLogin.Data.Code, userTwoFactorLogin.Data.RecoveryCode)
+	if validator.IsError(matchedRecoveryCode.Error) {
+		return common.NewResultOnFailure[user.UserToken](userUseCase.handleFailedLogin(ctx, location+"VerifyTwoFactorLogin.checkTwoFactorCode", matchedRecoveryCode.Error, email, userDevice.IPAddress))
+	}
+
+	completeTwoFactorLoginError := userUseCase.UserRepository.CompleteTwoFactorLogin(ctx, hashedMFAToken, matchedRecoveryCode.Data)
+	if validator.IsError(completeTwoFactorLoginError) {
+		return common.NewResultOnFailure[user.UserToken](domain.HandleError(completeTwoFactorLoginError))
+	}
+
+	resetLoginThrottleError := userUseCase.resetLoginThrottle(ctx, email)
+	if validator.IsError(resetLoginThrottleError) {
+		return common.NewResultOnFailure[user.UserToken](resetLoginThrottleError)
+	}
+
+	// The user might have been suspended while the second factor was pending.
+	checkUserSuspensionError := userUseCase.checkUserSuspension(ctx, location+"VerifyTwoFactorLogin", fetchedUser.Data)
+	if validator.IsError(checkUserSuspensionError) {
+		return common.NewResultOnFailure[user.UserToken](checkUserSuspensionError)
+	}
+
+	return userUseCase.startSession(ctx, location+"VerifyTwoFactorLogin", fetchedUser.Data, userDevice)
+}
+
+// issueMFAToken stores a short-lived MFA token for the user and returns it in place of the token pair.
+func (userUseCase UserUseCase) issueMFAToken(ctx context.Context, location string, fetchedUser user.User) common.Result[user.UserToken] {
+	mfaToken := randstr.String(mfaTokenLength)
+	userMFAToken := user.NewUserMFAToken(fetchedUser.ID, utility.HashToken(mfaToken), time.Now().Add(constants.MFATokenExpirationTime))
+	updateMFATokenError := userUseCase.UserRepository.UpdateMFAToken(ctx, userMFAToken)
+	if validator.IsError(updateMFATokenError) {
+		return common.NewResultOnFailure[user.UserToken](domain.HandleError(updateMFATokenError))
+	}
+
+	var userToken user.UserToken
+	userToken.MFAToken = mfaToken
+	return common.NewResultOnSuccess[user.UserToken](userToken)
+}
+
+// startSession starts a new refresh token family, which identifies the session, and issues its token pair.
+func (userUseCase UserUseCase) startSession(ctx context.Context, location string, fetchedUser user.User, userDevice user.UserDevice) common.Result[user.UserToken] {
+	userTokenPayload := user.NewUserTokenPayload(fetchedUser.ID, fetchedUser.Role)
+	userTokenPayload.TokenID = uuid.New().String()
+	userTokenPayload.SessionID = uuid.New().String()
+	userToken := userUseCase.issueUserToken(ctx, location+".startSession", userTokenPayload, userDevice)
+	if validator.IsError(userToken.Error) {
+		return common.NewResultOnFailure[user.UserToken](domain.HandleError(userToken.Error))
+	}
+
+	return userToken
+}
+
+// checkTwoFactorCode checks the TOTP code or, if it is not provided, the recovery code of the user.
+// It returns the hash of the matched recovery code, so the code can be removed after it has been used.
+func (userUseCase UserUseCase) checkTwoFactorCode(ctx context.Context, location string, fetchedUser user.User, code, recoveryCode string) common.Result[string] {
+	if validator.IsValueNotEmpty(code) {
+		useTOTPCodeError := userUseCase.useTOTPCode(ctx, location+".checkTwoFactorCode", fetchedUser, code)
+		if validator.IsError(useTOTPCodeError) {
+			return common.NewResultOnFailure[string](useTOTPCodeError)
+		}
+
+		return common.NewResultOnSuccess[string]("")
+	}
+
+	for _, hashedRecoveryCode := range fetchedUser.RecoveryCodes {
+		if userUseCase.PasswordHasher.VerifyPassword(hashedRecoveryCode, recoveryCode) {
+			return common.NewResultOnSuccess[string](hashedRecoveryCode)
+		}
+	}
+
+	return common.NewResultOnFailure[string](userUseCase.twoFactorValidationError(location+".checkTwoFactorCode", invalidTwoFactorCode))
+}
+
+// useTOTPCode checks the TOTP code of the user and stores its time step, so every code is accepted only once.
+// A code that has already been used, even by a concurrent request, is reported as invalid.
+func (userUseCase UserUseCase) useTOTPCode(ctx context.Context, location string, fetchedUser user.User, code string) error {
+	step := domainUtility.ValidateTOTPCode(userUseCase.Logger, location+".useTOTPCode", fetchedUser.TwoFactorSecret, code, fetchedUser.TwoFactorLastStep, time.Now())
+	if step == 0 {
+		return userUseCase.twoFactorValidationError(location+".useTOTPCode.ValidateTOTPCode", invalidTwoFactorCode)
+	}
+
+	updateTwoFactorLastStepError := userUseCase.UserRepository.UpdateTwoFactorLastStep(ctx, fetchedUser.ID, step)
+	if validator.IsError(updateTwoFactorLastStepError) {
+		_, isInternalError := updateTwoFactorLastStepError.(domain.InternalError)
+		if isInternalError {
+			return domain.HandleError(updateTwoFactorLastStepError)
+		}
+
+		return userUseCase.twoFactorValidationError(location+".useTOTPCode.UpdateTwoFactorLastStep", invalidTwoFactorCode)
+	}
+
+	return nil
+}
+
+// twoFactorValidationError logs and returns a validation error of the two-factor code.
+func (userUseCase UserUseCase) twoFactorValidationError(location, message string) error {
+	validationError := domain.NewValidationError(location, twoFactorCodeField, constants.FieldRequired, message)
+	userUseCase.Logger.Debug(validationError)
+	return validationError
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/thanhpk/randstr"
 	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
 	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
@@ -20,22 +21,42 @@ const (
 	location                   = "internal.user.domain.usecase."
 	verificationCodeLength int = 20
 	resetTokenLength       int = 20
-	userRole                   = "user"
+
+	refreshTokenReuseDetected = "A rotated or revoked refresh token was presented again, the whole token family has been revoked."
+	refreshTokenUserMismatch  = "The refresh token does not belong to the current user."
 )
 
 type UserUseCase struct {
-	Config         *config.ApplicationConfig
-	Logger         interfaces.Logger
-	Email          interfaces.Email
-	UserRepository interfaces.UserRepository
+	Config                        *config.ApplicationConfig
+	Logger                        interfaces.Logger
+	Email                         interfaces.Email
+	KeyRings                      domainUtility.KeyRings
+	UserRepository                interfaces.UserRepository
+	RefreshTokenRepository        interfaces.RefreshTokenRepository
+	LoginAttemptRepository        interfaces.LoginAttemptRepository
+	PersonalAccessTokenRepository interfaces.PersonalAccessTokenRepository
+	OAuthRepository               interfaces.OAuthRepository
+	OAuthProviders                map[string]interfaces.OAuthProvider
+	PasswordHasher                interfaces.PasswordHasher
+	BreachedPasswords             interfaces.BreachedPasswords
+	InvitationRepository          interfaces.InvitationRepository
 }
 
-func NewUserUseCase(config *config.ApplicationConfig, logger interfaces.Logger, email interfaces.Email, userRepository interfaces.UserRepository) UserUseCase {
+func NewUserUseCase(config *config.ApplicationConfig, logger interfaces.Logger, email interfaces.Email, keyRings domainUtility.KeyRings, userRepository interfaces.UserRepository, refreshTokenRepository interfaces.RefreshTokenRepository, loginAttemptRepository interfaces.LoginAttemptRepository, personalAccessTokenRepository interfaces.PersonalAccessTokenRepository, oauthRepository interfaces.OAuthRepository, oauthProviders map[string]interfaces.OAuthProvider, passwordHasher interfaces.PasswordHasher, breachedPasswords interfaces.BreachedPasswords, invitationRepository interfaces.InvitationRepository) UserUseCase {
 	return UserUseCase{
-		Config:         config,
-		Logger:         logger,
-		Email:          email,
-		UserRepository: userRepository,
+		Config:                        config,
+		Logger:                        logger,
+		Email:                         email,
+		KeyRings:                      keyRings,
+		UserRepository:                userRepository,
+		RefreshTokenRepository:        refreshTokenRepository,
+		LoginAttemptRepository:        loginAttemptRepository,
+		PersonalAccessTokenRepository: personalAccessTokenRepository,
+		OAuthRepository:               oauthRepository,
+		OAuthProviders:                oauthProviders,
+		PasswordHasher:                passwordHasher,
+		BreachedPasswords:             breachedPasswords,
+		InvitationRepository:          invitationRepository,
 	}
 }
 
@@ -48,6 +69,11 @@ func (userUseCase UserUseCase) GetAllUsers(ctx context.Context, paginationQuery
 	return fetchedUsers
 }
 
+// GetJSONWebKeySet returns the public keys of the access tokens, so other services can verify them.
+func (userUseCase UserUseCase) GetJSONWebKeySet() user.JSONWebKeySet {
+	return domainUtility.GenerateJSONWebKeySet(userUseCase.KeyRings.AccessToken)
+}
+
 func (userUseCase UserUseCase) GetUserById(ctx context.Context, userID string) common.Result[user.User] {
 	fetchedUser := userUseCase.UserRepository.GetUserById(ctx, userID)
 	if validator.IsError(fetchedUser.Error) {
@@ -57,6 +83,23 @@ func (userUseCase UserUseCase) GetUserById(ctx context.Context, userID string) c
 	return fetchedUser
 }
 
+// GetUserByHandle returns the user with the handle, the handle is normalized first,
+// so the profile URL works with any case of the handle.
+func (userUseCase UserUseCase) GetUserByHandle(ctx context.Context, handle string) common.Result[user.User] {
+	normalizedHandle := domainUtility.NormalizeHandle(handle)
+	checkHandleError := checkHandle(userUseCase.Logger, location+"GetUserByHandle", normalizedHandle)
+	if validator.IsError(checkHandleError) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(checkHandleError))
+	}
+
+	fetchedUser := userUseCase.UserRepository.GetUserByHandle(ctx, normalizedHandle)
+	if validator.IsError(fetchedUser.Error) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(fetchedUser.Error))
+	}
+
+	return fetchedUser
+}
+
 func (userUseCase UserUseCase) GetUserByEmail(ctx context.Context, email string) common.Result[user.User] {
 	validateEmailError := checkEmail(userUseCase.Logger, location+"GetUserByEmail", email)
 	if validator.IsError(validateEmailError) {
@@ -71,25 +114,49 @@ func (userUseCase UserUseCase) GetUserByEmail(ctx context.Context, email string)
 	return fetchedUser
 }
 
+// Register creates an unverified user, if the registration mode allows it, and sends the verification email.
+// While the registration is invite-only, a use of the invitation is counted and given back if the user can't be created.
 func (userUseCase UserUseCase) Register(ctx context.Context, userCreateData user.UserCreate) common.Result[user.User] {
-	userCreate := validateUserCreate(userUseCase.Logger, userCreateData)
+	userCreate := validateUserCreate(userUseCase.Logger, userUseCase.Config.Security.PasswordPolicy, userCreateData)
 	if validator.IsError(userCreate.Error) {
 		return common.NewResultOnFailure[user.User](domain.HandleError(userCreate.Error))
 	}
 
+	checkRegistrationError := userUseCase.checkRegistration(location+"Register", userCreate.Data.Email, userCreate.Data.InvitationCode)
+	if validator.IsError(checkRegistrationError) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(checkRegistrationError))
+	}
+
+	checkNewPasswordError := userUseCase.checkNewPassword(ctx, location+"Register", userCreate.Data.Password, userCreate.Data.Email, userCreate.Data.Username)
+	if validator.IsError(checkNewPasswordError) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(checkNewPasswordError))
+	}
+
 	checkEmailDuplicateError := userUseCase.UserRepository.CheckEmailDuplicate(ctx, userCreate.Data.Email)
 	if validator.IsError(checkEmailDuplicateError) {
 		return common.NewResultOnFailure[user.User](domain.HandleError(checkEmailDuplicateError))
 	}
 
+	checkUsernameDuplicateError := userUseCase.UserRepository.CheckUsernameDuplicate(ctx, "", userCreate.Data.Handle)
+	if validator.IsError(checkUsernameDuplicateError) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(checkUsernameDuplicateError))
+	}
+
+	useInvitationError := userUseCase.useInvitation(ctx, location+"Register", userCreate.Data.InvitationCode)
+	if validator.IsError(useInvitationError) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(useInvitationError))
+	}
+
 	token := randstr.String(verificationCodeLength)
 	encodedToken := utility.Encode(token)
-	userCreate.Data.Role = userRole
-	userCreate.Data.Verified = true
-	userCreate.Data.VerificationCode = token
+	userCreate.Data.Role = constants.UserRoleValue
+	userCreate.Data.Verified = false
+	userCreate.Data.VerificationCode = utility.HashToken(token)
+	userCreate.Data.VerificationExpiry = time.Now().Add(constants.EmailVerificationCodeExpirationTime)
 
 	createdUser := userUseCase.UserRepository.Register(ctx, userCreate.Data)
 	if validator.IsError(createdUser.Error) {
+		userUseCase.releaseInvitation(ctx, userCreate.Data.InvitationCode)
 		return common.NewResultOnFailure[user.User](domain.HandleError(createdUser.Error))
 	}
 
@@ -108,6 +175,11 @@ func (userUseCase UserUseCase) UpdateCurrentUser(ctx context.Context, userUpdate
 		return common.NewResultOnFailure[user.User](domain.HandleError(userUpdate.Error))
 	}
 
+	checkUsernameDuplicateError := userUseCase.UserRepository.CheckUsernameDuplicate(ctx, userUpdate.Data.ID, userUpdate.Data.Handle)
+	if validator.IsError(checkUsernameDuplicateError) {
+		return common.NewResultOnFailure[user.User](domain.HandleError(checkUsernameDuplicateError))
+	}
+
 	updatedUser := userUseCase.UserRepository.UpdateCurrentUser(ctx, userUpdate.Data)
 	if validator.IsError(updatedUser.Error) {
 		return common.NewResultOnFailure[user.User](domain.HandleError(updatedUser.Error))
@@ -116,6 +188,55 @@ func (userUseCase UserUseCase) UpdateCurrentUser(ctx context.Context, userUpdate
 	return updatedUser
 }
 
+// UpdatePassword changes the password of the authenticated user, which requires the current password.
+// Wrong current passwords are throttled like failed logins. All other sessions are signed out
+// and the user is notified by email, so a change made by someone else can be noticed.
+func (userUseCase UserUseCase) UpdatePassword(ctx context.Context, userPasswordUpdateData user.UserPasswordUpdate, currentSessionID string, userDevice user.UserDevice) error {
+	userPasswordUpdate := validateUserPasswordUpdate(userUseCase.Logger, userUseCase.Config.Security.PasswordPolicy, userPasswordUpdateData)
+	if validator.IsError(userPasswordUpdate.Error) {
+		return domain.HandleError(userPasswordUpdate.Error)
+	}
+
+	fetchedUser := userUseCase.UserRepository.GetUserById(ctx, userPasswordUpdate.Data.ID)
+	if validator.IsError(fetchedUser.Error) {
+		return domain.HandleError(fetchedUser.Error)
+	}
+
+	email := fetchedUser.Data.Email
+	checkLoginThrottleError := userUseCase.checkLoginThrottle(ctx, location+"UpdatePassword", emailLoginAttemptKeyPrefix+email, ipLoginAttemptKeyPrefix+userDevice.IPAddress)
+	if validator.IsError(checkLoginThrottleError) {
+		return checkLoginThrottleError
+	}
+
+	checkCurrentPasswordError := checkCurrentPassword(userUseCase.Logger, userUseCase.PasswordHasher, location+"UpdatePassword", fetchedUser.Data.Password, userPasswordUpdate.Data.CurrentPassword)
+	if validator.IsError(checkCurrentPasswordError) {
+		return userUseCase.handleFailedLogin(ctx, location+"UpdatePassword.checkCurrentPassword", checkCurrentPasswordError, email, userDevice.IPAddress)
+	}
+
+	checkNewPasswordError := userUseCase.checkNewPassword(ctx, location+"UpdatePassword", userPasswordUpdate.Data.Password, fetchedUser.Data.Email, fetchedUser.Data.Username)
+	if validator.IsError(checkNewPasswordError) {
+		return domain.HandleError(checkNewPasswordError)
+	}
+
+	updatePasswordError := userUseCase.UserRepository.UpdatePassword(ctx, userPasswordUpdate.Data)
+	if validator.IsError(updatePasswordError) {
+		return domain.HandleError(updatePasswordError)
+	}
+
+	revokeOtherSessionsError := userUseCase.RefreshTokenRepository.RevokeOtherSessions(ctx, fetchedUser.Data.ID, currentSessionID)
+	if validator.IsError(revokeOtherSessionsError) {
+		return domain.HandleError(revokeOtherSessionsError)
+	}
+
+	emailData := prepareEmailDataForPasswordChanged(userUseCase.Config, fetchedUser.Data)
+	sendEmailError := userUseCase.Email.SendEmail(userUseCase.Config, userUseCase.Logger, location+"UpdatePassword", fetchedUser.Data, emailData)
+	if validator.IsError(sendEmailError) {
+		return domain.HandleError(sendEmailError)
+	}
+
+	return nil
+}
+
 func (userUseCase UserUseCase) DeleteUserById(ctx context.Context, userID string) error {
 	deletedUser := userUseCase.UserRepository.DeleteUserById(ctx, userID)
 	if validator.IsError(deletedUser) {
@@ -125,41 +246,179 @@ func (userUseCase UserUseCase) DeleteUserById(ctx context.Context, userID string
 	return nil
 }
 
-func (userUseCase UserUseCase) Login(ctx context.Context, userLoginData user.UserLogin) common.Result[user.UserToken] {
-	userLogin := validateUserLogin(userUseCase.Logger, userLoginData)
+func (userUseCase UserUseCase) Login(ctx context.Context, userLoginData user.UserLogin, userDevice user.UserDevice) common.Result[user.UserToken] {
+	userLogin := validateUserLogin(userUseCase.Logger, userUseCase.Config.Security.PasswordPolicy, userLoginData)
 	if validator.IsError(userLogin.Error) {
 		return common.NewResultOnFailure[user.UserToken](domain.HandleError(userLogin.Error))
 	}
 
+	// Failed attempts are throttled per email and per IP address.
+	checkLoginThrottleError := userUseCase.checkLoginThrottle(ctx, location+"Login", emailLoginAttemptKeyPrefix+userLogin.Data.Email, ipLoginAttemptKeyPrefix+userDevice.IPAddress)
+	if validator.IsError(checkLoginThrottleError) {
+		return common.NewResultOnFailure[user.UserToken](checkLoginThrottleError)
+	}
+
 	fetchedUser := userUseCase.UserRepository.GetUserByEmail(ctx, userLogin.Data.Email)
 	if validator.IsError(fetchedUser.Error) {
-		return common.NewResultOnFailure[user.UserToken](domain.HandleError(fetchedUser.Error))
+		return common.NewResultOnFailure[user.UserToken](userUseCase.handleFailedLogin(ctx, location+"Login.GetUserByEmail", fetchedUser.Error, userLogin.Data.Email, userDevice.IPAddress))
 	}
-	
-	checkPasswordsError := checkPasswords(userUseCase.Logger, location+"Login", fetchedUser.Data.Password, userLoginData.Password)
+
+	checkPasswordsError := checkPasswords(userUseCase.Logger, userUseCase.PasswordHasher, location+"Login", fetchedUser.Data.Password, userLoginData.Password)
 	if validator.IsError(checkPasswordsError) {
-		return common.NewResultOnFailure[user.UserToken](domain.HandleError(checkPasswordsError))
+		return common.NewResultOnFailure[user.UserToken](userUseCase.handleFailedLogin(ctx, location+"Login.checkPasswords", checkPasswordsError, userLogin.Data.Email, userDevice.IPAddress))
+	}
+	if userUseCase.PasswordHasher.NeedsRehash(fetchedUser.Data.Password) {
+		userUseCase.rehashPassword(fetchedUser.Data, userLoginData.Password)
+	}
+	checkUserSuspensionError := userUseCase.checkUserSuspension(ctx, location+"Login", fetchedUser.Data)
+	if validator.IsError(checkUserSuspensionError) {
+		return common.NewResultOnFailure[user.UserToken](checkUserSuspensionError)
+	}
+	if !fetchedUser.Data.Verified {
+		emailNotVerifiedError := domain.NewEmailNotVerifiedError(location+"Login.Verified", constants.EmailNotVerifiedNotification)
+		userUseCase.Logger.Error(emailNotVerifiedError)
+		return common.NewResultOnFailure[user.UserToken](domain.HandleError(emailNotVerifiedError))
+	}
+	checkMustResetPasswordError := userUseCase.checkMustResetPassword(location+"Login", fetchedUser.Data)
+	if validator.IsError(checkMustResetPasswordError) {
+		return common.NewResultOnFailure[user.UserToken](checkMustResetPasswordError)
 	}
 
-	userTokenPayload := user.NewUserTokenPayload(fetchedUser.Data.ID, fetchedUser.Data.Role)
-	userToken := generateToken(userUseCase.Config, userUseCase.Logger, location+"Login", userTokenPayload)
-	if validator.IsError(userToken.Error) {
-		return common.NewResultOnFailure[user.UserToken](domain.HandleError(userToken.Error))
+	// Users with two-factor authentication get the token pair only after the second login step,
+	// the failed attempts are kept until then, so the codes cannot be guessed by logging in again.
+	if fetchedUser.Data.TwoFactorEnabled {
+		return userUseCase.issueMFAToken(ctx, location+"Login", fetchedUser.Data)
 	}
 
-	return userToken
+	resetLoginThrottleError := userUseCase.resetLoginThrottle(ctx, userLogin.Data.Email)
+	if validator.IsError(resetLoginThrottleError) {
+		return common.NewResultOnFailure[user.UserToken](resetLoginThrottleError)
+	}
+
+	// Every login starts a new refresh token family, which identifies the session.
+	return userUseCase.startSession(ctx, location+"Login", fetchedUser.Data, userDevice)
 }
 
-func (userUseCase UserUseCase) RefreshAccessToken(ctx context.Context, userData user.User) common.Result[user.UserToken] {
+// RefreshAccessToken rotates the presented refresh token and issues a new token pair.
+// Presenting a refresh token that was already rotated or revoked is treated as token theft,
+// so the whole token family is revoked.
+func (userUseCase UserUseCase) RefreshAccessToken(ctx context.Context, userData user.User, refreshTokenID string, userDevice user.UserDevice) common.Result[user.UserToken] {
+	checkUserSuspensionError := userUseCase.checkUserSuspension(ctx, location+"RefreshAccessToken", userData)
+	if validator.IsError(checkUserSuspensionError) {
+		return common.NewResultOnFailure[user.UserToken](checkUserSuspensionError)
+	}
+
+	fetchedRefreshToken := userUseCase.RefreshTokenRepository.GetRefreshTokenByTokenID(ctx, refreshTokenID)
+	if validator.IsError(fetchedRefreshToken.Error) {
+		return common.NewResultOnFailure[user.UserToken](domain.HandleError(fetchedRefreshToken.Error))
+	}
+	if fetchedRefreshToken.Data.UserID != userData.ID {
+		invalidTokenError := domain.NewInvalidTokenError(location+"RefreshAccessToken.UserID", refreshTokenUserMismatch)
+		userUseCase.Logger.Error(invalidTokenError)
+		invalidTokenError.Notification = constants.InvalidTokenErrorMessage
+		return common.NewResultOnFailure[user.UserToken](invalidTokenError)
+	}
+	if fetchedRefreshToken.Data.Revoked {
+		return common.NewResultOnFailure[user.UserToken](userUseCase.revokeRefreshTokenFamily(ctx, location+"RefreshAccessToken.Revoked", fetchedRefreshToken.Data.FamilyID))
+	}
+	if validator.IsTimeNotValid(fetchedRefreshToken.Data.ExpiresAt) {
+		timeExpiredError := domain.NewTimeExpiredError(location+"RefreshAccessToken.IsTimeNotValid", constants.TimeExpiredErrorNotification)
+		userUseCase.Logger.Error(timeExpiredError)
+		return common.NewResultOnFailure[user.UserToken](domain.HandleError(timeExpiredError))
+	}
+
+	// The new token pair is issued before the presented token is rotated, so when signing or storing it fails
+	// the client keeps a refresh token that still works instead of losing the session.
 	userTokenPayload := user.NewUserTokenPayload(userData.ID, userData.Role)
-	userToken := generateToken(userUseCase.Config, userUseCase.Logger, location+"RefreshAccessToken", userTokenPayload)
+	userTokenPayload.TokenID = uuid.New().String()
+	userTokenPayload.SessionID = fetchedRefreshToken.Data.FamilyID
+	userToken := userUseCase.issueUserToken(ctx, location+"RefreshAccessToken", userTokenPayload, userDevice)
 	if validator.IsError(userToken.Error) {
 		return common.NewResultOnFailure[user.UserToken](domain.HandleError(userToken.Error))
 	}
 
+	// Rotation only succeeds once, so a concurrent reuse of the same token is detected here as well.
+	// Revoking the family revokes the new token too, another failure only revokes the new token.
+	rotateRefreshTokenError := userUseCase.RefreshTokenRepository.RotateRefreshToken(ctx, refreshTokenID, userTokenPayload.TokenID)
+	if validator.IsError(rotateRefreshTokenError) {
+		_, isInvalidTokenError := rotateRefreshTokenError.(domain.InvalidTokenError)
+		if isInvalidTokenError {
+			return common.NewResultOnFailure[user.UserToken](userUseCase.revokeRefreshTokenFamily(ctx, location+"RefreshAccessToken.RotateRefreshToken", fetchedRefreshToken.Data.FamilyID))
+		}
+
+		userUseCase.RefreshTokenRepository.RevokeRefreshToken(ctx, userTokenPayload.TokenID)
+		return common.NewResultOnFailure[user.UserToken](domain.HandleError(rotateRefreshTokenError))
+	}
+
 	return userToken
 }
 
+// Logout revokes the presented refresh token so it cannot be used again.
+func (userUseCase UserUseCase) Logout(ctx context.Context, refreshTokenID string) error {
+	revokeRefreshTokenError := userUseCase.RefreshTokenRepository.RevokeRefreshToken(ctx, refreshTokenID)
+	if validator.IsError(revokeRefreshTokenError) {
+		return domain.HandleError(revokeRefreshTokenError)
+	}
+
+	return nil
+}
+
+// GetSessions retrieves the active sessions of the user and marks the one the request was made from.
+func (userUseCase UserUseCase) GetSessions(ctx context.Context, userID, currentSessionID string) common.Result[user.UserSessions] {
+	fetchedSessions := userUseCase.RefreshTokenRepository.GetActiveSessions(ctx, userID)
+	if validator.IsError(fetchedSessions.Error) {
+		return common.NewResultOnFailure[user.UserSessions](domain.HandleError(fetchedSessions.Error))
+	}
+
+	for index := range fetchedSessions.Data.Sessions {
+		fetchedSessions.Data.Sessions[index].Current = fetchedSessions.Data.Sessions[index].ID == currentSessionID
+	}
+
+	return fetchedSessions
+}
+
+// RevokeSession logs the user out of the provided session.
+func (userUseCase UserUseCase) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	revokeSessionError := userUseCase.RefreshTokenRepository.RevokeSession(ctx, userID, sessionID)
+	if validator.IsError(revokeSessionError) {
+		return domain.HandleError(revokeSessionError)
+	}
+
+	return nil
+}
+
+// RevokeAllSessions logs the user out of every session, including the current one.
+func (userUseCase UserUseCase) RevokeAllSessions(ctx context.Context, userID string) error {
+	revokeAllSessionsError := userUseCase.RefreshTokenRepository.RevokeAllSessions(ctx, userID)
+	if validator.IsError(revokeAllSessionsError) {
+		return domain.HandleError(revokeAllSessionsError)
+	}
+
+	return nil
+}
+
+// ValidateSession checks that the session of an authenticated token has not been revoked
+// and that its user is not suspended.
+// The suspension is checked first, because suspending a user also revokes all the user's sessions.
+func (userUseCase UserUseCase) ValidateSession(ctx context.Context, userID, sessionID string) error {
+	fetchedUser := userUseCase.UserRepository.GetUserById(ctx, userID)
+	if validator.IsError(fetchedUser.Error) {
+		return domain.HandleError(fetchedUser.Error)
+	}
+
+	checkUserSuspensionError := userUseCase.checkUserSuspension(ctx, location+"ValidateSession", fetchedUser.Data)
+	if validator.IsError(checkUserSuspensionError) {
+		return checkUserSuspensionError
+	}
+
+	checkActiveSessionError := userUseCase.RefreshTokenRepository.CheckActiveSession(ctx, userID, sessionID)
+	if validator.IsError(checkActiveSessionError) {
+		return domain.HandleError(checkActiveSessionError)
+	}
+
+	return nil
+}
+
 func (userUseCase UserUseCase) ForgottenPassword(ctx context.Context, userForgottenPasswordData user.UserForgottenPassword) error {
 	userForgottenPassword := validateUserForgottenPassword(userUseCase.Logger, userForgottenPasswordData)
 	if validator.IsError(userForgottenPassword.Error) {
@@ -198,7 +457,7 @@ func (userUseCase UserUseCase) ResetUserPassword(ctx context.Context, userResetP
 	}
 
 	userResetPasswordData.ResetToken = token.Data
-	userResetPassword := validateUserResetPassword(userUseCase.Logger, userResetPasswordData)
+	userResetPassword := validateUserResetPassword(userUseCase.Logger, userUseCase.Config.Security.PasswordPolicy, userResetPasswordData)
 	if validator.IsError(userResetPassword.Error) {
 		return domain.HandleError(userResetPassword.Error)
 	}
@@ -213,6 +472,11 @@ func (userUseCase UserUseCase) ResetUserPassword(ctx context.Context, userResetP
 		return domain.HandleError(timeExpiredError)
 	}
 
+	checkNewPasswordError := userUseCase.checkNewPassword(ctx, location+"ResetUserPassword", userResetPassword.Data.Password, fetchedResetExpiry.Data.Email, fetchedResetExpiry.Data.Username)
+	if validator.IsError(checkNewPasswordError) {
+		return domain.HandleError(checkNewPasswordError)
+	}
+
 	resetUserPasswordError := userUseCase.UserRepository.ResetUserPassword(ctx, userResetPassword.Data)
 	if validator.IsError(resetUserPasswordError) {
 		return domain.HandleError(resetUserPasswordError)
@@ -221,6 +485,163 @@ func (userUseCase UserUseCase) ResetUserPassword(ctx context.Context, userResetP
 	return nil
 }
 
+func (userUseCase UserUseCase) VerifyEmail(ctx context.Context, encodedVerificationCode string) error {
+	verificationCode := utility.Decode(userUseCase.Logger, location+"VerifyEmail", encodedVerificationCode)
+	if validator.IsError(verificationCode.Error) {
+		return domain.HandleError(verificationCode.Error)
+	}
+
+	validatedVerificationCode := validateVerificationCode(userUseCase.Logger, verificationCode.Data)
+	if validator.IsError(validatedVerificationCode.Error) {
+		return domain.HandleError(validatedVerificationCode.Error)
+	}
+
+	hashedVerificationCode := utility.HashToken(validatedVerificationCode.Data)
+	fetchedVerificationExpiry := userUseCase.UserRepository.GetVerificationExpiry(ctx, hashedVerificationCode)
+	if validator.IsError(fetchedVerificationExpiry.Error) {
+		return domain.HandleError(fetchedVerificationExpiry.Error)
+	}
+	if validator.IsTimeNotValid(fetchedVerificationExpiry.Data.VerificationExpiry) {
+		timeExpiredError := domain.NewTimeExpiredError(location+"VerifyEmail.IsTimeNotValid", constants.TimeExpiredErrorNotification)
+		userUseCase.Logger.Error(timeExpiredError)
+		return domain.HandleError(timeExpiredError)
+	}
+
+	verifyEmailError := userUseCase.UserRepository.VerifyEmail(ctx, hashedVerificationCode)
+	if validator.IsError(verifyEmailError) {
+		return domain.HandleError(verifyEmailError)
+	}
+
+	return nil
+}
+
+// ResendVerificationCode sends a new verification code to an unverified account with the email address.
+// An unknown or already verified address gets the same response without an email, so the response doesn't tell
+// which addresses are registered.
+func (userUseCase UserUseCase) ResendVerificationCode(ctx context.Context, userVerificationCodeData user.UserVerificationCode) error {
+	userVerificationCode := validateUserVerificationCode(userUseCase.Logger, userVerificationCodeData)
+	if validator.IsError(userVerificationCode.Error) {
+		return domain.HandleError(userVerificationCode.Error)
+	}
+
+	fetchedUser := userUseCase.UserRepository.GetUserByEmail(ctx, userVerificationCode.Data.Email)
+	if validator.IsError(fetchedUser.Error) {
+		_, isInternalError := fetchedUser.Error.(domain.InternalError)
+		if isInternalError {
+			return domain.HandleError(fetchedUser.Error)
+		}
+
+		return nil
+	}
+	if fetchedUser.Data.Verified {
+		userUseCase.Logger.Debug(domain.NewInfoMessage(location+"ResendVerificationCode.Verified", constants.EmailAlreadyVerifiedNotification))
+		return nil
+	}
+
+	token := randstr.String(verificationCodeLength)
+	encodedToken := utility.Encode(token)
+	userVerificationCode.Data.VerificationCode = utility.HashToken(token)
+	userVerificationCode.Data.VerificationExpiry = time.Now().Add(constants.EmailVerificationCodeExpirationTime)
+
+	updateVerificationCodeError := userUseCase.UserRepository.UpdateVerificationCode(ctx, userVerificationCode.Data)
+	if validator.IsError(updateVerificationCodeError) {
+		return domain.HandleError(updateVerificationCodeError)
+	}
+
+	emailData := prepareEmailDataForRegistration(userUseCase.Config, fetchedUser.Data, encodedToken)
+	sendEmailError := userUseCase.Email.SendEmail(userUseCase.Config, userUseCase.Logger, location+"ResendVerificationCode", fetchedUser.Data, emailData)
+	if validator.IsError(sendEmailError) {
+		return domain.HandleError(sendEmailError)
+	}
+
+	return nil
+}
+
+// issueUserToken generates the token pair and stores the refresh token described by the payload.
+// The pair is generated first, so a token that can't be signed is never stored.
+func (userUseCase UserUseCase) issueUserToken(ctx context.Context, location string, userTokenPayload user.UserTokenPayload, userDevice user.UserDevice) common.Result[user.UserToken] {
+	userToken := generateToken(userUseCase.Config, userUseCase.Logger, userUseCase.KeyRings, location+".issueUserToken", userTokenPayload)
+	if validator.IsError(userToken.Error) {
+		return userToken
+	}
+
+	refreshTokenCreate := user.NewRefreshTokenCreate(
+		userTokenPayload.TokenID,
+		userTokenPayload.SessionID,
+		userTokenPayload.UserID,
+		userDevice,
+		time.Now().Add(userUseCase.Config.RefreshToken.ExpiredIn),
+	)
+
+	createdRefreshToken := userUseCase.RefreshTokenRepository.CreateRefreshToken(ctx, refreshTokenCreate)
+	if validator.IsError(createdRefreshToken.Error) {
+		return common.NewResultOnFailure[user.UserToken](createdRefreshToken.Error)
+	}
+
+	return userToken
+}
+
+// rehashPassword replaces a password hash of an outdated algorithm or with outdated parameters in the background,
+// the login doesn't wait for it and a failed rehash is only logged, the password is rehashed on the next login then.
+func (userUseCase UserUseCase) rehashPassword(fetchedUser user.User, password string) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultContextTimer)
+		defer cancel()
+
+		userUseCase.UserRepository.RehashPassword(ctx, fetchedUser.ID, fetchedUser.Password, password)
+	}()
+}
+
+// revokeRefreshTokenFamily revokes all refresh tokens of the family after a token reuse has been detected.
+func (userUseCase UserUseCase) revokeRefreshTokenFamily(ctx context.Context, location, familyID string) error {
+	invalidTokenError := domain.NewInvalidTokenError(location, refreshTokenReuseDetected)
+	userUseCase.Logger.Warn(invalidTokenError)
+
+	revokeRefreshTokenFamilyError := userUseCase.RefreshTokenRepository.RevokeRefreshTokenFamily(ctx, familyID)
+	if validator.IsError(revokeRefreshTokenFamilyError) {
+		return domain.HandleError(revokeRefreshTokenFamilyError)
+	}
+
+	invalidTokenError.Notification = constants.InvalidTokenErrorMessage
+	return invalidTokenError
+}
+
+// checkUserSuspension returns a UserSuspendedError if the user is suspended.
+// A temporary suspension that has already expired is lifted instead.
+func (userUseCase UserUseCase) checkUserSuspension(ctx context.Context, location string, fetchedUser user.User) error {
+	if !fetchedUser.Suspended {
+		return nil
+	}
+	if !fetchedUser.SuspendedUntil.IsZero() && validator.IsTimeNotValid(fetchedUser.SuspendedUntil) {
+		liftExpiredSuspensionError := userUseCase.UserRepository.LiftExpiredSuspension(ctx, fetchedUser.ID)
+		if validator.IsError(liftExpiredSuspensionError) {
+			return domain.HandleError(liftExpiredSuspensionError)
+		}
+
+		return nil
+	}
+
+	suspendedUntil := ""
+	if !fetchedUser.SuspendedUntil.IsZero() {
+		suspendedUntil = fetchedUser.SuspendedUntil.Format(time.RFC3339)
+	}
+
+	userSuspendedError := domain.NewUserSuspendedError(location+".checkUserSuspension", fetchedUser.SuspensionReason, suspendedUntil, constants.UserSuspendedNotification)
+	userUseCase.Logger.Warn(userSuspendedError)
+	return domain.HandleError(userSuspendedError)
+}
+
+// checkMustResetPassword returns a MustResetPasswordError if the password reset was forced and is not done yet.
+func (userUseCase UserUseCase) checkMustResetPassword(location string, fetchedUser user.User) error {
+	if !fetchedUser.MustResetPassword {
+		return nil
+	}
+
+	mustResetPasswordError := domain.NewMustResetPasswordError(location+".checkMustResetPassword", constants.MustResetPasswordNotification)
+	userUseCase.Logger.Warn(mustResetPasswordError)
+	return domain.HandleError(mustResetPasswordError)
+}
+
 func prepareEmailData(config *config.ApplicationConfig, user user.User, tokenValue, subject, url, templateName, templatePath string) interfaces.EmailData {
 	emailData := interfaces.NewEmailData(
 		user.Email,
@@ -258,13 +679,28 @@ func prepareEmailDataForForgottenPassword(config *config.ApplicationConfig, user
 	)
 }
 
-func generateToken(config *config.ApplicationConfig, logger interfaces.Logger, location string, userTokenPayload user.UserTokenPayload) common.Result[user.UserToken] {
+func prepareEmailDataForPasswordChanged(config *config.ApplicationConfig, user user.User) interfaces.EmailData {
+	return prepareEmailData(
+		config,
+		user,
+		"",
+		constants.PasswordChangedSubject,
+		constants.PasswordChangedUrl,
+		config.Email.PasswordChangedTemplateName,
+		config.Email.PasswordChangedTemplatePath,
+	)
+}
+
+func generateToken(config *config.ApplicationConfig, logger interfaces.Logger, keyRings domainUtility.KeyRings, location string, userTokenPayload user.UserTokenPayload) common.Result[user.UserToken] {
+	// The token ID identifies the stored refresh token, the access token only carries the session.
+	accessTokenPayload := userTokenPayload
+	accessTokenPayload.TokenID = ""
 	accessToken := domainUtility.GenerateJWTToken(
 		logger,
 		location+".generateToken.accessToken",
-		config.AccessToken.PrivateKey,
+		keyRings.AccessToken,
 		config.AccessToken.ExpiredIn,
-		userTokenPayload,
+		accessTokenPayload,
 	)
 	if validator.IsError(accessToken.Error) {
 		return common.NewResultOnFailure[user.UserToken](accessToken.Error)
@@ -273,7 +709,7 @@ func generateToken(config *config.ApplicationConfig, logger interfaces.Logger, l
 	refreshToken := domainUtility.GenerateJWTToken(
 		logger,
 		location+".generateToken.refreshToken",
-		config.RefreshToken.PrivateKey,
+		keyRings.RefreshToken,
 		config.RefreshToken.ExpiredIn,
 		userTokenPayload,
 	)
@@ -1,57 +1,117 @@
 package usecase
 
 import (
+	"fmt"
 	"net"
 	"regexp"
 	"strings"
+	"time"
 
 	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
 	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	domainUtility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
 	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
 	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
 	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
 	commonUtility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/common"
 	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/domain"
+	policy "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/policy"
 	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
-	bcrypt "golang.org/x/crypto/bcrypt"
 )
 
 // Constants used for various validation messages and field names.
 const (
 	// Error Messages for invalid inputs.
-	passwordAllowedCharacters = "Sorry, only letters (a-z), numbers(0-9), the asterics, hyphen and underscore characters are allowed."
+	passwordAllowedCharacters = "Sorry, control characters are not allowed."
 	emailAllowedCharacters    = "Sorry, only letters (a-z), numbers(0-9) and periods (.) are allowed, you cannot use a period in the end and more than one in a row."
 	invalidEmailDomain        = "Email domain does not exist."
 	passwordsDoNotMatch       = "Passwords do not match."
+	passwordPersonalData      = "Sorry, the password cannot contain your email or username."
+	passwordBreached          = "Sorry, this password has appeared in a data breach, please choose a different one."
 	invalidEmailOrPassword    = "Invalid email or password."
+	invalidRole               = "Sorry, the role does not exist."
+	invalidCreatedRange       = "Sorry, the start of the creation range must be before its end."
+	invalidSuspendedUntil     = "Sorry, the end of the suspension must be in the future."
+	twoFactorCodeAllowed      = "Sorry, the code must consist of 6 digits."
+	recoveryCodeAllowed       = "Sorry, the recovery code must consist of 10 letters and numbers."
+	codeOrRecoveryCode        = "Sorry, either the code or the recovery code must be provided."
+	invalidCurrentPassword    = "The current password is incorrect."
+	samePassword              = "Sorry, the new password must differ from the current one."
+	scopesRequired            = "Sorry, at least one scope must be provided."
+	scopeNotGranted           = "Sorry, the scope %s is not granted to your role."
+	invalidExpiresAt          = "Sorry, the expiration must be in the future."
+	oauthEmailNotVerified     = "Sorry, the email address of your %s account must be verified before you can sign in with it."
+	invitationCodeAllowed     = "Sorry, the invitation code must consist of 20 letters and numbers."
+	invalidMaxUses            = "Sorry, the maximum number of uses must be between 1 and %d."
+	handleAllowedCharacters   = "Sorry, only letters (a-z), numbers (0-9), hyphens and underscores are allowed."
 
 	// Field Names used in validation.
-	usernameField         = "username"
+	UsernameField         = "username"
 	EmailField            = "email"
 	passwordField         = "password"
+	currentPasswordField  = "current_password"
 	emailOrPasswordFields = "email or password"
 	resetTokenField       = "reset token"
+	verificationCodeField = "verification code"
+	roleField             = "role"
+	createdRangeField     = "created_from and created_to"
+	suspensionReasonField = "reason"
+	suspendedUntilField   = "suspended_until"
+	recoveryCodeField     = "recovery_code"
+	mfaTokenField         = "mfa_token"
+	tokenNameField        = "name"
+	scopesField           = "scopes"
+	expiresAtField        = "expires_at"
+	oauthCodeField        = "code"
+	oauthStateField       = "state"
+	invitationCodeField   = "invitation_code"
+	maxUsesField          = "max_uses"
+	handleField           = "handle"
+
+	// Length constraints.
+	minSuspensionReasonLength = 4
+	maxSuspensionReasonLength = 200
+	maxOAuthCodeLength        = 2048
+	maxInvitationUses         = 1000
+	minHandleLength           = 1
+	maxHandleLength           = 50 // Leaves room for the suffixes of handles made unique by the migration.
 )
 
 // Regular expressions for validating the fields.
 var (
 	emailRegex    = regexp.MustCompile("^(?:(?:(?:(?:[a-zA-Z]|\\d|[\\\\\\\\/=\\\\{\\|}]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+(?:\\.([a-zA-Z]|\\d|[\\\\+\\-\\/=\\\\_{\\|}]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+)*)|(?:(?:\\x22)(?:(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(?:\\x20|\\x09)+)?(?:(?:[\\x01-\\x08\\x0b\\x0c\\x0e-\\x1f\\x7f]|\\x21|[\\x23-\\x5b]|[\\x5d-\\x7e]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[\\x01-\\x09\\x0b\\x0c\\x0d-\\x7f]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}]))))*(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(\\x20|\\x09)+)?(?:\\x22))))@(?:(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.||[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.)+(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.||[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.?$") //nolint:gosimple
-	usernameRegex = regexp.MustCompile(`^[a-zA-z0-9-_ \t]*$`)
-	passwordRegex = regexp.MustCompile((`^[a-zA-z0-9-_*,.]*$`))
+	usernameRegex = regexp.MustCompile(`^[a-zA-Z0-9_ \t-]*$`)
+	passwordRegex = regexp.MustCompile(`^\P{C}*$`)
+	reasonRegex   = regexp.MustCompile(constants.DefaultStringRegex)
+
+	twoFactorCodeRegex  = regexp.MustCompile(`^[0-9]{6}$`)
+	recoveryCodeRegex   = regexp.MustCompile(`^[a-zA-Z0-9]{10}$`)
+	mfaTokenRegex       = regexp.MustCompile(`^[a-zA-Z0-9]{32}$`)
+	tokenNameRegex      = regexp.MustCompile(constants.DefaultStringRegex)
+	oauthCodeRegex      = regexp.MustCompile(`^[\x21-\x7E]*$`)
+	oauthStateRegex     = regexp.MustCompile(`^[a-zA-Z0-9]{32}$`)
+	invitationCodeRegex = regexp.MustCompile(`^[a-zA-Z0-9]{20}$`)
+	handleRegex         = regexp.MustCompile(`^[a-z0-9_-]*$`)
 )
 
-func validateUserCreate(logger interfaces.Logger, userCreate user.UserCreate) common.Result[user.UserCreate] {
+func validateUserCreate(logger interfaces.Logger, passwordPolicy config.PasswordPolicy, userCreate user.UserCreate) common.Result[user.UserCreate] {
 	validationErrors := make([]error, 0, 4)
 
 	userCreate.Email = commonUtility.SanitizeAndToLowerString(userCreate.Email)
 	userCreate.Username = commonUtility.SanitizeAndCollapseWhitespace(userCreate.Username)
+	userCreate.Handle = domainUtility.NormalizeHandle(userCreate.Username)
 	userCreate.Password = strings.TrimSpace(userCreate.Password)
 	userCreate.PasswordConfirm = strings.TrimSpace(userCreate.PasswordConfirm)
-	usernameValidator := utility.NewStringValidator(usernameField, userCreate.Username, usernameRegex, constants.DefaultMinStringLength, constants.DefaultMaxStringLength, false)
+	userCreate.InvitationCode = strings.TrimSpace(userCreate.InvitationCode)
+	usernameValidator := utility.NewStringValidator(UsernameField, userCreate.Username, usernameRegex, constants.DefaultMinStringLength, constants.DefaultMaxStringLength, false)
+	invitationCodeValidator := utility.NewStringValidator(invitationCodeField, userCreate.InvitationCode, invitationCodeRegex, invitationCodeLength, invitationCodeLength, true)
+	invitationCodeValidator.Notification = invitationCodeAllowed
 
 	validationErrors = validateEmail(logger, location+"validateUserCreate", userCreate.Email, validationErrors)
 	validationErrors = utility.ValidateField(logger, location+"validateUserCreate", usernameValidator, validationErrors)
-	validationErrors = validatePassword(logger, location+"validateUserCreate", userCreate.Password, userCreate.PasswordConfirm, validationErrors)
+	validationErrors = validatePassword(logger, location+"validateUserCreate", passwordPolicy, userCreate.Password, userCreate.PasswordConfirm, validationErrors)
+	validationErrors = utility.ValidateField(logger, location+"validateUserCreate", invitationCodeValidator, validationErrors)
 	if len(validationErrors) > 0 {
 		return common.NewResultOnFailure[user.UserCreate](domain.NewValidationErrors(validationErrors))
 	}
@@ -63,7 +123,8 @@ func validateUserUpdate(logger interfaces.Logger, userUpdate user.UserUpdate) co
 	validationErrors := make([]error, 0, 1)
 
 	userUpdate.Username = commonUtility.SanitizeAndCollapseWhitespace(userUpdate.Username)
-	usernameValidator := utility.NewStringValidator(usernameField, userUpdate.Username, usernameRegex, constants.DefaultMinStringLength, constants.DefaultMaxStringLength, false)
+	userUpdate.Handle = domainUtility.NormalizeHandle(userUpdate.Username)
+	usernameValidator := utility.NewStringValidator(UsernameField, userUpdate.Username, usernameRegex, constants.DefaultMinStringLength, constants.DefaultMaxStringLength, false)
 	validationErrors = utility.ValidateField(logger, location+"validateUserUpdate", usernameValidator, validationErrors)
 	if len(validationErrors) > 0 {
 		return common.NewResultOnFailure[user.UserUpdate](domain.NewValidationErrors(validationErrors))
@@ -72,15 +133,50 @@ func validateUserUpdate(logger interfaces.Logger, userUpdate user.UserUpdate) co
 	return common.NewResultOnSuccess[user.UserUpdate](userUpdate)
 }
 
-func validateUserLogin(logger interfaces.Logger, userLogin user.UserLogin) common.Result[user.UserLogin] {
+func validateUserPasswordUpdate(logger interfaces.Logger, passwordPolicy config.PasswordPolicy, userPasswordUpdate user.UserPasswordUpdate) common.Result[user.UserPasswordUpdate] {
+	validationErrors := make([]error, 0, 3)
+
+	userPasswordUpdate.CurrentPassword = strings.TrimSpace(userPasswordUpdate.CurrentPassword)
+	userPasswordUpdate.Password = strings.TrimSpace(userPasswordUpdate.Password)
+	userPasswordUpdate.PasswordConfirm = strings.TrimSpace(userPasswordUpdate.PasswordConfirm)
+
+	validationErrors = validateCurrentPassword(logger, location+"validateUserPasswordUpdate", currentPasswordField, passwordPolicy, userPasswordUpdate.CurrentPassword, validationErrors)
+	validationErrors = validatePassword(logger, location+"validateUserPasswordUpdate", passwordPolicy, userPasswordUpdate.Password, userPasswordUpdate.PasswordConfirm, validationErrors)
+	if userPasswordUpdate.Password == userPasswordUpdate.CurrentPassword {
+		validationError := domain.NewValidationError(location+"validateUserPasswordUpdate.SamePassword", passwordField, constants.FieldRequired, samePassword)
+		logger.Debug(validationError)
+		validationErrors = append(validationErrors, validationError)
+	}
+	if len(validationErrors) > 0 {
+		return common.NewResultOnFailure[user.UserPasswordUpdate](domain.NewValidationErrors(validationErrors))
+	}
+
+	return common.NewResultOnSuccess[user.UserPasswordUpdate](userPasswordUpdate)
+}
+
+func validateUserEmailChange(logger interfaces.Logger, passwordPolicy config.PasswordPolicy, userEmailChange user.UserEmailChange) common.Result[user.UserEmailChange] {
+	validationErrors := make([]error, 0, 2)
+
+	userEmailChange.Email = commonUtility.SanitizeAndToLowerString(userEmailChange.Email)
+	userEmailChange.Password = strings.TrimSpace(userEmailChange.Password)
+
+	validationErrors = validateEmail(logger, location+"validateUserEmailChange", userEmailChange.Email, validationErrors)
+	validationErrors = validateCurrentPassword(logger, location+"validateUserEmailChange", passwordField, passwordPolicy, userEmailChange.Password, validationErrors)
+	if len(validationErrors) > 0 {
+		return common.NewResultOnFailure[user.UserEmailChange](domain.NewValidationErrors(validationErrors))
+	}
+
+	return common.NewResultOnSuccess[user.UserEmailChange](userEmailChange)
+}
+
+func validateUserLogin(logger interfaces.Logger, passwordPolicy config.PasswordPolicy, userLogin user.UserLogin) common.Result[user.UserLogin] {
 	validationErrors := make([]error, 0, 2)
 
 	userLogin.Email = commonUtility.SanitizeAndToLowerString(userLogin.Email)
 	userLogin.Password = strings.TrimSpace(userLogin.Password)
-	passwordValidator := utility.NewStringValidator(passwordField, userLogin.Password, passwordRegex, constants.DefaultMinStringLength, constants.DefaultMaxStringLength, false)
 
 	validationErrors = validateEmail(logger, location+"validateUserLogin", userLogin.Email, validationErrors)
-	validationErrors = utility.ValidateField(logger, location+"validateUserLogin", passwordValidator, validationErrors)
+	validationErrors = validateCurrentPassword(logger, location+"validateUserLogin", passwordField, passwordPolicy, userLogin.Password, validationErrors)
 	if len(validationErrors) > 0 {
 		return common.NewResultOnFailure[user.UserLogin](domain.NewValidationErrors(validationErrors))
 	}
@@ -100,7 +196,19 @@ func validateUserForgottenPassword(logger interfaces.Logger, userForgottenPasswo
 	return common.NewResultOnSuccess[user.UserForgottenPassword](userForgottenPassword)
 }
 
-func validateUserResetPassword(logger interfaces.Logger, userResetPassword user.UserResetPassword) common.Result[user.UserResetPassword] {
+func validateUserMagicLink(logger interfaces.Logger, userMagicLink user.UserMagicLink) common.Result[user.UserMagicLink] {
+	validationErrors := make([]error, 0, 1)
+
+	userMagicLink.Email = commonUtility.SanitizeAndToLowerString(userMagicLink.Email)
+	validationErrors = validateEmail(logger, location+"validateUserMagicLink", userMagicLink.Email, validationErrors)
+	if len(validationErrors) > 0 {
+		return common.NewResultOnFailure[user.UserMagicLink](domain.NewValidationErrors(validationErrors))
+	}
+
+	return common.NewResultOnSuccess[user.UserMagicLink](userMagicLink)
+}
+
+func validateUserResetPassword(logger interfaces.Logger, passwordPolicy config.PasswordPolicy, userResetPassword user.UserResetPassword) common.Result[user.UserResetPassword] {
 	validationErrors := make([]error, 0, 2)
 
 	userResetPassword.ResetToken = strings.TrimSpace(userResetPassword.ResetToken)
@@ -109,7 +217,7 @@ func validateUserResetPassword(logger interfaces.Logger, userResetPassword user.
 	tokenValidator := utility.NewStringValidator(resetTokenField, userResetPassword.ResetToken, usernameRegex, constants.DefaultMinStringLength, constants.DefaultMaxStringLength, false)
 
 	validationErrors = utility.ValidateField(logger, location+"validateUserResetPassword", tokenValidator, validationErrors)
-	validationErrors = validatePassword(logger, location+"validateUserResetPassword", userResetPassword.Password, userResetPassword.PasswordConfirm, validationErrors)
+	validationErrors = validatePassword(logger, location+"validateUserResetPassword", passwordPolicy, userResetPassword.Password, userResetPassword.PasswordConfirm, validationErrors)
 	if len(validationErrors) > 0 {
 		return common.NewResultOnFailure[user.UserResetPassword](domain.NewValidationErrors(validationErrors))
 	}
@@ -117,6 +225,31 @@ func validateUserResetPassword(logger interfaces.Logger, userResetPassword user.
 	return common.NewResultOnSuccess[user.UserResetPassword](userResetPassword)
 }
 
+func validateUserVerificationCode(logger interfaces.Logger, userVerificationCode user.UserVerificationCode) common.Result[user.UserVerificationCode] {
+	validationErrors := make([]error, 0, 2)
+
+	userVerificationCode.Email = commonUtility.SanitizeAndToLowerString(userVerificationCode.Email)
+	validationErrors = validateEmail(logger, location+"validateUserVerificationCode", userVerificationCode.Email, validationErrors)
+	if len(validationErrors) > 0 {
+		return common.NewResultOnFailure[user.UserVerificationCode](domain.NewValidationErrors(validationErrors))
+	}
+
+	return common.NewResultOnSuccess[user.UserVerificationCode](userVerificationCode)
+}
+
+func validateVerificationCode(logger interfaces.Logger, verificationCode string) common.Result[string] {
+	validationErrors := make([]error, 0, 1)
+
+	verificationCode = strings.TrimSpace(verificationCode)
+	verificationCodeValidator := utility.NewStringValidator(verificationCodeField, verificationCode, usernameRegex, constants.DefaultMinStringLength, constants.DefaultMaxStringLength, false)
+	validationErrors = utility.ValidateField(logger, location+"validateVerificationCode", verificationCodeValidator, validationErrors)
+	if len(validationErrors) > 0 {
+		return common.NewResultOnFailure[string](domain.NewValidationErrors(validationErrors))
+	}
+
+	return common.NewResultOnSuccess[string](verificationCode)
+}
+
 func validateEmail(logger interfaces.Logger, location, email string, validationErrors []error) []error {
 	errors := validationErrors
 
@@ -135,16 +268,13 @@ func validateEmail(logger interfaces.Logger, location, email string, validationE
 	return errors
 }
 
-func validatePassword(logger interfaces.Logger, location, password, passwordConfirm string, validationErrors []error) []error {
-	errors := validationErrors
-
-	passwordValidator := utility.NewStringValidator(passwordField, password, passwordRegex, constants.DefaultMinStringLength, constants.DefaultMaxStringLength, false)
-	passwordValidator.Notification = passwordAllowedCharacters
-	errors = utility.ValidateField(logger, location+".validatePassword", passwordValidator, errors)
+// validatePassword checks a new password against the password policy.
+func validatePassword(logger interfaces.Logger, location string, passwordPolicy config.PasswordPolicy, password, passwordConfirm string, validationErrors []error) []error {
+	errors := checkPassword(logger, location+".validatePassword", passwordField, passwordPolicy, password, validationErrors)
 	if password != passwordConfirm {
 		validationError := domain.NewValidationError(
 			location+".validatePassword",
-			passwordValidator.FieldName,
+			passwordField,
 			constants.FieldRequired,
 			passwordsDoNotMatch,
 		)
@@ -155,6 +285,34 @@ func validatePassword(logger interfaces.Logger, location, password, passwordConf
 	return errors
 }
 
+// validateCurrentPassword checks a password the user already has. Only the length limits of the current and
+// the previous password policy apply, so changing the policy never locks out the existing users.
+func validateCurrentPassword(logger interfaces.Logger, location, fieldName string, passwordPolicy config.PasswordPolicy, password string, validationErrors []error) []error {
+	lengthPolicy := config.PasswordPolicy{
+		MinLength: min(passwordPolicy.MinLength, constants.DefaultMinStringLength),
+		MaxLength: max(passwordPolicy.MaxLength, constants.DefaultMaxStringLength),
+	}
+
+	return checkPassword(logger, location+".validateCurrentPassword", fieldName, lengthPolicy, password, validationErrors)
+}
+
+func checkPassword(logger interfaces.Logger, location, fieldName string, passwordPolicy config.PasswordPolicy, password string, validationErrors []error) []error {
+	errors := validationErrors
+
+	if utility.AreStringCharactersInvalid(password, passwordRegex) {
+		validationError := domain.NewValidationError(location+".checkPassword.AreStringCharactersInvalid", fieldName, constants.FieldRequired, passwordAllowedCharacters)
+		logger.Debug(validationError)
+		errors = append(errors, validationError)
+	}
+	for _, notification := range domainUtility.CheckPasswordPolicy(passwordPolicy, password) {
+		validationError := domain.NewValidationError(location+".checkPassword.CheckPasswordPolicy", fieldName, constants.FieldRequired, notification)
+		logger.Debug(validationError)
+		errors = append(errors, validationError)
+	}
+
+	return errors
+}
+
 // checkEmailDomain checks if the email domain exists by resolving DNS records.
 func checkEmailDomain(logger interfaces.Logger, location, emailString string) error {
 	host := strings.Split(emailString, "@")[1]
@@ -174,9 +332,9 @@ func checkEmailDomain(logger interfaces.Logger, location, emailString string) er
 	return nil
 }
 
-func checkPasswords(logger interfaces.Logger, location, hashedPassword string, checkedPassword string) error {
-	if validator.IsError(bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(checkedPassword))) {
-		validationError := domain.NewValidationError(location+".checkPasswords.CompareHashAndPassword", emailOrPasswordFields, constants.FieldRequired, passwordsDoNotMatch)
+func checkPasswords(logger interfaces.Logger, passwordHasher interfaces.PasswordHasher, location, hashedPassword string, checkedPassword string) error {
+	if !passwordHasher.VerifyPassword(hashedPassword, checkedPassword) {
+		validationError := domain.NewValidationError(location+".checkPasswords.VerifyPassword", emailOrPasswordFields, constants.FieldRequired, passwordsDoNotMatch)
 		logger.Debug(validationError)
 		validationError.Notification = invalidEmailOrPassword
 		return validationError
@@ -185,6 +343,16 @@ func checkPasswords(logger interfaces.Logger, location, hashedPassword string, c
 	return nil
 }
 
+func checkCurrentPassword(logger interfaces.Logger, passwordHasher interfaces.PasswordHasher, location, hashedPassword string, currentPassword string) error {
+	if !passwordHasher.VerifyPassword(hashedPassword, currentPassword) {
+		validationError := domain.NewValidationError(location+".checkCurrentPassword.VerifyPassword", currentPasswordField, constants.FieldRequired, invalidCurrentPassword)
+		logger.Debug(validationError)
+		return validationError
+	}
+
+	return nil
+}
+
 func checkEmail(logger interfaces.Logger, location, email string) error {
 	validationErrors := make([]error, 0, 1)
 
@@ -196,3 +364,259 @@ func checkEmail(logger interfaces.Logger, location, email string) error {
 
 	return checkEmailDomain(logger, location+".checkEmail", email)
 }
+
+func checkHandle(logger interfaces.Logger, location, handle string) error {
+	validationErrors := make([]error, 0, 1)
+
+	handleValidator := utility.NewStringValidator(handleField, handle, handleRegex, minHandleLength, maxHandleLength, false)
+	handleValidator.Notification = handleAllowedCharacters
+	validationErrors = utility.ValidateField(logger, location+".checkHandle", handleValidator, validationErrors)
+	if len(validationErrors) > 0 {
+		return validationErrors[0]
+	}
+
+	return nil
+}
+
+func validateUserFilter(logger interfaces.Logger, userFilter user.UserFilter) common.Result[user.UserFilter] {
+	validationErrors := make([]error, 0, 2)
+
+	userFilter.Role = commonUtility.SanitizeAndToLowerString(userFilter.Role)
+	if validator.IsValueNotEmpty(userFilter.Role) {
+		validationErrors = validateRole(logger, location+"validateUserFilter", userFilter.Role, constants.FieldOptional, validationErrors)
+	}
+	if !userFilter.CreatedFrom.IsZero() && !userFilter.CreatedTo.IsZero() && userFilter.CreatedFrom.After(userFilter.CreatedTo) {
+		validationError := domain.NewValidationError(location+"validateUserFilter.CreatedRange", createdRangeField, constants.FieldOptional, invalidCreatedRange)
+		logger.Debug(validationError)
+		validationErrors = append(validationErrors, validationError)
+	}
+	if len(validationErrors) > 0 {
+		return common.NewResultOnFailure[user.UserFilter](domain.NewValidationErrors(validationErrors))
+	}
+
+	return common.NewResultOnSuccess[user.UserFilter](userFilter)
+}
+
+func validateUserRoleUpdate(logger interfaces.Logger, userRoleUpdate user.UserRoleUpdate) common.Result[user.UserRoleUpdate] {
+	validationErrors := make([]error, 0, 1)
+
+	userRoleUpdate.Role = commonUtility.SanitizeAndToLowerString(userRoleUpdate.Role)
+	validationErrors = validateRole(logger, location+"validateUserRoleUpdate", userRoleUpdate.Role, constants.FieldRequired, validationErrors)
+	if len(validationErrors) > 0 {
+		return common.NewResultOnFailure[user.UserRoleUpdate](domain.NewValidationErrors(validationErrors))
+	}
+
+	return common.NewResultOnSuccess[user.UserRoleUpdate](userRoleUpdate)
+}
+
+func validateUserSuspension(logger interfaces.Logger, userSuspension user.UserSuspension) common.Result[user.UserSuspension] {
+	validationErrors := make([]error, 0, 2)
+
+	userSuspension.Reason = commonUtility.SanitizeAndCollapseWhitespace(userSuspension.Reason)
+	reasonValidator := utility.NewStringValidator(suspensionReasonField, userSuspension.Reason, reasonRegex, minSuspensionReasonLength, maxSuspensionReasonLength, false)
+	validationErrors = utility.ValidateField(logger, location+"validateUserSuspension", reasonValidator, validationErrors)
+	if !userSuspension.SuspendedUntil.IsZero() && validator.IsTimeNotValid(userSuspension.SuspendedUntil) {
+		validationError := domain.NewValidationError(location+"validateUserSuspension.SuspendedUntil", suspendedUntilField, constants.FieldOptional, invalidSuspendedUntil)
+		logger.Debug(validationError)
+		validationErrors = append(validationErrors, validationError)
+	}
+	if len(validationErrors) > 0 {
+		return common.NewResultOnFailure[user.UserSuspension](domain.NewValidationErrors(validationErrors))
+	}
+
+	return common.NewResultOnSuccess[user.UserSuspension](userSuspension)
+}
+
+// validatePersonalAccessTokenCreate validates the name, the scopes and the optional expiration of a personal access token.
+// Every scope must be a permission granted to the role of the user, duplicated scopes are removed.
+func validatePersonalAccessTokenCreate(logger interfaces.Logger, personalAccessTokenCreate user.PersonalAccessTokenCreate, role string) common.Result[user.PersonalAccessTokenCreate] {
+	validationErrors := make([]error, 0, 3)
+
+	personalAccessTokenCreate.Name = commonUtility.SanitizeAndCollapseWhitespace(personalAccessTokenCreate.Name)
+	nameValidator := utility.NewStringValidator(tokenNameField, personalAccessTokenCreate.Name, tokenNameRegex, constants.DefaultMinStringLength, constants.DefaultMaxStringLength, false)
+	validationErrors = utility.ValidateField(logger, location+"validatePersonalAccessTokenCreate", nameValidator, validationErrors)
+
+	scopes := make([]string, 0, len(personalAccessTokenCreate.Scopes))
+	for _, scope := range personalAccessTokenCreate.Scopes {
+		scope = commonUtility.SanitizeAndToLowerString(scope)
+		if validator.IsSliceContains(scopes, scope) {
+			continue
+		}
+		if !policy.HasPermission(role, scope) {
+			validationError := domain.NewValidationError(location+"validatePersonalAccessTokenCreate.HasPermission", scopesField, constants.FieldRequired, fmt.Sprintf(scopeNotGranted, scope))
+			logger.Debug(validationError)
+			validationErrors = append(validationErrors, validationError)
+			continue
+		}
+		scopes = append(scopes, scope)
+	}
+	if len(personalAccessTokenCreate.Scopes) == 0 {
+		validationError := domain.NewValidationError(location+"validatePersonalAccessTokenCreate.Scopes", scopesField, constants.FieldRequired, scopesRequired)
+		logger.Debug(validationError)
+		validationErrors = append(validationErrors, validationError)
+	}
+	personalAccessTokenCreate.Scopes = scopes
+
+	if !personalAccessTokenCreate.ExpiresAt.IsZero() && validator.IsTimeNotValid(personalAccessTokenCreate.ExpiresAt) {
+		validationError := domain.NewValidationError(location+"validatePersonalAccessTokenCreate.ExpiresAt", expiresAtField, constants.FieldOptional, invalidExpiresAt)
+		logger.Debug(validationError)
+		validationErrors = append(validationErrors, validationError)
+	}
+	if len(validationErrors) > 0 {
+		return common.NewResultOnFailure[user.PersonalAccessTokenCreate](domain.NewValidationErrors(validationErrors))
+	}
+
+	return common.NewResultOnSuccess[user.PersonalAccessTokenCreate](personalAccessTokenCreate)
+}
+
+// validateInvitationCreate validates a new invitation, it can be used once and expires after a week by default.
+func validateInvitationCreate(logger interfaces.Logger, invitationCreate user.InvitationCreate) common.Result[user.InvitationCreate] {
+	validationErrors := make([]error, 0, 2)
+
+	if invitationCreate.MaxUses == 0 {
+		invitationCreate.MaxUses = 1
+	}
+	if invitationCreate.MaxUses < 1 || invitationCreate.MaxUses > maxInvitationUses {
+		validationError := domain.NewValidationError(location+"validateInvitationCreate.MaxUses", maxUsesField, constants.FieldOptional, fmt.Sprintf(invalidMaxUses, maxInvitationUses))
+		logger.Debug(validationError)
+		validationErrors = append(validationErrors, validationError)
+	}
+
+	if invitationCreate.ExpiresAt.IsZero() {
+		invitationCreate.ExpiresAt = time.Now().Add(constants.InvitationExpirationTime)
+	}
+	if validator.IsTimeNotValid(invitationCreate.ExpiresAt) {
+		validationError := domain.NewValidationError(location+"validateInvitationCreate.ExpiresAt", expiresAtField, constants.FieldOptional, invalidExpiresAt)
+		logger.Debug(validationError)
+		validationErrors = append(validationErrors, validationError)
+	}
+	if len(validationErrors) > 0 {
+		return common.NewResultOnFailure[user.InvitationCreate](domain.NewValidationErrors(validationErrors))
+	}
+
+	return common.NewResultOnSuccess[user.InvitationCreate](invitationCreate)
+}
+
+// validateOAuthLogin checks the callback of a provider, the code is opaque, while the state is the one we generated.
+func validateOAuthLogin(logger interfaces.Logger, oauthLogin user.OAuthLogin) common.Result[user.OAuthLogin] {
+	validationErrors := make([]error, 0, 2)
+
+	codeValidator := utility.NewStringValidator(oauthCodeField, oauthLogin.Code, oauthCodeRegex, 1, maxOAuthCodeLength, false)
+	stateValidator := utility.NewStringValidator(oauthStateField, oauthLogin.State, oauthStateRegex, 1, constants.DefaultMaxStringLength, false)
+
+	validationErrors = utility.ValidateField(logger, location+"validateOAuthLogin", codeValidator, validationErrors)
+	validationErrors = utility.ValidateField(logger, location+"validateOAuthLogin", stateValidator, validationErrors)
+	if len(validationErrors) > 0 {
+		return common.NewResultOnFailure[user.OAuthLogin](domain.NewValidationErrors(validationErrors))
+	}
+
+	return common.NewResultOnSuccess[user.OAuthLogin](oauthLogin)
+}
+
+// validateOAuthIdentity accepts the identity of a provider only with a verified email address,
+// the email links the identity to an existing user, so an unverified one could take over the account.
+func validateOAuthIdentity(logger interfaces.Logger, oauthIdentity user.OAuthIdentity) common.Result[user.OAuthIdentity] {
+	validationErrors := make([]error, 0, 1)
+
+	oauthIdentity.Email = commonUtility.SanitizeAndToLowerString(oauthIdentity.Email)
+	emailValidator := utility.NewStringValidator(EmailField, oauthIdentity.Email, emailRegex, constants.DefaultMinStringLength, constants.DefaultMaxStringLength, false)
+	emailValidator.Notification = emailAllowedCharacters
+	validationErrors = utility.ValidateField(logger, location+"validateOAuthIdentity", emailValidator, validationErrors)
+	if len(validationErrors) == 0 && !oauthIdentity.EmailVerified {
+		validationError := domain.NewValidationError(
+			location+"validateOAuthIdentity.EmailVerified",
+			EmailField,
+			constants.FieldRequired,
+			fmt.Sprintf(oauthEmailNotVerified, oauthIdentity.Provider),
+		)
+		logger.Debug(validationError)
+		validationErrors = append(validationErrors, validationError)
+	}
+	if len(validationErrors) > 0 {
+		return common.NewResultOnFailure[user.OAuthIdentity](domain.NewValidationErrors(validationErrors))
+	}
+
+	return common.NewResultOnSuccess[user.OAuthIdentity](oauthIdentity)
+}
+
+// validateUserTwoFactorCode validates the TOTP code, or the recovery code where it is accepted instead.
+func validateUserTwoFactorCode(logger interfaces.Logger, userTwoFactorCode user.UserTwoFactorCode, recoveryCodeAllowed bool) common.Result[user.UserTwoFactorCode] {
+	validationErrors := make([]error, 0, 1)
+
+	userTwoFactorCode.Code = strings.TrimSpace(userTwoFactorCode.Code)
+	userTwoFactorCode.RecoveryCode = strings.TrimSpace(userTwoFactorCode.RecoveryCode)
+	if !recoveryCodeAllowed {
+		userTwoFactorCode.RecoveryCode = ""
+	}
+
+	validationErrors = validateTwoFactorCodes(logger, location+"validateUserTwoFactorCode", userTwoFactorCode.Code, userTwoFactorCode.RecoveryCode, validationErrors)
+	if len(validationErrors) > 0 {
+		return common.NewResultOnFailure[user.UserTwoFactorCode](domain.NewValidationErrors(validationErrors))
+	}
+
+	return common.NewResultOnSuccess[user.UserTwoFactorCode](userTwoFactorCode)
+}
+
+func validateUserTwoFactorDisable(logger interfaces.Logger, passwordPolicy config.PasswordPolicy, userTwoFactorDisable user.UserTwoFactorDisable) common.Result[user.UserTwoFactorDisable] {
+	validationErrors := make([]error, 0, 2)
+
+	userTwoFactorDisable.CurrentPassword = strings.TrimSpace(userTwoFactorDisable.CurrentPassword)
+	userTwoFactorDisable.Code = strings.TrimSpace(userTwoFactorDisable.Code)
+	userTwoFactorDisable.RecoveryCode = strings.TrimSpace(userTwoFactorDisable.RecoveryCode)
+
+	validationErrors = validateCurrentPassword(logger, location+"validateUserTwoFactorDisable", currentPasswordField, passwordPolicy, userTwoFactorDisable.CurrentPassword, validationErrors)
+	validationErrors = validateTwoFactorCodes(logger, location+"validateUserTwoFactorDisable", userTwoFactorDisable.Code, userTwoFactorDisable.RecoveryCode, validationErrors)
+	if len(validationErrors) > 0 {
+		return common.NewResultOnFailure[user.UserTwoFactorDisable](domain.NewValidationErrors(validationErrors))
+	}
+
+	return common.NewResultOnSuccess[user.UserTwoFactorDisable](userTwoFactorDisable)
+}
+
+func validateUserTwoFactorLogin(logger interfaces.Logger, userTwoFactorLogin user.UserTwoFactorLogin) common.Result[user.UserTwoFactorLogin] {
+	validationErrors := make([]error, 0, 2)
+
+	userTwoFactorLogin.MFAToken = strings.TrimSpace(userTwoFactorLogin.MFAToken)
+	userTwoFactorLogin.Code = strings.TrimSpace(userTwoFactorLogin.Code)
+	userTwoFactorLogin.RecoveryCode = strings.TrimSpace(userTwoFactorLogin.RecoveryCode)
+	if !mfaTokenRegex.MatchString(userTwoFactorLogin.MFAToken) {
+		validationError := domain.NewValidationError(location+"validateUserTwoFactorLogin.MFAToken", mfaTokenField, constants.FieldRequired, constants.InvalidTokenErrorMessage)
+		logger.Debug(validationError)
+		validationErrors = append(validationErrors, validationError)
+	}
+
+	validationErrors = validateTwoFactorCodes(logger, location+"validateUserTwoFactorLogin", userTwoFactorLogin.Code, userTwoFactorLogin.RecoveryCode, validationErrors)
+	if len(validationErrors) > 0 {
+		return common.NewResultOnFailure[user.UserTwoFactorLogin](domain.NewValidationErrors(validationErrors))
+	}
+
+	return common.NewResultOnSuccess[user.UserTwoFactorLogin](userTwoFactorLogin)
+}
+
+// validateTwoFactorCodes checks that exactly one of the TOTP code and the recovery code is provided and well-formed.
+func validateTwoFactorCodes(logger interfaces.Logger, location, code, recoveryCode string, validationErrors []error) []error {
+	var validationError domain.ValidationError
+	switch {
+	case validator.IsValueEmpty(code) == validator.IsValueEmpty(recoveryCode):
+		validationError = domain.NewValidationError(location+".validateTwoFactorCodes", twoFactorCodeField, constants.FieldRequired, codeOrRecoveryCode)
+	case validator.IsValueNotEmpty(code) && !twoFactorCodeRegex.MatchString(code):
+		validationError = domain.NewValidationError(location+".validateTwoFactorCodes", twoFactorCodeField, constants.FieldRequired, twoFactorCodeAllowed)
+	case validator.IsValueNotEmpty(recoveryCode) && !recoveryCodeRegex.MatchString(recoveryCode):
+		validationError = domain.NewValidationError(location+".validateTwoFactorCodes", recoveryCodeField, constants.FieldRequired, recoveryCodeAllowed)
+	default:
+		return validationErrors
+	}
+
+	logger.Debug(validationError)
+	return append(validationErrors, validationError)
+}
+
+func validateRole(logger interfaces.Logger, location, role, fieldType string, validationErrors []error) []error {
+	if policy.IsRoleValid(role) {
+		return validationErrors
+	}
+
+	validationError := domain.NewValidationError(location+".validateRole", roleField, fieldType, invalidRole)
+	logger.Debug(validationError)
+	return append(validationErrors, validationError)
+}
@@ -0,0 +1,41 @@
+package utility
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	DefaultHandle = "user"
+)
+
+// NormalizeHandle derives the handle from the username, the handle identifies the user in profile URLs.
+// The username is lowercased, whitespace becomes a hyphen and other characters than letters, digits,
+// hyphens and underscores are dropped, so usernames that only differ in case or spacing share a handle.
+func NormalizeHandle(username string) string {
+	var builder strings.Builder
+	for _, character := range strings.Join(strings.Fields(strings.ToLower(username)), "-") {
+		if isHandleCharacter(character) {
+			builder.WriteRune(character)
+		}
+	}
+
+	return builder.String()
+}
+
+// HandleCandidate returns the handle for the attempt to find a free one, the first attempt is the handle itself
+// and the following ones get a numbered suffix. A username without a single usable character falls back to DefaultHandle.
+func HandleCandidate(handle string, attempt int) string {
+	if handle == "" {
+		handle = DefaultHandle
+	}
+	if attempt <= 1 {
+		return handle
+	}
+
+	return handle + "-" + strconv.Itoa(attempt)
+}
+
+func isHandleCharacter(character rune) bool {
+	return (character >= 'a' && character <= 'z') || (character >= '0' && character <= '9') || character == '-' || character == '_'
+}
@@ -0,0 +1,222 @@
+package utility
+
+import (
+	"crypto"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	keyIDHeader       = "kid"
+	signatureKeyUse   = "sig"
+	keyPairMismatch   = "the private key of %s does not match its public key"
+	duplicateKeyID    = "the key id %s is used by more than one key"
+	unknownKeyID      = "unknown key id: %s"
+	retiredKeyExpired = "the retired key %s is no longer accepted"
+)
+
+// KeyRings holds the key rings of the access and the refresh tokens.
+type KeyRings struct {
+	AccessToken  KeyRing
+	RefreshToken KeyRing
+}
+
+// KeyRing holds the parsed keys of a token type and the claims its tokens are issued with. Tokens are signed
+// with the active key and carry its id in the kid header, retired keys only verify the tokens signed before
+// the rotation until they expire.
+type KeyRing struct {
+	ActiveKeyID string
+	Keys        map[string]SigningKey
+	Claims      TokenClaims
+}
+
+// SigningKey is a parsed key of the key ring, pinned to the algorithm it signs with.
+// Retired keys have no private key and a ValidUntil date.
+type SigningKey struct {
+	KeyID      string
+	Algorithm  string
+	PrivateKey crypto.Signer
+	PublicKey  crypto.PublicKey
+	ValidUntil time.Time
+}
+
+func NewKeyRings(accessToken, refreshToken KeyRing) KeyRings {
+	return KeyRings{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}
+}
+
+// NewKeyRing parses the base64 encoded PEM keys once, so they don't have to be parsed for every token.
+// The keys use the RS256 algorithm unless configured otherwise, retired keys default to the algorithm of the ring.
+// A key without an id gets the RFC 7638 thumbprint of its public key as id.
+func NewKeyRing(logger interfaces.Logger, location, algorithm, keyID, privateKey, publicKey string, retiredKeys []config.RetiredKey, claims TokenClaims) common.Result[KeyRing] {
+	if algorithm == "" {
+		algorithm = constants.DefaultSigningAlgorithm
+	}
+
+	activeKey := parseSigningKey(logger, location+".NewKeyRing", algorithm, keyID, privateKey, publicKey)
+	if validator.IsError(activeKey.Error) {
+		return common.NewResultOnFailure[KeyRing](activeKey.Error)
+	}
+
+	keyRing := KeyRing{
+		ActiveKeyID: activeKey.Data.KeyID,
+		Keys:        map[string]SigningKey{activeKey.Data.KeyID: activeKey.Data},
+		Claims:      claims,
+	}
+
+	for _, retiredKey := range retiredKeys {
+		if retiredKey.Algorithm == "" {
+			retiredKey.Algorithm = algorithm
+		}
+
+		parsedRetiredKey := parseRetiredKey(logger, location+".NewKeyRing", retiredKey)
+		if validator.IsError(parsedRetiredKey.Error) {
+			return common.NewResultOnFailure[KeyRing](parsedRetiredKey.Error)
+		}
+
+		_, exists := keyRing.Keys[parsedRetiredKey.Data.KeyID]
+		if exists {
+			internalError := domain.NewInternalError(location+".NewKeyRing.exists", fmt.Sprintf(duplicateKeyID, parsedRetiredKey.Data.KeyID))
+			logger.Error(internalError)
+			return common.NewResultOnFailure[KeyRing](internalError)
+		}
+
+		keyRing.Keys[parsedRetiredKey.Data.KeyID] = parsedRetiredKey.Data
+	}
+
+	return common.NewResultOnSuccess[KeyRing](keyRing)
+}
+
+// GenerateJSONWebKeySet returns the public keys of the key ring that still verify tokens,
+// the active key comes first and the retired keys follow in the order of their ids.
+func GenerateJSONWebKeySet(keyRing KeyRing) user.JSONWebKeySet {
+	keyIDs := make([]string, 0, len(keyRing.Keys))
+	for keyID, signingKey := range keyRing.Keys {
+		if keyID != keyRing.ActiveKeyID && !isRetiredKeyExpired(signingKey) {
+			keyIDs = append(keyIDs, keyID)
+		}
+	}
+	sort.Strings(keyIDs)
+	keyIDs = append([]string{keyRing.ActiveKeyID}, keyIDs...)
+
+	keys := make([]user.JSONWebKey, len(keyIDs))
+	for index, keyID := range keyIDs {
+		signingKey := keyRing.Keys[keyID]
+		keys[index] = newJSONWebKey(keyID, signingKey.Algorithm, signingKey.PublicKey)
+	}
+
+	return user.NewJSONWebKeySet(keys)
+}
+
+func parseSigningKey(logger interfaces.Logger, location, algorithm, keyID, privateKey, publicKey string) common.Result[SigningKey] {
+	decodedPrivateKey := decodeBase64String(logger, location+".parseSigningKey", privateKey)
+	if validator.IsError(decodedPrivateKey.Error) {
+		return common.NewResultOnFailure[SigningKey](decodedPrivateKey.Error)
+	}
+
+	parsedPrivateKey := parsePrivateKey(logger, location+".parseSigningKey", algorithm, decodedPrivateKey.Data)
+	if validator.IsError(parsedPrivateKey.Error) {
+		return common.NewResultOnFailure[SigningKey](parsedPrivateKey.Error)
+	}
+
+	parsedPublicKey := decodePublicKey(logger, location+".parseSigningKey", algorithm, publicKey)
+	if validator.IsError(parsedPublicKey.Error) {
+		return common.NewResultOnFailure[SigningKey](parsedPublicKey.Error)
+	}
+
+	if keyID == "" {
+		keyID = generateKeyThumbprint(parsedPublicKey.Data)
+	}
+
+	// A mismatching pair would issue tokens that the application itself rejects.
+	if !parsedPrivateKey.Data.Public().(publicKeyComparer).Equal(parsedPublicKey.Data) {
+		internalError := domain.NewInternalError(location+".parseSigningKey.Equal", fmt.Sprintf(keyPairMismatch, keyID))
+		logger.Error(internalError)
+		return common.NewResultOnFailure[SigningKey](internalError)
+	}
+
+	return common.NewResultOnSuccess[SigningKey](SigningKey{
+		KeyID:      keyID,
+		Algorithm:  algorithm,
+		PrivateKey: parsedPrivateKey.Data,
+		PublicKey:  parsedPublicKey.Data,
+	})
+}
+
+func parseRetiredKey(logger interfaces.Logger, location string, retiredKey config.RetiredKey) common.Result[SigningKey] {
+	parsedPublicKey := decodePublicKey(logger, location+".parseRetiredKey", retiredKey.Algorithm, retiredKey.PublicKey)
+	if validator.IsError(parsedPublicKey.Error) {
+		return common.NewResultOnFailure[SigningKey](parsedPublicKey.Error)
+	}
+
+	keyID := retiredKey.KeyID
+	if keyID == "" {
+		keyID = generateKeyThumbprint(parsedPublicKey.Data)
+	}
+
+	return common.NewResultOnSuccess[SigningKey](SigningKey{
+		KeyID:      keyID,
+		Algorithm:  retiredKey.Algorithm,
+		PublicKey:  parsedPublicKey.Data,
+		ValidUntil: retiredKey.ValidUntil,
+	})
+}
+
+func decodePublicKey(logger interfaces.Logger, location, algorithm, publicKey string) common.Result[crypto.PublicKey] {
+	decodedPublicKey := decodeBase64String(logger, location+".decodePublicKey", publicKey)
+	if validator.IsError(decodedPublicKey.Error) {
+		return common.NewResultOnFailure[crypto.PublicKey](decodedPublicKey.Error)
+	}
+
+	return parsePublicKey(logger, location+".decodePublicKey", algorithm, decodedPublicKey.Data)
+}
+
+// verificationKey looks up the key named by the kid header of the token. Tokens without the header
+// were signed before the key ring was introduced and are verified with the active key.
+// The algorithm of the token has to be the one the key is pinned to, so a token can't pick how it is verified.
+func verificationKey(logger interfaces.Logger, location string, keyRing KeyRing, token *jwt.Token) common.Result[crypto.PublicKey] {
+	keyID, ok := token.Header[keyIDHeader].(string)
+	if !ok {
+		keyID = keyRing.ActiveKeyID
+	}
+
+	signingKey, exists := keyRing.Keys[keyID]
+	if !exists {
+		internalError := domain.NewInternalError(location+".verificationKey.exists", fmt.Sprintf(unknownKeyID, keyID))
+		logger.Error(internalError)
+		return common.NewResultOnFailure[crypto.PublicKey](internalError)
+	}
+	if token.Method.Alg() != signingKey.Algorithm {
+		internalError := domain.NewInternalError(location+".verificationKey.Alg", fmt.Sprintf(unexpectedMethod, token.Method.Alg()))
+		logger.Error(internalError)
+		return common.NewResultOnFailure[crypto.PublicKey](internalError)
+	}
+	if isRetiredKeyExpired(signingKey) {
+		internalError := domain.NewInternalError(location+".verificationKey.isRetiredKeyExpired", fmt.Sprintf(retiredKeyExpired, keyID))
+		logger.Error(internalError)
+		return common.NewResultOnFailure[crypto.PublicKey](internalError)
+	}
+
+	return common.NewResultOnSuccess[crypto.PublicKey](signingKey.PublicKey)
+}
+
+func isRetiredKeyExpired(signingKey SigningKey) bool {
+	return !signingKey.ValidUntil.IsZero() && validator.IsTimeNotValid(signingKey.ValidUntil)
+}
+
+func encodeBase64URL(value []byte) string {
+	return base64.RawURLEncoding.EncodeToString(value)
+}
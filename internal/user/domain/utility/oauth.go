@@ -0,0 +1,17 @@
+package utility
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+const (
+	CodeChallengeMethod = "S256"
+)
+
+// CodeChallenge derives the S256 PKCE code challenge from the code verifier (RFC 7636),
+// the provider only issues tokens to the client that presents the verifier.
+func CodeChallenge(codeVerifier string) string {
+	hash := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
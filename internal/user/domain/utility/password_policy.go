@@ -0,0 +1,97 @@
+package utility
+
+import (
+	"crypto/sha1" //nolint:gosec // SHA-1 is the hash of the breached passwords lists, it is not used for storage.
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+)
+
+const (
+	lowercaseRequired = "Must contain at least one lowercase letter."
+	uppercaseRequired = "Must contain at least one uppercase letter."
+	digitRequired     = "Must contain at least one digit."
+	symbolRequired    = "Must contain at least one symbol."
+
+	// HashPrefixLength is the number of characters of the SHA-1 hash used to look up breached passwords,
+	// the rest of the hash is compared by the caller, so the password itself is never revealed (k-anonymity).
+	HashPrefixLength = 5
+
+	// minPersonalDataLength skips short parts of the personal data, they match too many passwords by chance.
+	minPersonalDataLength = 4
+)
+
+// CheckPasswordPolicy checks the length and the character classes of a new password
+// and returns the notification of every rule of the password policy it breaks.
+func CheckPasswordPolicy(passwordPolicy config.PasswordPolicy, password string) []string {
+	notifications := make([]string, 0, 5)
+
+	length := utf8.RuneCountInString(password)
+	if length < passwordPolicy.MinLength || length > passwordPolicy.MaxLength {
+		notifications = append(notifications, fmt.Sprintf(constants.StringAllowedLength, passwordPolicy.MinLength, passwordPolicy.MaxLength))
+	}
+
+	var hasLowercase, hasUppercase, hasDigit, hasSymbol bool
+	for _, character := range password {
+		switch {
+		case unicode.IsLower(character):
+			hasLowercase = true
+		case unicode.IsUpper(character):
+			hasUppercase = true
+		case unicode.IsDigit(character):
+			hasDigit = true
+		case unicode.IsPunct(character) || unicode.IsSymbol(character):
+			hasSymbol = true
+		}
+	}
+
+	if passwordPolicy.RequireLowercase && !hasLowercase {
+		notifications = append(notifications, lowercaseRequired)
+	}
+	if passwordPolicy.RequireUppercase && !hasUppercase {
+		notifications = append(notifications, uppercaseRequired)
+	}
+	if passwordPolicy.RequireDigit && !hasDigit {
+		notifications = append(notifications, digitRequired)
+	}
+	if passwordPolicy.RequireSymbol && !hasSymbol {
+		notifications = append(notifications, symbolRequired)
+	}
+
+	return notifications
+}
+
+// ContainsPersonalData reports whether the password contains the email or the username, ignoring case.
+// The local part of the email and every word of the username are checked on their own as well.
+func ContainsPersonalData(password, email, username string) bool {
+	password = strings.ToLower(password)
+	personalData := []string{email, username}
+	localPart, _, _ := strings.Cut(email, "@")
+	personalData = append(personalData, localPart)
+	personalData = append(personalData, strings.Fields(username)...)
+
+	for _, value := range personalData {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if utf8.RuneCountInString(value) < minPersonalDataLength {
+			continue
+		}
+		if strings.Contains(password, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SplitPasswordHash hashes the password with SHA-1 and splits the uppercase hex hash into
+// the prefix used to look up breached passwords and the suffix compared with the results.
+func SplitPasswordHash(password string) (string, string) {
+	hash := sha1.Sum([]byte(password)) //nolint:gosec // See the import.
+	hexHash := strings.ToUpper(hex.EncodeToString(hash[:]))
+	return hexHash[:HashPrefixLength], hexHash[HashPrefixLength:]
+}
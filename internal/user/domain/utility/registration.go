@@ -0,0 +1,23 @@
+package utility
+
+import (
+	"strings"
+)
+
+// IsEmailDomainAllowed reports whether the domain of the email is one of the allowed domains, ignoring case.
+// Subdomains have to be allowed on their own, so an allowed example.com doesn't let mail.example.com register.
+func IsEmailDomainAllowed(allowedDomains []string, email string) bool {
+	separatorIndex := strings.LastIndex(email, "@")
+	if separatorIndex < 0 {
+		return false
+	}
+
+	emailDomain := strings.ToLower(email[separatorIndex+1:])
+	for _, allowedDomain := range allowedDomains {
+		if strings.ToLower(strings.TrimSpace(allowedDomain)) == emailDomain {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,188 @@
+package utility
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+
+	"github.com/golang-jwt/jwt"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	rsaKeyType           = "RSA"
+	ecKeyType            = "EC"
+	okpKeyType           = "OKP"
+	p256Curve            = "P-256"
+	ed25519Curve         = "Ed25519"
+	p256CoordinateSize   = 32
+	rsaThumbprintFormat  = `{"e":"%s","kty":"RSA","n":"%s"}`
+	ecThumbprintFormat   = `{"crv":"%s","kty":"EC","x":"%s","y":"%s"}`
+	okpThumbprintFormat  = `{"crv":"%s","kty":"OKP","x":"%s"}`
+	privateKeyPEMType    = "PRIVATE KEY"
+	publicKeyPEMType     = "PUBLIC KEY"
+	unsupportedAlgorithm = "unsupported signing algorithm: %s"
+	invalidAlgorithmKey  = "the key is not a valid %s key"
+)
+
+// KeyPair is a PEM encoded key pair, the private key in PKCS #8 and the public key in PKIX form.
+type KeyPair struct {
+	PrivateKey []byte
+	PublicKey  []byte
+}
+
+// publicKeyComparer is implemented by the public keys of all the supported algorithms.
+type publicKeyComparer interface {
+	Equal(crypto.PublicKey) bool
+}
+
+// GenerateKeyPair generates a key pair for the signing algorithm in the same form the configuration expects
+// before the base64 encoding. The RSA key size is ignored by the other algorithms.
+func GenerateKeyPair(logger interfaces.Logger, location, algorithm string, rsaKeySize int) common.Result[KeyPair] {
+	var privateKey crypto.Signer
+	var generateKeyError error
+	switch algorithm {
+	case constants.RS256:
+		privateKey, generateKeyError = rsa.GenerateKey(rand.Reader, rsaKeySize)
+	case constants.ES256:
+		privateKey, generateKeyError = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case constants.EdDSA:
+		_, privateKey, generateKeyError = ed25519.GenerateKey(rand.Reader)
+	default:
+		internalError := domain.NewInternalError(location+".GenerateKeyPair.default", fmt.Sprintf(unsupportedAlgorithm, algorithm))
+		logger.Error(internalError)
+		return common.NewResultOnFailure[KeyPair](internalError)
+	}
+	if validator.IsError(generateKeyError) {
+		internalError := domain.NewInternalError(location+".GenerateKeyPair.GenerateKey", generateKeyError.Error())
+		logger.Error(internalError)
+		return common.NewResultOnFailure[KeyPair](internalError)
+	}
+
+	encodedPrivateKey, marshalPrivateKeyError := x509.MarshalPKCS8PrivateKey(privateKey)
+	if validator.IsError(marshalPrivateKeyError) {
+		internalError := domain.NewInternalError(location+".GenerateKeyPair.MarshalPKCS8PrivateKey", marshalPrivateKeyError.Error())
+		logger.Error(internalError)
+		return common.NewResultOnFailure[KeyPair](internalError)
+	}
+
+	encodedPublicKey, marshalPublicKeyError := x509.MarshalPKIXPublicKey(privateKey.Public())
+	if validator.IsError(marshalPublicKeyError) {
+		internalError := domain.NewInternalError(location+".GenerateKeyPair.MarshalPKIXPublicKey", marshalPublicKeyError.Error())
+		logger.Error(internalError)
+		return common.NewResultOnFailure[KeyPair](internalError)
+	}
+
+	return common.NewResultOnSuccess[KeyPair](KeyPair{
+		PrivateKey: pem.EncodeToMemory(&pem.Block{Type: privateKeyPEMType, Bytes: encodedPrivateKey}),
+		PublicKey:  pem.EncodeToMemory(&pem.Block{Type: publicKeyPEMType, Bytes: encodedPublicKey}),
+	})
+}
+
+// parsePrivateKey parses the PEM private key of the signing algorithm from the provided byte slice.
+func parsePrivateKey(logger interfaces.Logger, location, algorithm string, decodedPrivateKey []byte) common.Result[crypto.Signer] {
+	var key crypto.Signer
+	var keyError error
+	switch algorithm {
+	case constants.RS256:
+		key, keyError = jwt.ParseRSAPrivateKeyFromPEM(decodedPrivateKey)
+	case constants.ES256:
+		var ecdsaKey *ecdsa.PrivateKey
+		ecdsaKey, keyError = jwt.ParseECPrivateKeyFromPEM(decodedPrivateKey)
+		if ecdsaKey != nil && ecdsaKey.Curve != elliptic.P256() {
+			keyError = fmt.Errorf(invalidAlgorithmKey, algorithm)
+		}
+		key = ecdsaKey
+	case constants.EdDSA:
+		var ed25519Key crypto.PrivateKey
+		ed25519Key, keyError = jwt.ParseEdPrivateKeyFromPEM(decodedPrivateKey)
+		key, _ = ed25519Key.(ed25519.PrivateKey)
+	default:
+		keyError = fmt.Errorf(unsupportedAlgorithm, algorithm)
+	}
+
+	if validator.IsError(keyError) {
+		internalError := domain.NewInternalError(location+".parsePrivateKey.ParsePrivateKeyFromPEM", keyError.Error())
+		logger.Error(internalError)
+		return common.NewResultOnFailure[crypto.Signer](internalError)
+	}
+
+	return common.NewResultOnSuccess[crypto.Signer](key)
+}
+
+// parsePublicKey parses the PEM public key of the signing algorithm from the provided byte slice.
+func parsePublicKey(logger interfaces.Logger, location, algorithm string, decodedPublicKey []byte) common.Result[crypto.PublicKey] {
+	var key crypto.PublicKey
+	var keyError error
+	switch algorithm {
+	case constants.RS256:
+		key, keyError = jwt.ParseRSAPublicKeyFromPEM(decodedPublicKey)
+	case constants.ES256:
+		var ecdsaKey *ecdsa.PublicKey
+		ecdsaKey, keyError = jwt.ParseECPublicKeyFromPEM(decodedPublicKey)
+		if ecdsaKey != nil && ecdsaKey.Curve != elliptic.P256() {
+			keyError = fmt.Errorf(invalidAlgorithmKey, algorithm)
+		}
+		key = ecdsaKey
+	case constants.EdDSA:
+		key, keyError = jwt.ParseEdPublicKeyFromPEM(decodedPublicKey)
+	default:
+		keyError = fmt.Errorf(unsupportedAlgorithm, algorithm)
+	}
+
+	if validator.IsError(keyError) {
+		internalError := domain.NewInternalError(location+".parsePublicKey.ParsePublicKeyFromPEM", keyError.Error())
+		logger.Error(internalError)
+		return common.NewResultOnFailure[crypto.PublicKey](internalError)
+	}
+
+	return common.NewResultOnSuccess[crypto.PublicKey](key)
+}
+
+// newJSONWebKey describes the public key in the JWK form of its key type (RFC 7518, RFC 8037).
+func newJSONWebKey(keyID, algorithm string, publicKey crypto.PublicKey) user.JSONWebKey {
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		x := encodeBase64URL(key.X.FillBytes(make([]byte, p256CoordinateSize)))
+		y := encodeBase64URL(key.Y.FillBytes(make([]byte, p256CoordinateSize)))
+		return user.NewJSONWebKey(ecKeyType, keyID, signatureKeyUse, algorithm, p256Curve, "", "", x, y)
+	case ed25519.PublicKey:
+		return user.NewJSONWebKey(okpKeyType, keyID, signatureKeyUse, algorithm, ed25519Curve, "", "", encodeBase64URL(key), "")
+	default:
+		rsaKey := publicKey.(*rsa.PublicKey)
+		modulus := encodeBase64URL(rsaKey.N.Bytes())
+		exponent := encodeBase64URL(big.NewInt(int64(rsaKey.E)).Bytes())
+		return user.NewJSONWebKey(rsaKeyType, keyID, signatureKeyUse, algorithm, "", modulus, exponent, "", "")
+	}
+}
+
+// generateKeyThumbprint computes the RFC 7638 thumbprint of the public key from its required JWK members.
+func generateKeyThumbprint(publicKey crypto.PublicKey) string {
+	jsonWebKey := newJSONWebKey("", "", publicKey)
+
+	var members string
+	switch jsonWebKey.KeyType {
+	case ecKeyType:
+		members = fmt.Sprintf(ecThumbprintFormat, jsonWebKey.Curve, jsonWebKey.X, jsonWebKey.Y)
+	case okpKeyType:
+		members = fmt.Sprintf(okpThumbprintFormat, jsonWebKey.Curve, jsonWebKey.X)
+	default:
+		members = fmt.Sprintf(rsaThumbprintFormat, jsonWebKey.Exponent, jsonWebKey.Modulus)
+	}
+
+	thumbprint := sha256.Sum256([]byte(members))
+	return encodeBase64URL(thumbprint[:])
+}
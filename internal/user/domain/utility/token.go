@@ -1,12 +1,12 @@
 package utility
 
 import (
-	"crypto/rsa"
 	"encoding/base64"
 	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
 	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
 	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
 	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
@@ -16,31 +16,44 @@ import (
 )
 
 const (
-	signingMethod    = "RS256"
 	userIDClaim      = "user_id"
 	userRoleClaim    = "user_role"
+	tokenTypeClaim   = "token_type"
+	tokenIDClaim     = "jti"
+	sessionIDClaim   = "sid"
+	issuerClaim      = "iss"
+	audienceClaim    = "aud"
 	expirationClaim  = "exp"
 	issuedAtClaim    = "iat"
 	notBeforeClaim   = "nbf"
 	unexpectedMethod = "unexpected method: %s"
+	invalidClaim     = "invalid token claim: %s"
 )
 
-// GenerateJWTToken generates a JWT token with the provided UserTokenPayload, using the given private key,
-// and sets the token's expiration based on the specified token lifetime.
-func GenerateJWTToken(logger interfaces.Logger, location, privateKey string, tokenLifeTime time.Duration, userTokenPayload user.UserTokenPayload) common.Result[string] {
-	decodedPrivateKey := decodeBase64String(logger, location+".GenerateJWTToken", privateKey)
-	if validator.IsError(decodedPrivateKey.Error) {
-		return common.NewResultOnFailure[string](decodedPrivateKey.Error)
-	}
+// TokenClaims holds the claims the tokens of a key ring are issued with and validated against.
+// The token type tells the access and refresh tokens apart, an empty issuer or audience is neither set nor checked.
+type TokenClaims struct {
+	TokenType string
+	Issuer    string
+	Audience  string
+	Leeway    time.Duration
+}
 
-	key := parsePrivateKey(logger, location+".GenerateJWTToken", decodedPrivateKey.Data)
-	if validator.IsError(key.Error) {
-		return common.NewResultOnFailure[string](key.Error)
+func NewTokenClaims(tokenType, issuer, audience string, leeway time.Duration) TokenClaims {
+	return TokenClaims{
+		TokenType: tokenType,
+		Issuer:    issuer,
+		Audience:  audience,
+		Leeway:    leeway,
 	}
+}
 
+// GenerateJWTToken generates a JWT token with the provided UserTokenPayload, signed with the active key of the key ring,
+// and sets the token's expiration based on the specified token lifetime.
+func GenerateJWTToken(logger interfaces.Logger, location string, keyRing KeyRing, tokenLifeTime time.Duration, userTokenPayload user.UserTokenPayload) common.Result[string] {
 	now := time.Now().UTC()
-	claims := generateClaims(tokenLifeTime, now, userTokenPayload)
-	token := createSignedToken(logger, location+".GenerateJWTToken", key.Data, claims)
+	claims := generateClaims(keyRing.Claims, tokenLifeTime, now, userTokenPayload)
+	token := createSignedToken(logger, location+".GenerateJWTToken", keyRing.Keys[keyRing.ActiveKeyID], claims)
 	if validator.IsError(token.Error) {
 		return common.NewResultOnFailure[string](token.Error)
 	}
@@ -48,20 +61,10 @@ func GenerateJWTToken(logger interfaces.Logger, location, privateKey string, tok
 	return common.NewResultOnSuccess[string](token.Data)
 }
 
-// ValidateJWTToken validates a JWT token using the provided public key and returns the claims
-// extracted from the token if it's valid.
-func ValidateJWTToken(logger interfaces.Logger, location, token, publicKey string) common.Result[user.UserTokenPayload] {
-	decodedPublicKey := decodeBase64String(logger, location+".ValidateJWTToken", publicKey)
-	if validator.IsError(decodedPublicKey.Error) {
-		return common.NewResultOnFailure[user.UserTokenPayload](decodedPublicKey.Error)
-	}
-
-	key := parsePublicKey(logger, location+".ValidateJWTToken", decodedPublicKey.Data)
-	if validator.IsError(key.Error) {
-		return common.NewResultOnFailure[user.UserTokenPayload](key.Error)
-	}
-
-	parsedToken := parseToken(logger, location+".ValidateJWTToken", token, key.Data)
+// ValidateJWTToken validates a JWT token with the key of the key ring that signed it, checks its claims
+// against the claims of the key ring and returns the claims extracted from the token if it's valid.
+func ValidateJWTToken(logger interfaces.Logger, location, token string, keyRing KeyRing) common.Result[user.UserTokenPayload] {
+	parsedToken := parseToken(logger, location+".ValidateJWTToken", token, keyRing)
 	if validator.IsError(parsedToken.Error) {
 		return common.NewResultOnFailure[user.UserTokenPayload](parsedToken.Error)
 	}
@@ -69,10 +72,17 @@ func ValidateJWTToken(logger interfaces.Logger, location, token, publicKey strin
 	// Extract and validate the claims from the parsed token.
 	claims, ok := parsedToken.Data.Claims.(jwt.MapClaims)
 	if ok && parsedToken.Data.Valid {
+		validateClaimsError := validateClaims(logger, location+".ValidateJWTToken", claims, keyRing.Claims)
+		if validator.IsError(validateClaimsError) {
+			return common.NewResultOnFailure[user.UserTokenPayload](validateClaimsError)
+		}
+
 		payload := user.NewUserTokenPayload(
 			fmt.Sprint(claims[userIDClaim]),
 			fmt.Sprint(claims[userRoleClaim]),
 		)
+		payload.TokenID = getStringClaim(claims, tokenIDClaim)
+		payload.SessionID = getStringClaim(claims, sessionIDClaim)
 
 		return common.NewResultOnSuccess[user.UserTokenPayload](payload)
 	}
@@ -94,68 +104,105 @@ func decodeBase64String(logger interfaces.Logger, location, base64String string)
 	return common.NewResultOnSuccess[[]byte](decodedString)
 }
 
-// generateClaims generates JWT claims with the specified token lifetime and UserTokenPayload.
-func generateClaims(tokenLifeTime time.Duration, now time.Time, userTokenPayload user.UserTokenPayload) jwt.MapClaims {
-	return jwt.MapClaims{
+// generateClaims generates JWT claims with the claims of the key ring, the specified token lifetime and UserTokenPayload.
+// Every token gets a unique jti, tokens bound to a persisted session use the id of the stored refresh token.
+func generateClaims(tokenClaims TokenClaims, tokenLifeTime time.Duration, now time.Time, userTokenPayload user.UserTokenPayload) jwt.MapClaims {
+	claims := jwt.MapClaims{
 		userIDClaim:     userTokenPayload.UserID,
 		userRoleClaim:   userTokenPayload.Role,
+		tokenTypeClaim:  tokenClaims.TokenType,
+		tokenIDClaim:    userTokenPayload.TokenID,
 		expirationClaim: now.Add(tokenLifeTime).Unix(),
 		issuedAtClaim:   now.Unix(),
 		notBeforeClaim:  now.Unix(),
 	}
+
+	if userTokenPayload.TokenID == "" {
+		claims[tokenIDClaim] = uuid.New().String()
+	}
+	if userTokenPayload.SessionID != "" {
+		claims[sessionIDClaim] = userTokenPayload.SessionID
+	}
+	if tokenClaims.Issuer != "" {
+		claims[issuerClaim] = tokenClaims.Issuer
+	}
+	if tokenClaims.Audience != "" {
+		claims[audienceClaim] = tokenClaims.Audience
+	}
+
+	return claims
 }
 
-// createSignedToken creates a signed JWT token using the provided private key and claims.
-func createSignedToken(logger interfaces.Logger, location string, key *rsa.PrivateKey, claims jwt.MapClaims) common.Result[string] {
-	token, tokenError := jwt.NewWithClaims(jwt.GetSigningMethod(signingMethod), claims).SignedString(key)
-	if validator.IsError(tokenError) {
-		internalError := domain.NewInternalError(location+".createSignedToken.NewWithClaims.SignedString", tokenError.Error())
-		logger.Error(internalError)
-		return common.NewResultOnFailure[string](internalError)
+// validateClaims checks the claims of a verified token against the claims of the key ring.
+// The leeway is applied to the time based claims to tolerate clock skew between servers.
+func validateClaims(logger interfaces.Logger, location string, claims jwt.MapClaims, tokenClaims TokenClaims) error {
+	now := time.Now().UTC()
+
+	var claim string
+	switch {
+	case !claims.VerifyExpiresAt(now.Add(-tokenClaims.Leeway).Unix(), true):
+		claim = expirationClaim
+	case !claims.VerifyNotBefore(now.Add(tokenClaims.Leeway).Unix(), false):
+		claim = notBeforeClaim
+	case !claims.VerifyIssuedAt(now.Add(tokenClaims.Leeway).Unix(), false):
+		claim = issuedAtClaim
+	case getStringClaim(claims, tokenIDClaim) == "":
+		claim = tokenIDClaim
+	case getStringClaim(claims, tokenTypeClaim) != tokenClaims.TokenType:
+		claim = tokenTypeClaim
+	case tokenClaims.Issuer != "" && !claims.VerifyIssuer(tokenClaims.Issuer, true):
+		claim = issuerClaim
+	case tokenClaims.Audience != "" && !claims.VerifyAudience(tokenClaims.Audience, true):
+		claim = audienceClaim
+	default:
+		return nil
 	}
 
-	return common.NewResultOnSuccess[string](token)
+	invalidTokenError := domain.NewInvalidTokenError(location+".validateClaims", fmt.Sprintf(invalidClaim, claim))
+	logger.Error(invalidTokenError)
+	return invalidTokenError
 }
 
-// parsePublicKey parses the RSA public key from the provided byte slice.
-func parsePublicKey(logger interfaces.Logger, location string, decodedPublicKey []byte) common.Result[*rsa.PublicKey] {
-	key, keyError := jwt.ParseRSAPublicKeyFromPEM(decodedPublicKey)
-	if validator.IsError(keyError) {
-		internalError := domain.NewInternalError(location+".parsePublicKey.ParseRSAPublicKeyFromPEM", keyError.Error())
-		logger.Error(internalError)
-		return common.NewResultOnFailure[*rsa.PublicKey](internalError)
+// getStringClaim returns the string value of the claim or an empty string if the claim is missing.
+func getStringClaim(claims jwt.MapClaims, claim string) string {
+	value, ok := claims[claim].(string)
+	if ok {
+		return value
 	}
 
-	return common.NewResultOnSuccess[*rsa.PublicKey](key)
+	return ""
 }
 
-// parsePrivateKey parses the RSA private key from the provided byte slice.
-func parsePrivateKey(logger interfaces.Logger, location string, decodedPrivateKey []byte) common.Result[*rsa.PrivateKey] {
-	key, keyError := jwt.ParseRSAPrivateKeyFromPEM(decodedPrivateKey)
-	if validator.IsError(keyError) {
-		internalError := domain.NewInternalError(location+".parsePrivateKey.ParseRSAPrivateKeyFromPEM", keyError.Error())
+// createSignedToken creates a signed JWT token using the provided signing key and claims,
+// the id of the key is set in the kid header so the token can be verified after a key rotation.
+func createSignedToken(logger interfaces.Logger, location string, signingKey SigningKey, claims jwt.MapClaims) common.Result[string] {
+	unsignedToken := jwt.NewWithClaims(jwt.GetSigningMethod(signingKey.Algorithm), claims)
+	unsignedToken.Header[keyIDHeader] = signingKey.KeyID
+	token, tokenError := unsignedToken.SignedString(signingKey.PrivateKey)
+	if validator.IsError(tokenError) {
+		internalError := domain.NewInternalError(location+".createSignedToken.NewWithClaims.SignedString", tokenError.Error())
 		logger.Error(internalError)
-		return common.NewResultOnFailure[*rsa.PrivateKey](internalError)
+		return common.NewResultOnFailure[string](internalError)
 	}
 
-	return common.NewResultOnSuccess[*rsa.PrivateKey](key)
+	return common.NewResultOnSuccess[string](token)
 }
 
-// parseToken parses and verifies the JWT token using the provided public key.
-func parseToken(logger interfaces.Logger, location, token string, key *rsa.PublicKey) common.Result[*jwt.Token] {
-	parsedToken, parseError := jwt.Parse(token, func(t *jwt.Token) (any, error) {
-		_, ok := t.Method.(*jwt.SigningMethodRSA)
-		if ok {
-			return key, nil
+// parseToken parses and verifies the JWT token using the public key of the key ring named by its kid header.
+// The claims are validated afterwards with the leeway of the key ring.
+func parseToken(logger interfaces.Logger, location, token string, keyRing KeyRing) common.Result[*jwt.Token] {
+	parser := jwt.Parser{SkipClaimsValidation: true}
+	parsedToken, parseError := parser.Parse(token, func(t *jwt.Token) (any, error) {
+		key := verificationKey(logger, location+".parseToken.Parse", keyRing, t)
+		if validator.IsError(key.Error) {
+			return nil, key.Error
 		}
 
-		internalError := domain.NewInternalError(location+".parseToken.jwt.Parse.Ok", unexpectedMethod+" t.Header[alg]")
-		logger.Error(internalError)
-		return nil, internalError
+		return key.Data, nil
 	})
 
 	if validator.IsError(parseError) {
-		internalError := domain.NewInternalError(location+"parseToken.jwt.Parse", parseError.Error())
+		internalError := domain.NewInternalError(location+".parseToken.Parse", parseError.Error())
 		logger.Error(internalError)
 		return common.NewResultOnFailure[*jwt.Token](internalError)
 	}
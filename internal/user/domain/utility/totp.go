@@ -0,0 +1,124 @@
+package utility
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+// TOTP parameters as defined by RFC 6238, they are the defaults supported by all authenticator apps.
+const (
+	totpSecretLength = 20
+	totpPeriod       = 30
+	totpDigits       = 6
+	totpSkew         = 1
+	totpAlgorithm    = "SHA1"
+	totpURIFormat    = "otpauth://totp/%s:%s?%s"
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret generates a random base32 encoded secret for a TOTP authenticator.
+func GenerateTOTPSecret(logger interfaces.Logger, location string) common.Result[string] {
+	secret := make([]byte, totpSecretLength)
+	_, readError := rand.Read(secret)
+	if validator.IsError(readError) {
+		internalError := domain.NewInternalError(location+".GenerateTOTPSecret.Read", readError.Error())
+		logger.Error(internalError)
+		return common.NewResultOnFailure[string](internalError)
+	}
+
+	return common.NewResultOnSuccess[string](totpEncoding.EncodeToString(secret))
+}
+
+// GenerateTOTPURI builds the otpauth URI that authenticator apps use to enroll the secret, usually shown as a QR code.
+func GenerateTOTPURI(issuer, accountName, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", totpAlgorithm)
+	query.Set("digits", fmt.Sprint(totpDigits))
+	query.Set("period", fmt.Sprint(totpPeriod))
+
+	return fmt.Sprintf(totpURIFormat, url.PathEscape(issuer), url.PathEscape(accountName), query.Encode())
+}
+
+// GenerateTOTPCode generates the code of the provided base32 encoded secret for the time step of the provided time.
+func GenerateTOTPCode(logger interfaces.Logger, location, secret string, at time.Time) common.Result[string] {
+	key := decodeTOTPSecret(logger, location+".GenerateTOTPCode", secret)
+	if validator.IsError(key.Error) {
+		return common.NewResultOnFailure[string](key.Error)
+	}
+
+	return common.NewResultOnSuccess[string](generateTOTPCode(key.Data, totpCounter(at)))
+}
+
+// ValidateTOTPCode checks the code against the provided base32 encoded secret and returns the time step it belongs to,
+// or 0 if the code is not valid. The codes of the previous and the next time steps are accepted as well to tolerate clock drift,
+// but not the steps up to the last used one, so a code that has already been accepted can't be replayed.
+func ValidateTOTPCode(logger interfaces.Logger, location, secret, code string, lastUsedStep int64, at time.Time) int64 {
+	key := decodeTOTPSecret(logger, location+".ValidateTOTPCode", secret)
+	if validator.IsError(key.Error) {
+		return 0
+	}
+
+	counter := int64(totpCounter(at))
+	for step := counter - totpSkew; step <= counter+totpSkew; step++ {
+		if step <= lastUsedStep {
+			continue
+		}
+		expectedCode := generateTOTPCode(key.Data, uint64(step))
+		if subtle.ConstantTimeCompare([]byte(expectedCode), []byte(code)) == 1 {
+			return step
+		}
+	}
+
+	return 0
+}
+
+func decodeTOTPSecret(logger interfaces.Logger, location, secret string) common.Result[[]byte] {
+	key, decodeStringError := totpEncoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if validator.IsError(decodeStringError) {
+		internalError := domain.NewInternalError(location+".decodeTOTPSecret.DecodeString", decodeStringError.Error())
+		logger.Error(internalError)
+		return common.NewResultOnFailure[[]byte](internalError)
+	}
+
+	return common.NewResultOnSuccess[[]byte](key)
+}
+
+// generateTOTPCode computes the HOTP value (RFC 4226) of the counter with dynamic truncation.
+func generateTOTPCode(key []byte, counter uint64) string {
+	message := make([]byte, 8)
+	binary.BigEndian.PutUint64(message, counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for digit := 0; digit < totpDigits; digit++ {
+		modulo *= 10
+	}
+
+	return fmt.Sprintf("%0*d", totpDigits, value%modulo)
+}
+
+func totpCounter(at time.Time) uint64 {
+	return uint64(at.Unix() / totpPeriod)
+}
@@ -17,33 +17,46 @@ func NewApplication(ctx context.Context) model.Container {
 	config := factory.NewConfig(constants.Config)
 	logger := factory.NewLogger(config)
 	email := factory.NewEmail(config, logger)
+	keyRings := factory.NewKeyRings(config, logger)
+	oauthProviders := factory.NewOAuthProviders(config, logger)
+	passwordHasher := factory.NewPasswordHasher(config, logger)
+	breachedPasswords := factory.NewBreachedPasswords(config, logger)
 
 	// Create repository factory and repositories, then assert their types.
-	repository := factory.NewRepositoryFactory(config, logger)
+	repository := factory.NewRepositoryFactory(config, logger, passwordHasher)
 	createRepository := repository.CreateRepository(ctx)
 	userRepository := repository.NewRepository(createRepository, (*interfaces.UserRepository)(nil)).(interfaces.UserRepository)
+	refreshTokenRepository := repository.NewRepository(createRepository, (*interfaces.RefreshTokenRepository)(nil)).(interfaces.RefreshTokenRepository)
+	loginAttemptRepository := repository.NewRepository(createRepository, (*interfaces.LoginAttemptRepository)(nil)).(interfaces.LoginAttemptRepository)
+	personalAccessTokenRepository := repository.NewRepository(createRepository, (*interfaces.PersonalAccessTokenRepository)(nil)).(interfaces.PersonalAccessTokenRepository)
+	oauthRepository := repository.NewRepository(createRepository, (*interfaces.OAuthRepository)(nil)).(interfaces.OAuthRepository)
+	invitationRepository := repository.NewRepository(createRepository, (*interfaces.InvitationRepository)(nil)).(interfaces.InvitationRepository)
 	postRepository := repository.NewRepository(createRepository, (*interfaces.PostRepository)(nil)).(interfaces.PostRepository)
 
 	// Create use cases.
-	userUseCase := user.NewUserUseCase(config, logger, email, userRepository)
+	userUseCase := user.NewUserUseCase(config, logger, email, keyRings, userRepository, refreshTokenRepository, loginAttemptRepository, personalAccessTokenRepository, oauthRepository, oauthProviders, passwordHasher, breachedPasswords, invitationRepository)
+	adminUseCase := user.NewAdminUseCase(config, logger, email, userRepository, refreshTokenRepository, invitationRepository)
 	postUseCase := post.NewPostUseCase(logger, postRepository)
+	postScheduler := post.NewPostScheduler(logger, postUseCase)
 
 	// Create delivery factory and controllers.
-	delivery := factory.NewDeliveryFactory(ctx, config, logger, repository)
+	delivery := factory.NewDeliveryFactory(ctx, config, logger, keyRings, repository)
 	healthController := delivery.NewHealthCheckController(repository)
 	userController := delivery.NewController(userUseCase)
+	adminController := delivery.NewController(adminUseCase)
 	postController := delivery.NewController(postUseCase)
 
 	// Create routers.
 	serverRouters := interfaces.NewServerRouters(
 		delivery.NewHealthRouter(healthController, repository),
-		delivery.NewRouter(userController),
-		delivery.NewRouter(postController),
+		delivery.NewRouter(userController, userUseCase),
+		delivery.NewRouter(adminController, userUseCase),
+		delivery.NewRouter(postController, userUseCase),
 		// Add other routers as needed.
 	)
 
 	delivery.CreateDelivery(serverRouters)
 	repository.HealthCheck(delivery)
-	container := model.NewContainer(logger, repository, delivery)
+	container := model.NewContainer(logger, repository, delivery, postScheduler)
 	return container
 }
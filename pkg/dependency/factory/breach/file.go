@@ -0,0 +1,89 @@
+package breach
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	location = "pkg.dependency.factory.breach."
+
+	hashPrefixLength = 5
+	commentPrefix    = "#"
+	countSeparator   = ":"
+	invalidLine      = "line %d of the breached passwords file is not a SHA-1 hash: %s"
+)
+
+var (
+	sha1HashRegex = regexp.MustCompile(`^[0-9A-F]{40}$`)
+)
+
+// File holds the breached passwords of a local file, so the check works offline.
+// Every line holds an uppercase or lowercase hex SHA-1 hash, optionally followed by a colon and
+// the number of breaches, which is the format of the Pwned Passwords downloads. Empty lines and comments are skipped.
+// The hashes are kept in memory grouped by their prefix, so a subset such as the most common passwords should be used.
+type File struct {
+	Logger interfaces.Logger
+	Ranges map[string][]string
+}
+
+// NewFile loads the breached passwords file, an empty path disables the check.
+func NewFile(logger interfaces.Logger, path string) common.Result[File] {
+	file := File{
+		Logger: logger,
+		Ranges: make(map[string][]string),
+	}
+	if path == "" {
+		return common.NewResultOnSuccess[File](file)
+	}
+
+	openedFile, openError := os.Open(path)
+	if validator.IsError(openError) {
+		internalError := domain.NewInternalError(location+"NewFile.Open", openError.Error())
+		logger.Error(internalError)
+		return common.NewResultOnFailure[File](internalError)
+	}
+	defer openedFile.Close()
+
+	scanner := bufio.NewScanner(openedFile)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, commentPrefix) {
+			continue
+		}
+
+		hash, _, _ := strings.Cut(line, countSeparator)
+		hash = strings.ToUpper(strings.TrimSpace(hash))
+		if !sha1HashRegex.MatchString(hash) {
+			internalError := domain.NewInternalError(location+"NewFile.MatchString", fmt.Sprintf(invalidLine, lineNumber, line))
+			logger.Error(internalError)
+			return common.NewResultOnFailure[File](internalError)
+		}
+
+		prefix := hash[:hashPrefixLength]
+		file.Ranges[prefix] = append(file.Ranges[prefix], hash[hashPrefixLength:])
+	}
+	if validator.IsError(scanner.Err()) {
+		internalError := domain.NewInternalError(location+"NewFile.Scan", scanner.Err().Error())
+		logger.Error(internalError)
+		return common.NewResultOnFailure[File](internalError)
+	}
+
+	return common.NewResultOnSuccess[File](file)
+}
+
+// GetHashSuffixes returns the suffixes of the breached hashes starting with the prefix.
+func (file File) GetHashSuffixes(ctx context.Context, hashPrefix string) common.Result[[]string] {
+	return common.NewResultOnSuccess[[]string](file.Ranges[strings.ToUpper(hashPrefix)])
+}
@@ -11,9 +11,11 @@ type ApplicationConfig struct {
 	Security     Security
 	Gin          Gin
 	GRPC         GRPC
+	JWT          JWT
 	AccessToken  AccessToken
 	RefreshToken RefreshToken
 	Email        Email
+	OAuth        OAuth
 }
 
 type Core struct {
@@ -34,6 +36,63 @@ type Security struct {
 	XContentTypeOptionsHeader       Header
 	AllowedHTTPMethods              []string
 	AllowedContentTypes             []string
+	LoginThrottle                   LoginThrottle
+	PasswordHashing                 PasswordHashing
+	PasswordPolicy                  PasswordPolicy
+	Registration                    Registration
+}
+
+// LoginThrottle configures the protection against brute-force login attempts.
+// Every failed attempt blocks further attempts with an exponential backoff,
+// and reaching the maximum number of attempts locks the login for the lockout duration.
+type LoginThrottle struct {
+	MaxAttemptsPerEmail int
+	MaxAttemptsPerIP    int
+	AttemptWindow       time.Duration
+	BackoffBase         time.Duration
+	BackoffMax          time.Duration
+	LockoutDuration     time.Duration
+}
+
+// PasswordHashing configures the algorithm new passwords are hashed with.
+// Hashes of the other algorithms or with other parameters are still verified, and replaced on the next login.
+type PasswordHashing struct {
+	Algorithm string
+	Bcrypt    Bcrypt
+	Argon2id  Argon2id
+}
+
+type Bcrypt struct {
+	Cost int
+}
+
+// Argon2id holds the parameters of the argon2id algorithm, the memory is set in KiB.
+type Argon2id struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// PasswordPolicy configures the requirements of new passwords.
+// Passwords found in the breached passwords file are rejected, the check is disabled when no file is set.
+type PasswordPolicy struct {
+	MinLength             int
+	MaxLength             int
+	RequireLowercase      bool
+	RequireUppercase      bool
+	RequireDigit          bool
+	RequireSymbol         bool
+	DisallowPersonalData  bool
+	BreachedPasswordsPath string
+}
+
+// Registration configures who can register, either anyone, invited users only
+// or users with an email address of the allowed domains.
+type Registration struct {
+	Mode                string
+	AllowedEmailDomains []string
 }
 
 type Header struct {
@@ -58,29 +117,81 @@ type GRPC struct {
 	ServerUrl string
 }
 
+// JWT configures the registered claims shared by the access and refresh tokens.
+// An empty issuer or audience is neither set nor checked, the leeway tolerates clock skew between servers.
+type JWT struct {
+	Issuer   string
+	Audience string
+	Leeway   time.Duration
+}
+
+// OAuth configures the social login. Each provider is addressed by its name in the login routes,
+// the authorization requests that haven't been completed expire after StateExpiredIn.
+type OAuth struct {
+	StateExpiredIn time.Duration
+	Providers      []OAuthProvider
+}
+
+// OAuthProvider configures an external identity provider. The type is GitHub, Google or OIDC,
+// an OIDC provider discovers its endpoints from the issuer URL.
+type OAuthProvider struct {
+	Name         string
+	Type         string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	IssuerURL    string
+	Scopes       []string
+}
+
 type AccessToken struct {
-	PrivateKey string
-	PublicKey  string
-	ExpiredIn  time.Duration
-	MaxAge     int
+	Algorithm   string
+	KeyID       string
+	PrivateKey  string
+	PublicKey   string
+	RetiredKeys []RetiredKey
+	ExpiredIn   time.Duration
+	MaxAge      int
 }
 
 type RefreshToken struct {
-	PrivateKey string
+	Algorithm   string
+	KeyID       string
+	PrivateKey  string
+	PublicKey   string
+	RetiredKeys []RetiredKey
+	ExpiredIn   time.Duration
+	MaxAge      int
+}
+
+// RetiredKey is a former signing key that no longer signs tokens,
+// it keeps verifying the tokens signed before the rotation until ValidUntil.
+type RetiredKey struct {
+	Algorithm  string
+	KeyID      string
 	PublicKey  string
-	ExpiredIn  time.Duration
-	MaxAge     int
+	ValidUntil time.Time
 }
 
 type Email struct {
-	ClientOriginUrl               string
-	EmailFrom                     string
-	SMTPHost                      string
-	SMTPPassword                  string
-	SMTPPort                      int
-	SMTPUser                      string
-	UserConfirmationTemplateName  string
-	UserConfirmationTemplatePath  string
-	ForgottenPasswordTemplateName string
-	ForgottenPasswordTemplatePath string
+	ClientOriginUrl                     string
+	EmailFrom                           string
+	SMTPHost                            string
+	SMTPPassword                        string
+	SMTPPort                            int
+	SMTPUser                            string
+	UserConfirmationTemplateName        string
+	UserConfirmationTemplatePath        string
+	ForgottenPasswordTemplateName       string
+	ForgottenPasswordTemplatePath       string
+	AccountUnlockTemplateName           string
+	AccountUnlockTemplatePath           string
+	PasswordChangedTemplateName         string
+	PasswordChangedTemplatePath         string
+	EmailChangeConfirmationTemplateName string
+	EmailChangeConfirmationTemplatePath string
+	EmailChangeCancelTemplateName       string
+	EmailChangeCancelTemplatePath       string
+	MagicLinkTemplateName               string
+	MagicLinkTemplatePath               string
 }
@@ -8,9 +8,11 @@ type YamlConfig struct {
 	MongoDB      YamlMongoDB      `mapstructure:"MongoDB"`
 	Gin          YamlGin          `mapstructure:"Gin"`
 	GRPC         YamlGRPC         `mapstructure:"Grpc"`
+	JWT          YamlJWT          `mapstructure:"JWT"`
 	AccessToken  YamlAccessToken  `mapstructure:"Access_Token"`
 	RefreshToken YamlRefreshToken `mapstructure:"Refresh_Token"`
 	Email        YamlEmail        `mapstructure:"Email"`
+	OAuth        YamlOAuth        `mapstructure:"OAuth"`
 }
 
 type YamlCore struct {
@@ -21,16 +23,63 @@ type YamlCore struct {
 }
 
 type YamlSecurity struct {
-	CookieDomainValue               string     `mapstructure:"Cookie_Domain_Value"`
-	CookieSecure                    bool       `mapstructure:"Cookie_Secure"`
-	HTTPOnly                        bool       `mapstructure:"HTTP_Only"`
-	RateLimit                       float64    `mapstructure:"Rate_Limit"`
-	ContentSecurityPolicyHeader     YamlHeader `mapstructure:"Content_Security_Policy_Header"`
-	ContentSecurityPolicyHeaderFull YamlHeader `mapstructure:"Content_Security_Policy_Header_Full"`
-	StrictTransportSecurityHeader   YamlHeader `mapstructure:"Strict_Transport_Security_Header"`
-	XContentTypeOptionsHeader       YamlHeader `mapstructure:"X_Content_Type_Options_Header"`
-	AllowedHTTPMethods              []string   `mapstructure:"Allowed_HTTP_Methods"`
-	AllowedContentTypes             []string   `mapstructure:"Allowed_Content_Types"`
+	CookieDomainValue               string              `mapstructure:"Cookie_Domain_Value"`
+	CookieSecure                    bool                `mapstructure:"Cookie_Secure"`
+	HTTPOnly                        bool                `mapstructure:"HTTP_Only"`
+	RateLimit                       float64             `mapstructure:"Rate_Limit"`
+	ContentSecurityPolicyHeader     YamlHeader          `mapstructure:"Content_Security_Policy_Header"`
+	ContentSecurityPolicyHeaderFull YamlHeader          `mapstructure:"Content_Security_Policy_Header_Full"`
+	StrictTransportSecurityHeader   YamlHeader          `mapstructure:"Strict_Transport_Security_Header"`
+	XContentTypeOptionsHeader       YamlHeader          `mapstructure:"X_Content_Type_Options_Header"`
+	AllowedHTTPMethods              []string            `mapstructure:"Allowed_HTTP_Methods"`
+	AllowedContentTypes             []string            `mapstructure:"Allowed_Content_Types"`
+	LoginThrottle                   YamlLoginThrottle   `mapstructure:"Login_Throttle"`
+	PasswordHashing                 YamlPasswordHashing `mapstructure:"Password_Hashing"`
+	PasswordPolicy                  YamlPasswordPolicy  `mapstructure:"Password_Policy"`
+	Registration                    YamlRegistration    `mapstructure:"Registration"`
+}
+
+type YamlLoginThrottle struct {
+	MaxAttemptsPerEmail int           `mapstructure:"Max_Attempts_Per_Email"`
+	MaxAttemptsPerIP    int           `mapstructure:"Max_Attempts_Per_IP"`
+	AttemptWindow       time.Duration `mapstructure:"Attempt_Window"`
+	BackoffBase         time.Duration `mapstructure:"Backoff_Base"`
+	BackoffMax          time.Duration `mapstructure:"Backoff_Max"`
+	LockoutDuration     time.Duration `mapstructure:"Lockout_Duration"`
+}
+
+type YamlPasswordHashing struct {
+	Algorithm string       `mapstructure:"Algorithm"`
+	Bcrypt    YamlBcrypt   `mapstructure:"Bcrypt"`
+	Argon2id  YamlArgon2id `mapstructure:"Argon2id"`
+}
+
+type YamlBcrypt struct {
+	Cost int `mapstructure:"Cost"`
+}
+
+type YamlArgon2id struct {
+	Memory      uint32 `mapstructure:"Memory"`
+	Iterations  uint32 `mapstructure:"Iterations"`
+	Parallelism uint8  `mapstructure:"Parallelism"`
+	SaltLength  uint32 `mapstructure:"Salt_Length"`
+	KeyLength   uint32 `mapstructure:"Key_Length"`
+}
+
+type YamlPasswordPolicy struct {
+	MinLength             int    `mapstructure:"Min_Length"`
+	MaxLength             int    `mapstructure:"Max_Length"`
+	RequireLowercase      bool   `mapstructure:"Require_Lowercase"`
+	RequireUppercase      bool   `mapstructure:"Require_Uppercase"`
+	RequireDigit          bool   `mapstructure:"Require_Digit"`
+	RequireSymbol         bool   `mapstructure:"Require_Symbol"`
+	DisallowPersonalData  bool   `mapstructure:"Disallow_Personal_Data"`
+	BreachedPasswordsPath string `mapstructure:"Breached_Passwords_Path"`
+}
+
+type YamlRegistration struct {
+	Mode                string   `mapstructure:"Mode"`
+	AllowedEmailDomains []string `mapstructure:"Allowed_Email_Domains"`
 }
 
 type YamlHeader struct {
@@ -55,29 +104,73 @@ type YamlGRPC struct {
 	ServerUrl string `mapstructure:"Server_Url"`
 }
 
+type YamlJWT struct {
+	Issuer   string        `mapstructure:"Issuer"`
+	Audience string        `mapstructure:"Audience"`
+	Leeway   time.Duration `mapstructure:"Leeway"`
+}
+
+type YamlOAuth struct {
+	StateExpiredIn time.Duration       `mapstructure:"State_Expired_In"`
+	Providers      []YamlOAuthProvider `mapstructure:"Providers"`
+}
+
+type YamlOAuthProvider struct {
+	Name         string   `mapstructure:"Name"`
+	Type         string   `mapstructure:"Type"`
+	ClientID     string   `mapstructure:"Client_ID"`
+	ClientSecret string   `mapstructure:"Client_Secret"`
+	RedirectURL  string   `mapstructure:"Redirect_URL"`
+	IssuerURL    string   `mapstructure:"Issuer_URL"`
+	Scopes       []string `mapstructure:"Scopes"`
+}
+
 type YamlAccessToken struct {
-	PrivateKey string        `mapstructure:"Private_Key"`
-	PublicKey  string        `mapstructure:"Public_Key"`
-	ExpiredIn  time.Duration `mapstructure:"Expired_In"`
-	MaxAge     int           `mapstructure:"Max_Age"`
+	Algorithm   string           `mapstructure:"Algorithm"`
+	KeyID       string           `mapstructure:"Key_ID"`
+	PrivateKey  string           `mapstructure:"Private_Key"`
+	PublicKey   string           `mapstructure:"Public_Key"`
+	RetiredKeys []YamlRetiredKey `mapstructure:"Retired_Keys"`
+	ExpiredIn   time.Duration    `mapstructure:"Expired_In"`
+	MaxAge      int              `mapstructure:"Max_Age"`
 }
 
 type YamlRefreshToken struct {
-	PrivateKey string        `mapstructure:"Private_Key"`
-	PublicKey  string        `mapstructure:"Public_Key"`
-	ExpiredIn  time.Duration `mapstructure:"Expired_In"`
-	MaxAge     int           `mapstructure:"Max_Age"`
+	Algorithm   string           `mapstructure:"Algorithm"`
+	KeyID       string           `mapstructure:"Key_ID"`
+	PrivateKey  string           `mapstructure:"Private_Key"`
+	PublicKey   string           `mapstructure:"Public_Key"`
+	RetiredKeys []YamlRetiredKey `mapstructure:"Retired_Keys"`
+	ExpiredIn   time.Duration    `mapstructure:"Expired_In"`
+	MaxAge      int              `mapstructure:"Max_Age"`
+}
+
+type YamlRetiredKey struct {
+	Algorithm  string `mapstructure:"Algorithm"`
+	KeyID      string `mapstructure:"Key_ID"`
+	PublicKey  string `mapstructure:"Public_Key"`
+	ValidUntil string `mapstructure:"Valid_Until"`
 }
 
 type YamlEmail struct {
-	ClientOriginUrl               string `mapstructure:"Client_Origin_Url"`
-	EmailFrom                     string `mapstructure:"Email_From"`
-	SMTPHost                      string `mapstructure:"SMTP_Host"`
-	SMTPPassword                  string `mapstructure:"SMTP_Password"`
-	SMTPPort                      int    `mapstructure:"SMTP_Port"`
-	SMTPUser                      string `mapstructure:"SMTP_User"`
-	UserConfirmationTemplateName  string `mapstructure:"User_Confirmation_Template_Name"`
-	UserConfirmationTemplatePath  string `mapstructure:"User_Confirmation_Template_Path"`
-	ForgottenPasswordTemplateName string `mapstructure:"Forgotten_Password_Template_Name"`
-	ForgottenPasswordTemplatePath string `mapstructure:"Forgotten_Password_Template_Path"`
+	ClientOriginUrl                     string `mapstructure:"Client_Origin_Url"`
+	EmailFrom                           string `mapstructure:"Email_From"`
+	SMTPHost                            string `mapstructure:"SMTP_Host"`
+	SMTPPassword                        string `mapstructure:"SMTP_Password"`
+	SMTPPort                            int    `mapstructure:"SMTP_Port"`
+	SMTPUser                            string `mapstructure:"SMTP_User"`
+	UserConfirmationTemplateName        string `mapstructure:"User_Confirmation_Template_Name"`
+	UserConfirmationTemplatePath        string `mapstructure:"User_Confirmation_Template_Path"`
+	ForgottenPasswordTemplateName       string `mapstructure:"Forgotten_Password_Template_Name"`
+	ForgottenPasswordTemplatePath       string `mapstructure:"Forgotten_Password_Template_Path"`
+	AccountUnlockTemplateName           string `mapstructure:"Account_Unlock_Template_Name"`
+	AccountUnlockTemplatePath           string `mapstructure:"Account_Unlock_Template_Path"`
+	PasswordChangedTemplateName         string `mapstructure:"Password_Changed_Template_Name"`
+	PasswordChangedTemplatePath         string `mapstructure:"Password_Changed_Template_Path"`
+	EmailChangeConfirmationTemplateName string `mapstructure:"Email_Change_Confirmation_Template_Name"`
+	EmailChangeConfirmationTemplatePath string `mapstructure:"Email_Change_Confirmation_Template_Path"`
+	EmailChangeCancelTemplateName       string `mapstructure:"Email_Change_Cancel_Template_Name"`
+	EmailChangeCancelTemplatePath       string `mapstructure:"Email_Change_Cancel_Template_Path"`
+	MagicLinkTemplateName               string `mapstructure:"Magic_Link_Template_Name"`
+	MagicLinkTemplatePath               string `mapstructure:"Magic_Link_Template_Path"`
 }
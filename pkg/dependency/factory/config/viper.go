@@ -3,6 +3,7 @@ package config
 import (
 	"log"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/viper"
@@ -70,9 +71,11 @@ func yamlConfigToApplicationConfigMapper(yamlConfig *config.YamlConfig) config.A
 		Security:     convertSecurity(&yamlConfig.Security),
 		Gin:          convertGin(&yamlConfig.Gin),
 		GRPC:         convertGRPC(&yamlConfig.GRPC),
+		JWT:          convertJWT(&yamlConfig.JWT),
 		AccessToken:  convertAccessToken(&yamlConfig.AccessToken),
 		RefreshToken: convertRefreshToken(&yamlConfig.RefreshToken),
 		Email:        convertEmail(&yamlConfig.Email),
+		OAuth:        convertOAuth(&yamlConfig.OAuth),
 	}
 }
 
@@ -97,6 +100,57 @@ func convertSecurity(security *config.YamlSecurity) config.Security {
 		XContentTypeOptionsHeader:       convertHeader(&security.XContentTypeOptionsHeader),
 		AllowedHTTPMethods:              security.AllowedHTTPMethods,
 		AllowedContentTypes:             security.AllowedContentTypes,
+		LoginThrottle:                   convertLoginThrottle(&security.LoginThrottle),
+		PasswordHashing:                 convertPasswordHashing(&security.PasswordHashing),
+		PasswordPolicy:                  convertPasswordPolicy(&security.PasswordPolicy),
+		Registration:                    convertRegistration(&security.Registration),
+	}
+}
+
+func convertLoginThrottle(loginThrottle *config.YamlLoginThrottle) config.LoginThrottle {
+	return config.LoginThrottle{
+		MaxAttemptsPerEmail: loginThrottle.MaxAttemptsPerEmail,
+		MaxAttemptsPerIP:    loginThrottle.MaxAttemptsPerIP,
+		AttemptWindow:       loginThrottle.AttemptWindow,
+		BackoffBase:         loginThrottle.BackoffBase,
+		BackoffMax:          loginThrottle.BackoffMax,
+		LockoutDuration:     loginThrottle.LockoutDuration,
+	}
+}
+
+func convertPasswordHashing(passwordHashing *config.YamlPasswordHashing) config.PasswordHashing {
+	return config.PasswordHashing{
+		Algorithm: passwordHashing.Algorithm,
+		Bcrypt: config.Bcrypt{
+			Cost: passwordHashing.Bcrypt.Cost,
+		},
+		Argon2id: config.Argon2id{
+			Memory:      passwordHashing.Argon2id.Memory,
+			Iterations:  passwordHashing.Argon2id.Iterations,
+			Parallelism: passwordHashing.Argon2id.Parallelism,
+			SaltLength:  passwordHashing.Argon2id.SaltLength,
+			KeyLength:   passwordHashing.Argon2id.KeyLength,
+		},
+	}
+}
+
+func convertPasswordPolicy(passwordPolicy *config.YamlPasswordPolicy) config.PasswordPolicy {
+	return config.PasswordPolicy{
+		MinLength:             passwordPolicy.MinLength,
+		MaxLength:             passwordPolicy.MaxLength,
+		RequireLowercase:      passwordPolicy.RequireLowercase,
+		RequireUppercase:      passwordPolicy.RequireUppercase,
+		RequireDigit:          passwordPolicy.RequireDigit,
+		RequireSymbol:         passwordPolicy.RequireSymbol,
+		DisallowPersonalData:  passwordPolicy.DisallowPersonalData,
+		BreachedPasswordsPath: passwordPolicy.BreachedPasswordsPath,
+	}
+}
+
+func convertRegistration(registration *config.YamlRegistration) config.Registration {
+	return config.Registration{
+		Mode:                registration.Mode,
+		AllowedEmailDomains: registration.AllowedEmailDomains,
 	}
 }
 
@@ -130,35 +184,100 @@ func convertGRPC(grpc *config.YamlGRPC) config.GRPC {
 	}
 }
 
+func convertJWT(jwt *config.YamlJWT) config.JWT {
+	return config.JWT{
+		Issuer:   jwt.Issuer,
+		Audience: jwt.Audience,
+		Leeway:   jwt.Leeway,
+	}
+}
+
+func convertOAuth(oauth *config.YamlOAuth) config.OAuth {
+	providers := make([]config.OAuthProvider, len(oauth.Providers))
+	for index, provider := range oauth.Providers {
+		providers[index] = config.OAuthProvider{
+			Name:         provider.Name,
+			Type:         provider.Type,
+			ClientID:     provider.ClientID,
+			ClientSecret: provider.ClientSecret,
+			RedirectURL:  provider.RedirectURL,
+			IssuerURL:    provider.IssuerURL,
+			Scopes:       provider.Scopes,
+		}
+	}
+
+	return config.OAuth{
+		StateExpiredIn: oauth.StateExpiredIn,
+		Providers:      providers,
+	}
+}
+
 func convertAccessToken(accessToken *config.YamlAccessToken) config.AccessToken {
 	return config.AccessToken{
-		PrivateKey: accessToken.PrivateKey,
-		PublicKey:  accessToken.PublicKey,
-		ExpiredIn:  accessToken.ExpiredIn,
-		MaxAge:     accessToken.MaxAge,
+		Algorithm:   accessToken.Algorithm,
+		KeyID:       accessToken.KeyID,
+		PrivateKey:  accessToken.PrivateKey,
+		PublicKey:   accessToken.PublicKey,
+		RetiredKeys: convertRetiredKeys(accessToken.RetiredKeys),
+		ExpiredIn:   accessToken.ExpiredIn,
+		MaxAge:      accessToken.MaxAge,
 	}
 }
 
 func convertRefreshToken(refreshToken *config.YamlRefreshToken) config.RefreshToken {
 	return config.RefreshToken{
-		PrivateKey: refreshToken.PrivateKey,
-		PublicKey:  refreshToken.PublicKey,
-		ExpiredIn:  refreshToken.ExpiredIn,
-		MaxAge:     refreshToken.MaxAge,
+		Algorithm:   refreshToken.Algorithm,
+		KeyID:       refreshToken.KeyID,
+		PrivateKey:  refreshToken.PrivateKey,
+		PublicKey:   refreshToken.PublicKey,
+		RetiredKeys: convertRetiredKeys(refreshToken.RetiredKeys),
+		ExpiredIn:   refreshToken.ExpiredIn,
+		MaxAge:      refreshToken.MaxAge,
+	}
+}
+
+// convertRetiredKeys parses the RFC 3339 dates until which the retired keys are accepted,
+// an invalid date stops the start-up, because tokens would be accepted for an unexpected period.
+func convertRetiredKeys(retiredKeys []config.YamlRetiredKey) []config.RetiredKey {
+	convertedRetiredKeys := make([]config.RetiredKey, len(retiredKeys))
+	for index, retiredKey := range retiredKeys {
+		validUntil, parseError := time.Parse(time.RFC3339, retiredKey.ValidUntil)
+		if validator.IsError(parseError) {
+			panic(domain.NewInternalError(location+"viper.convertRetiredKeys.Parse", parseError.Error()))
+		}
+
+		convertedRetiredKeys[index] = config.RetiredKey{
+			Algorithm:  retiredKey.Algorithm,
+			KeyID:      retiredKey.KeyID,
+			PublicKey:  retiredKey.PublicKey,
+			ValidUntil: validUntil,
+		}
 	}
+
+	return convertedRetiredKeys
 }
 
 func convertEmail(email *config.YamlEmail) config.Email {
 	return config.Email{
-		ClientOriginUrl:               email.ClientOriginUrl,
-		EmailFrom:                     email.EmailFrom,
-		SMTPHost:                      email.SMTPHost,
-		SMTPPassword:                  email.SMTPPassword,
-		SMTPPort:                      email.SMTPPort,
-		SMTPUser:                      email.SMTPUser,
-		UserConfirmationTemplateName:  email.UserConfirmationTemplateName,
-		UserConfirmationTemplatePath:  email.UserConfirmationTemplatePath,
-		ForgottenPasswordTemplateName: email.ForgottenPasswordTemplateName,
-		ForgottenPasswordTemplatePath: email.ForgottenPasswordTemplatePath,
+		ClientOriginUrl:                     email.ClientOriginUrl,
+		EmailFrom:                           email.EmailFrom,
+		SMTPHost:                            email.SMTPHost,
+		SMTPPassword:                        email.SMTPPassword,
+		SMTPPort:                            email.SMTPPort,
+		SMTPUser:                            email.SMTPUser,
+		UserConfirmationTemplateName:        email.UserConfirmationTemplateName,
+		UserConfirmationTemplatePath:        email.UserConfirmationTemplatePath,
+		ForgottenPasswordTemplateName:       email.ForgottenPasswordTemplateName,
+		ForgottenPasswordTemplatePath:       email.ForgottenPasswordTemplatePath,
+		AccountUnlockTemplateName:           email.AccountUnlockTemplateName,
+		AccountUnlockTemplatePath:           email.AccountUnlockTemplatePath,
+		PasswordChangedTemplateName:         email.PasswordChangedTemplateName,
+		PasswordChangedTemplatePath:         email.PasswordChangedTemplatePath,
+		EmailChangeConfirmationTemplateName: email.EmailChangeConfirmationTemplateName,
+		EmailChangeConfirmationTemplatePath: email.EmailChangeConfirmationTemplatePath,
+		EmailChangeCancelTemplateName:       email.EmailChangeCancelTemplateName,
+		EmailChangeCancelTemplatePath:       email.EmailChangeCancelTemplatePath,
+		MagicLinkTemplateName:               email.MagicLinkTemplateName,
+		MagicLinkTemplatePath:               email.MagicLinkTemplatePath,
 	}
 }
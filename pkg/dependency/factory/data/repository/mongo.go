@@ -29,15 +29,17 @@ const (
 )
 
 type MongoDBRepository struct {
-	Config      *config.ApplicationConfig
-	Logger      interfaces.Logger
-	MongoClient *mongo.Client
+	Config         *config.ApplicationConfig
+	Logger         interfaces.Logger
+	PasswordHasher interfaces.PasswordHasher
+	MongoClient    *mongo.Client
 }
 
-func NewMongoDBRepository(config *config.ApplicationConfig, logger interfaces.Logger) *MongoDBRepository {
+func NewMongoDBRepository(config *config.ApplicationConfig, logger interfaces.Logger, passwordHasher interfaces.PasswordHasher) *MongoDBRepository {
 	return &MongoDBRepository{
-		Config: config,
-		Logger: logger,
+		Config:         config,
+		Logger:         logger,
+		PasswordHasher: passwordHasher,
 	}
 }
 
@@ -50,7 +52,17 @@ func (mongoDBRepository MongoDBRepository) NewRepository(createRepository any, r
 	mongoDB := createRepository.(*mongo.Database)
 	switch repository.(type) {
 	case *interfaces.UserRepository:
-		return user.NewUserRepository(mongoDBRepository.Config, mongoDBRepository.Logger, mongoDB)
+		return user.NewUserRepository(mongoDBRepository.Config, mongoDBRepository.Logger, mongoDBRepository.PasswordHasher, mongoDB)
+	case *interfaces.RefreshTokenRepository:
+		return user.NewRefreshTokenRepository(mongoDBRepository.Config, mongoDBRepository.Logger, mongoDB)
+	case *interfaces.LoginAttemptRepository:
+		return user.NewLoginAttemptRepository(mongoDBRepository.Config, mongoDBRepository.Logger, mongoDB)
+	case *interfaces.PersonalAccessTokenRepository:
+		return user.NewPersonalAccessTokenRepository(mongoDBRepository.Config, mongoDBRepository.Logger, mongoDB)
+	case *interfaces.OAuthRepository:
+		return user.NewOAuthRepository(mongoDBRepository.Config, mongoDBRepository.Logger, mongoDB)
+	case *interfaces.InvitationRepository:
+		return user.NewInvitationRepository(mongoDBRepository.Config, mongoDBRepository.Logger, mongoDB)
 	case *interfaces.PostRepository:
 		return post.NewPostRepository(mongoDBRepository.Logger, mongoDB)
 	default:
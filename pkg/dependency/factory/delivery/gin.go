@@ -13,6 +13,7 @@ import (
 	postUseCase "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/usecase"
 	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/delivery/http/gin"
 	userUseCase "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/usecase"
+	domainUtility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
 	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
 	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
 	httpModel "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/delivery/http"
@@ -27,16 +28,18 @@ const (
 )
 
 type GinDelivery struct {
-	Config *config.ApplicationConfig
-	Logger interfaces.Logger
-	Server *http.Server
-	Router *gin.Engine
+	Config   *config.ApplicationConfig
+	Logger   interfaces.Logger
+	KeyRings domainUtility.KeyRings
+	Server   *http.Server
+	Router   *gin.Engine
 }
 
-func NewGinDelivery(config *config.ApplicationConfig, logger interfaces.Logger) *GinDelivery {
+func NewGinDelivery(config *config.ApplicationConfig, logger interfaces.Logger, keyRings domainUtility.KeyRings) *GinDelivery {
 	return &GinDelivery{
-		Config: config,
-		Logger: logger,
+		Config:   config,
+		Logger:   logger,
+		KeyRings: keyRings,
 	}
 }
 
@@ -52,6 +55,7 @@ func (ginDelivery *GinDelivery) CreateDelivery(serverRouters interfaces.ServerRo
 
 	// Initialize entity-specific routers.
 	serverRouters.UserRouter.Router(router)
+	serverRouters.AdminRouter.Router(router)
 	serverRouters.PostRouter.Router(router)
 
 	setNoRouteHandler(ginDelivery.Router, location+"gin.CreateDelivery", ginDelivery.Logger)
@@ -84,8 +88,10 @@ func (ginDelivery GinDelivery) NewController(useCase any) any {
 	switch useCaseType := useCase.(type) {
 	case userUseCase.UserUseCase:
 		return user.NewUserController(ginDelivery.Config, ginDelivery.Logger, useCaseType)
+	case userUseCase.AdminUseCase:
+		return user.NewAdminController(ginDelivery.Config, ginDelivery.Logger, useCaseType)
 	case postUseCase.PostUseCase:
-		return post.NewPostController(useCaseType)
+		return post.NewPostController(ginDelivery.Config, ginDelivery.Logger, useCaseType)
 	default:
 		ginDelivery.Logger.Panic(domain.NewInternalError(location+"gin.NewController.default", fmt.Sprintf(constants.UnsupportedUsecase, useCaseType)))
 		return nil
@@ -102,12 +108,14 @@ func (ginDelivery GinDelivery) NewHealthRouter(controller any, repository interf
 	}
 }
 
-func (ginDelivery GinDelivery) NewRouter(controller any) interfaces.Router {
+func (ginDelivery GinDelivery) NewRouter(controller any, sessionValidator interfaces.SessionValidator) interfaces.Router {
 	switch controllerType := controller.(type) {
 	case interfaces.UserController:
-		return user.NewUserRouter(ginDelivery.Config, ginDelivery.Logger, controllerType)
+		return user.NewUserRouter(ginDelivery.Config, ginDelivery.Logger, ginDelivery.KeyRings, controllerType, sessionValidator)
+	case interfaces.AdminController:
+		return user.NewAdminRouter(ginDelivery.Config, ginDelivery.Logger, ginDelivery.KeyRings, controllerType, sessionValidator)
 	case interfaces.PostController:
-		return post.NewPostRouter(ginDelivery.Config, ginDelivery.Logger, controllerType)
+		return post.NewPostRouter(ginDelivery.Config, ginDelivery.Logger, ginDelivery.KeyRings, controllerType, sessionValidator)
 	default:
 		ginDelivery.Logger.Panic(domain.NewInternalError(location+"gin.NewRouter.default", fmt.Sprintf(constants.UnsupportedController, controllerType)))
 		return nil
@@ -5,20 +5,27 @@ import (
 	"fmt"
 
 	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	domainUtility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	breach "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/breach"
 	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config"
 	configModel "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
 	repository "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/data/repository"
 	delivery "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/delivery"
 	email "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/email"
+	hasher "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/hasher"
 	logger "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/logger"
+	oauth "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/oauth"
 	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/model"
 	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
 	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
 	emailMock "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/dependency/email"
 )
 
 const (
 	location = "pkg.dependency.factory."
+
+	duplicateOAuthProvider = "OAuth providers need a unique name, got %q"
 )
 
 func NewConfig(configType string) *configModel.ApplicationConfig {
@@ -53,10 +60,105 @@ func NewEmail(config *configModel.ApplicationConfig, logger interfaces.Logger) i
 	}
 }
 
-func NewRepositoryFactory(config *configModel.ApplicationConfig, logger interfaces.Logger) interfaces.Repository {
+// NewKeyRings parses the keys of the access and refresh tokens once at start-up,
+// the application can't issue or verify tokens without them. Each key ring only accepts the tokens of its own type.
+func NewKeyRings(config *configModel.ApplicationConfig, logger interfaces.Logger) domainUtility.KeyRings {
+	accessTokenKeyRing := domainUtility.NewKeyRing(
+		logger,
+		location+"NewKeyRings.accessToken",
+		config.AccessToken.Algorithm,
+		config.AccessToken.KeyID,
+		config.AccessToken.PrivateKey,
+		config.AccessToken.PublicKey,
+		config.AccessToken.RetiredKeys,
+		domainUtility.NewTokenClaims(constants.AccessTokenValue, config.JWT.Issuer, config.JWT.Audience, config.JWT.Leeway),
+	)
+	if validator.IsError(accessTokenKeyRing.Error) {
+		logger.Panic(accessTokenKeyRing.Error)
+	}
+
+	refreshTokenKeyRing := domainUtility.NewKeyRing(
+		logger,
+		location+"NewKeyRings.refreshToken",
+		config.RefreshToken.Algorithm,
+		config.RefreshToken.KeyID,
+		config.RefreshToken.PrivateKey,
+		config.RefreshToken.PublicKey,
+		config.RefreshToken.RetiredKeys,
+		domainUtility.NewTokenClaims(constants.RefreshTokenValue, config.JWT.Issuer, config.JWT.Audience, config.JWT.Leeway),
+	)
+	if validator.IsError(refreshTokenKeyRing.Error) {
+		logger.Panic(refreshTokenKeyRing.Error)
+	}
+
+	return domainUtility.NewKeyRings(accessTokenKeyRing.Data, refreshTokenKeyRing.Data)
+}
+
+// NewOAuthProviders creates the configured social login providers, addressed by their names.
+// A misconfigured provider stops the start-up instead of failing the logins later.
+func NewOAuthProviders(config *configModel.ApplicationConfig, logger interfaces.Logger) map[string]interfaces.OAuthProvider {
+	oauthProviders := make(map[string]interfaces.OAuthProvider, len(config.OAuth.Providers))
+	for _, provider := range config.OAuth.Providers {
+		_, ok := oauthProviders[provider.Name]
+		if validator.IsValueEmpty(provider.Name) || ok {
+			logger.Panic(domain.NewInternalError(location+"NewOAuthProviders.Name", fmt.Sprintf(duplicateOAuthProvider, provider.Name)))
+		}
+
+		switch provider.Type {
+		case constants.GitHub:
+			oauthProviders[provider.Name] = oauth.NewGitHub(logger, provider)
+		case constants.Google:
+			oauthProviders[provider.Name] = oauth.NewGoogle(logger, provider)
+		case constants.OIDC:
+			oauthProviders[provider.Name] = oauth.NewOIDC(logger, provider)
+		// Add other OAuth provider options here as needed.
+		default:
+			logger.Panic(domain.NewInternalError(location+"NewOAuthProviders", fmt.Sprintf(constants.UnsupportedOAuth, provider.Type)))
+		}
+	}
+
+	return oauthProviders
+}
+
+// NewPasswordHasher creates the password hasher of the configured algorithm.
+// The other algorithms are kept to verify the existing hashes, which are replaced on the next login.
+func NewPasswordHasher(config *configModel.ApplicationConfig, logger interfaces.Logger) interfaces.PasswordHasher {
+	passwordHashing := config.Security.PasswordHashing
+	bcrypt := hasher.NewBcrypt(logger, location+"NewPasswordHasher", passwordHashing.Bcrypt)
+	if validator.IsError(bcrypt.Error) {
+		logger.Panic(bcrypt.Error)
+	}
+	argon2id := hasher.NewArgon2id(logger, location+"NewPasswordHasher", passwordHashing.Argon2id)
+	if validator.IsError(argon2id.Error) {
+		logger.Panic(argon2id.Error)
+	}
+
+	switch passwordHashing.Algorithm {
+	case constants.Argon2id:
+		return hasher.NewPasswordHasher(logger, argon2id.Data, bcrypt.Data)
+	case constants.Bcrypt:
+		return hasher.NewPasswordHasher(logger, bcrypt.Data, argon2id.Data)
+	// Add other password hashing options here as needed.
+	default:
+		logger.Panic(domain.NewInternalError(location+"NewPasswordHasher", fmt.Sprintf(constants.UnsupportedHasher, passwordHashing.Algorithm)))
+		return nil
+	}
+}
+
+// NewBreachedPasswords loads the local breached passwords file of the password policy.
+func NewBreachedPasswords(config *configModel.ApplicationConfig, logger interfaces.Logger) interfaces.BreachedPasswords {
+	breachedPasswords := breach.NewFile(logger, config.Security.PasswordPolicy.BreachedPasswordsPath)
+	if validator.IsError(breachedPasswords.Error) {
+		logger.Panic(breachedPasswords.Error)
+	}
+
+	return breachedPasswords.Data
+}
+
+func NewRepositoryFactory(config *configModel.ApplicationConfig, logger interfaces.Logger, passwordHasher interfaces.PasswordHasher) interfaces.Repository {
 	switch config.Core.Database {
 	case constants.MongoDB:
-		return repository.NewMongoDBRepository(config, logger)
+		return repository.NewMongoDBRepository(config, logger, passwordHasher)
 	// Add other repository options here as needed.
 	default:
 		logger.Panic(domain.NewInternalError(location+"NewRepositoryFactory", fmt.Sprintf(constants.UnsupportedRepository, config.Core.Database)))
@@ -64,10 +166,10 @@ func NewRepositoryFactory(config *configModel.ApplicationConfig, logger interfac
 	}
 }
 
-func NewDeliveryFactory(ctx context.Context, config *configModel.ApplicationConfig, logger interfaces.Logger, repository interfaces.Repository) interfaces.Delivery {
+func NewDeliveryFactory(ctx context.Context, config *configModel.ApplicationConfig, logger interfaces.Logger, keyRings domainUtility.KeyRings, repository interfaces.Repository) interfaces.Delivery {
 	switch config.Core.Delivery {
 	case constants.Gin:
-		return delivery.NewGinDelivery(config, logger)
+		return delivery.NewGinDelivery(config, logger, keyRings)
 	// Add other delivery options here as needed.
 	default:
 		model.GracefulShutdown(ctx, logger, repository)
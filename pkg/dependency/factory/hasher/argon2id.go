@@ -0,0 +1,172 @@
+package hasher
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	argon2idPrefix     = "$argon2id$"
+	argon2idFormat     = "$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s"
+	argon2idParameters = "m=%d,t=%d,p=%d"
+	argon2idVersion    = "v=%d"
+
+	// The defaults follow the second recommended option of RFC 9106.
+	defaultArgon2idMemory      uint32 = 64 * 1024
+	defaultArgon2idIterations  uint32 = 3
+	defaultArgon2idParallelism uint8  = 4
+	defaultArgon2idSaltLength  uint32 = 16
+	defaultArgon2idKeyLength   uint32 = 32
+
+	minArgon2idSaltLength uint32 = 8
+	minArgon2idKeyLength  uint32 = 16
+
+	argon2idMemoryTooLow   = "the memory has to be at least 8 KiB per thread"
+	argon2idSaltTooShort   = "the salt has to be at least %d bytes long"
+	argon2idKeyTooShort    = "the key has to be at least %d bytes long"
+	argon2idMalformedHash  = "malformed argon2id hash"
+	argon2idUnknownVersion = "unsupported argon2id version"
+)
+
+// Argon2id hashes passwords in the PHC string format, $argon2id$v=19$m=65536,t=3,p=4$<salt>$<key>,
+// so the parameters of every hash are known when it is verified.
+type Argon2id struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+type argon2idHash struct {
+	Argon2id
+	Salt []byte
+	Key  []byte
+}
+
+// NewArgon2id creates the argon2id algorithm, the parameters that are not set get the defaults of RFC 9106.
+func NewArgon2id(logger interfaces.Logger, location string, argon2idConfig config.Argon2id) common.Result[Argon2id] {
+	argon2idAlgorithm := Argon2id{
+		Memory:      valueOrDefault(argon2idConfig.Memory, defaultArgon2idMemory),
+		Iterations:  valueOrDefault(argon2idConfig.Iterations, defaultArgon2idIterations),
+		Parallelism: valueOrDefault(argon2idConfig.Parallelism, defaultArgon2idParallelism),
+		SaltLength:  valueOrDefault(argon2idConfig.SaltLength, defaultArgon2idSaltLength),
+		KeyLength:   valueOrDefault(argon2idConfig.KeyLength, defaultArgon2idKeyLength),
+	}
+
+	notification := ""
+	switch {
+	case argon2idAlgorithm.Memory < 8*uint32(argon2idAlgorithm.Parallelism):
+		notification = argon2idMemoryTooLow
+	case argon2idAlgorithm.SaltLength < minArgon2idSaltLength:
+		notification = fmt.Sprintf(argon2idSaltTooShort, minArgon2idSaltLength)
+	case argon2idAlgorithm.KeyLength < minArgon2idKeyLength:
+		notification = fmt.Sprintf(argon2idKeyTooShort, minArgon2idKeyLength)
+	}
+	if validator.IsValueNotEmpty(notification) {
+		internalError := domain.NewInternalError(location+".NewArgon2id", fmt.Sprintf(invalidParameters, constants.Argon2id, notification))
+		logger.Error(internalError)
+		return common.NewResultOnFailure[Argon2id](internalError)
+	}
+
+	return common.NewResultOnSuccess[Argon2id](argon2idAlgorithm)
+}
+
+func (argon2idAlgorithm Argon2id) Hash(password string) (string, error) {
+	salt := make([]byte, argon2idAlgorithm.SaltLength)
+	_, readError := rand.Read(salt)
+	if validator.IsError(readError) {
+		return "", readError
+	}
+
+	key := argon2idAlgorithm.key(password, salt, argon2idAlgorithm.KeyLength)
+	return fmt.Sprintf(
+		argon2idFormat,
+		argon2.Version,
+		argon2idAlgorithm.Memory,
+		argon2idAlgorithm.Iterations,
+		argon2idAlgorithm.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Verify derives the key with the parameters and the salt of the hash and compares it in constant time.
+func (argon2idAlgorithm Argon2id) Verify(hashedPassword, password string) bool {
+	parsedHash, parseError := parseArgon2idHash(hashedPassword)
+	if validator.IsError(parseError) {
+		return false
+	}
+
+	key := parsedHash.key(password, parsedHash.Salt, uint32(len(parsedHash.Key)))
+	return subtle.ConstantTimeCompare(key, parsedHash.Key) == 1
+}
+
+func (argon2idAlgorithm Argon2id) Identifies(hashedPassword string) bool {
+	return strings.HasPrefix(hashedPassword, argon2idPrefix)
+}
+
+func (argon2idAlgorithm Argon2id) NeedsRehash(hashedPassword string) bool {
+	parsedHash, parseError := parseArgon2idHash(hashedPassword)
+	if validator.IsError(parseError) {
+		return true
+	}
+
+	parsedHash.SaltLength = uint32(len(parsedHash.Salt))
+	parsedHash.KeyLength = uint32(len(parsedHash.Key))
+	return parsedHash.Argon2id != argon2idAlgorithm
+}
+
+func (argon2idAlgorithm Argon2id) key(password string, salt []byte, keyLength uint32) []byte {
+	return argon2.IDKey([]byte(password), salt, argon2idAlgorithm.Iterations, argon2idAlgorithm.Memory, argon2idAlgorithm.Parallelism, keyLength)
+}
+
+// parseArgon2idHash splits the PHC string into the parameters, the salt and the key.
+func parseArgon2idHash(hashedPassword string) (argon2idHash, error) {
+	parsedHash := argon2idHash{}
+	parts := strings.Split(hashedPassword, "$")
+	if len(parts) != 6 || !strings.HasPrefix(hashedPassword, argon2idPrefix) {
+		return parsedHash, errors.New(argon2idMalformedHash)
+	}
+
+	var version int
+	_, versionError := fmt.Sscanf(parts[2], argon2idVersion, &version)
+	if validator.IsError(versionError) || version != argon2.Version {
+		return parsedHash, errors.New(argon2idUnknownVersion)
+	}
+
+	_, parametersError := fmt.Sscanf(parts[3], argon2idParameters, &parsedHash.Memory, &parsedHash.Iterations, &parsedHash.Parallelism)
+	if validator.IsError(parametersError) || parsedHash.Iterations == 0 || parsedHash.Parallelism == 0 {
+		return parsedHash, errors.New(argon2idMalformedHash)
+	}
+
+	salt, saltError := base64.RawStdEncoding.DecodeString(parts[4])
+	key, keyError := base64.RawStdEncoding.DecodeString(parts[5])
+	if validator.IsError(saltError) || validator.IsError(keyError) || len(key) == 0 {
+		return parsedHash, errors.New(argon2idMalformedHash)
+	}
+
+	parsedHash.Salt = salt
+	parsedHash.Key = key
+	return parsedHash, nil
+}
+
+func valueOrDefault[T uint8 | uint32](value, defaultValue T) T {
+	if value == 0 {
+		return defaultValue
+	}
+
+	return value
+}
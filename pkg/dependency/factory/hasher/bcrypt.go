@@ -0,0 +1,67 @@
+package hasher
+
+import (
+	"fmt"
+	"strings"
+
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	bcryptCostOutOfRange = "the cost has to be between %d and %d"
+)
+
+var (
+	// Bcrypt hashes use the modular crypt format, which the PHC string format is based on.
+	bcryptPrefixes = []string{"$2a$", "$2b$", "$2y$"}
+)
+
+type Bcrypt struct {
+	Cost int
+}
+
+// NewBcrypt creates the bcrypt algorithm, the cost defaults to bcrypt.DefaultCost.
+func NewBcrypt(logger interfaces.Logger, location string, bcryptConfig config.Bcrypt) common.Result[Bcrypt] {
+	cost := bcryptConfig.Cost
+	if cost == 0 {
+		cost = bcrypt.DefaultCost
+	}
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		notification := fmt.Sprintf(bcryptCostOutOfRange, bcrypt.MinCost, bcrypt.MaxCost)
+		internalError := domain.NewInternalError(location+".NewBcrypt.Cost", fmt.Sprintf(invalidParameters, constants.Bcrypt, notification))
+		logger.Error(internalError)
+		return common.NewResultOnFailure[Bcrypt](internalError)
+	}
+
+	return common.NewResultOnSuccess[Bcrypt](Bcrypt{Cost: cost})
+}
+
+func (bcryptAlgorithm Bcrypt) Hash(password string) (string, error) {
+	hashedPassword, generateFromPasswordError := bcrypt.GenerateFromPassword([]byte(password), bcryptAlgorithm.Cost)
+	return string(hashedPassword), generateFromPasswordError
+}
+
+func (bcryptAlgorithm Bcrypt) Verify(hashedPassword, password string) bool {
+	return !validator.IsError(bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password)))
+}
+
+func (bcryptAlgorithm Bcrypt) Identifies(hashedPassword string) bool {
+	for _, prefix := range bcryptPrefixes {
+		if strings.HasPrefix(hashedPassword, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (bcryptAlgorithm Bcrypt) NeedsRehash(hashedPassword string) bool {
+	cost, costError := bcrypt.Cost([]byte(hashedPassword))
+	return validator.IsError(costError) || cost != bcryptAlgorithm.Cost
+}
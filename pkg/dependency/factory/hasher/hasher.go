@@ -0,0 +1,64 @@
+package hasher
+
+import (
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	location          = "pkg.dependency.factory.hasher."
+	invalidParameters = "Invalid %s parameters: %s"
+)
+
+// Algorithm is a password hashing algorithm, its hashes are recognized by their prefix.
+type Algorithm interface {
+	Hash(password string) (string, error)
+	Verify(hashedPassword, password string) bool
+	Identifies(hashedPassword string) bool
+	NeedsRehash(hashedPassword string) bool
+}
+
+// PasswordHasher hashes new passwords with the current algorithm. The other algorithms only verify the hashes
+// created before the configuration was changed, so their users can still sign in and get their passwords rehashed.
+type PasswordHasher struct {
+	Logger     interfaces.Logger
+	Current    Algorithm
+	Algorithms []Algorithm
+}
+
+func NewPasswordHasher(logger interfaces.Logger, current Algorithm, others ...Algorithm) PasswordHasher {
+	return PasswordHasher{
+		Logger:     logger,
+		Current:    current,
+		Algorithms: append([]Algorithm{current}, others...),
+	}
+}
+
+func (passwordHasher PasswordHasher) HashPassword(location, password string) common.Result[string] {
+	hashedPassword, hashError := passwordHasher.Current.Hash(password)
+	if validator.IsError(hashError) {
+		internalError := domain.NewInternalError(location+".HashPassword.Hash", hashError.Error())
+		passwordHasher.Logger.Error(internalError)
+		return common.NewResultOnFailure[string](internalError)
+	}
+
+	return common.NewResultOnSuccess[string](hashedPassword)
+}
+
+// VerifyPassword checks the password against a hash of any of the supported algorithms.
+func (passwordHasher PasswordHasher) VerifyPassword(hashedPassword, password string) bool {
+	for _, algorithm := range passwordHasher.Algorithms {
+		if algorithm.Identifies(hashedPassword) {
+			return algorithm.Verify(hashedPassword, password)
+		}
+	}
+
+	return false
+}
+
+// NeedsRehash reports whether the hash was created with another algorithm or other parameters than the current ones.
+func (passwordHasher PasswordHasher) NeedsRehash(hashedPassword string) bool {
+	return !passwordHasher.Current.Identifies(hashedPassword) || passwordHasher.Current.NeedsRehash(hashedPassword)
+}
@@ -0,0 +1,94 @@
+package oauth
+
+import (
+	"context"
+	"strconv"
+
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	gitHubAuthorizationEndpoint = "https://github.com/login/oauth/authorize"
+	gitHubTokenEndpoint         = "https://github.com/login/oauth/access_token"
+	gitHubAPIURL                = "https://api.github.com"
+	gitHubUserPath              = "/user"
+	gitHubEmailsPath            = "/user/emails"
+)
+
+var (
+	gitHubDefaultScopes = []string{"read:user", "user:email"}
+)
+
+// GitHub is the GitHub provider. GitHub doesn't support OpenID Connect, the identity is read from its API,
+// and the email is taken from the primary address of the account, with the verification GitHub reports for it.
+type GitHub struct {
+	Client
+	Name                  string
+	AuthorizationEndpoint string
+	TokenEndpoint         string
+	APIURL                string
+}
+
+type gitHubUser struct {
+	ID    int64  `json:"id"`
+	Login string `json:"login"`
+}
+
+type gitHubEmail struct {
+	Email    string `json:"email"`
+	Primary  bool   `json:"primary"`
+	Verified bool   `json:"verified"`
+}
+
+func NewGitHub(logger interfaces.Logger, provider config.OAuthProvider) GitHub {
+	return GitHub{
+		Client:                NewClient(logger, provider, gitHubDefaultScopes),
+		Name:                  provider.Name,
+		AuthorizationEndpoint: gitHubAuthorizationEndpoint,
+		TokenEndpoint:         gitHubTokenEndpoint,
+		APIURL:                gitHubAPIURL,
+	}
+}
+
+func (gitHub GitHub) AuthorizationURL(ctx context.Context, state, codeChallenge string) common.Result[string] {
+	return common.NewResultOnSuccess[string](gitHub.authorizationURL(gitHub.AuthorizationEndpoint, state, codeChallenge))
+}
+
+func (gitHub GitHub) Exchange(ctx context.Context, code, codeVerifier string) common.Result[user.OAuthIdentity] {
+	accessToken := gitHub.exchangeCode(ctx, location+"GitHub.Exchange", gitHub.TokenEndpoint, code, codeVerifier)
+	if validator.IsError(accessToken.Error) {
+		return common.NewResultOnFailure[user.OAuthIdentity](accessToken.Error)
+	}
+
+	var fetchedUser gitHubUser
+	getUserError := gitHub.getJSON(ctx, location+"GitHub.Exchange", gitHub.APIURL+gitHubUserPath, accessToken.Data, &fetchedUser)
+	if validator.IsError(getUserError) {
+		return common.NewResultOnFailure[user.OAuthIdentity](getUserError)
+	}
+	if fetchedUser.ID == 0 {
+		internalError := domain.NewInternalError(location+"GitHub.Exchange.ID", missingSubject)
+		gitHub.Logger.Error(internalError)
+		return common.NewResultOnFailure[user.OAuthIdentity](internalError)
+	}
+
+	var fetchedEmails []gitHubEmail
+	getEmailsError := gitHub.getJSON(ctx, location+"GitHub.Exchange", gitHub.APIURL+gitHubEmailsPath, accessToken.Data, &fetchedEmails)
+	if validator.IsError(getEmailsError) {
+		return common.NewResultOnFailure[user.OAuthIdentity](getEmailsError)
+	}
+
+	identity := user.NewOAuthIdentity(gitHub.Name, strconv.FormatInt(fetchedUser.ID, 10), "", false, fetchedUser.Login)
+	for _, fetchedEmail := range fetchedEmails {
+		if fetchedEmail.Primary {
+			identity.Email = fetchedEmail.Email
+			identity.EmailVerified = fetchedEmail.Verified
+		}
+	}
+
+	return common.NewResultOnSuccess[user.OAuthIdentity](identity)
+}
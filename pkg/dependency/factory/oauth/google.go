@@ -0,0 +1,20 @@
+package oauth
+
+import (
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	googleIssuerURL = "https://accounts.google.com"
+)
+
+// NewGoogle returns the Google provider, an OpenID Connect provider whose issuer is known in advance.
+func NewGoogle(logger interfaces.Logger, provider config.OAuthProvider) OIDC {
+	if validator.IsValueEmpty(provider.IssuerURL) {
+		provider.IssuerURL = googleIssuerURL
+	}
+
+	return NewOIDC(logger, provider)
+}
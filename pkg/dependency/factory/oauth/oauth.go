@@ -0,0 +1,164 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	domainUtility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	location = "pkg.dependency.factory.oauth."
+
+	responseTypeCode           = "code"
+	grantTypeAuthorizationCode = "authorization_code"
+	acceptHeader               = "Accept"
+	applicationJSON            = "application/json"
+	applicationForm            = "application/x-www-form-urlencoded"
+
+	unexpectedStatus = "the provider responded with the status %d"
+	tokenRejected    = "the provider rejected the authorization code: %s"
+)
+
+// Client holds the registration of the application at a provider and talks to its endpoints.
+type Client struct {
+	Logger       interfaces.Logger
+	HTTPClient   *http.Client
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func NewClient(logger interfaces.Logger, provider config.OAuthProvider, defaultScopes []string) Client {
+	scopes := provider.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultScopes
+	}
+
+	return Client{
+		Logger:       logger,
+		HTTPClient:   &http.Client{Timeout: constants.DefaultContextTimer},
+		ClientID:     provider.ClientID,
+		ClientSecret: provider.ClientSecret,
+		RedirectURL:  provider.RedirectURL,
+		Scopes:       scopes,
+	}
+}
+
+// authorizationURL builds the URL the user is redirected to, carrying the state and the S256 code challenge.
+func (client Client) authorizationURL(authorizationEndpoint, state, codeChallenge string) string {
+	query := url.Values{}
+	query.Set("response_type", responseTypeCode)
+	query.Set("client_id", client.ClientID)
+	query.Set("redirect_uri", client.RedirectURL)
+	query.Set("scope", strings.Join(client.Scopes, " "))
+	query.Set("state", state)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", domainUtility.CodeChallengeMethod)
+
+	separator := "?"
+	if strings.Contains(authorizationEndpoint, "?") {
+		separator = "&"
+	}
+
+	return authorizationEndpoint + separator + query.Encode()
+}
+
+// exchangeCode redeems the authorization code together with the code verifier for an access token.
+func (client Client) exchangeCode(ctx context.Context, location, tokenEndpoint, code, codeVerifier string) common.Result[string] {
+	form := url.Values{}
+	form.Set("grant_type", grantTypeAuthorizationCode)
+	form.Set("code", code)
+	form.Set("redirect_uri", client.RedirectURL)
+	form.Set("client_id", client.ClientID)
+	form.Set("client_secret", client.ClientSecret)
+	form.Set("code_verifier", codeVerifier)
+
+	request, newRequestError := http.NewRequestWithContext(ctx, http.MethodPost, tokenEndpoint, strings.NewReader(form.Encode()))
+	if validator.IsError(newRequestError) {
+		internalError := domain.NewInternalError(location+".exchangeCode.NewRequestWithContext", newRequestError.Error())
+		client.Logger.Error(internalError)
+		return common.NewResultOnFailure[string](internalError)
+	}
+	request.Header.Set(constants.ContentType, applicationForm)
+	request.Header.Set(acceptHeader, applicationJSON)
+
+	var token tokenResponse
+	statusCode := client.do(location+".exchangeCode", request, &token)
+	if validator.IsError(statusCode.Error) {
+		return common.NewResultOnFailure[string](statusCode.Error)
+	}
+
+	// Some providers, GitHub among them, report a rejected code with a successful status.
+	if statusCode.Data != http.StatusOK || validator.IsValueNotEmpty(token.Error) || validator.IsValueEmpty(token.AccessToken) {
+		invalidTokenError := domain.NewInvalidTokenError(location+".exchangeCode.AccessToken", fmt.Sprintf(tokenRejected, token.Error+" "+token.ErrorDescription))
+		client.Logger.Error(invalidTokenError)
+		invalidTokenError.Notification = constants.InvalidTokenErrorMessage
+		return common.NewResultOnFailure[string](invalidTokenError)
+	}
+
+	return common.NewResultOnSuccess[string](token.AccessToken)
+}
+
+// getJSON decodes the response of an endpoint into the target, authorized with the access token if it is provided.
+func (client Client) getJSON(ctx context.Context, location, endpoint, accessToken string, target any) error {
+	request, newRequestError := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if validator.IsError(newRequestError) {
+		internalError := domain.NewInternalError(location+".getJSON.NewRequestWithContext", newRequestError.Error())
+		client.Logger.Error(internalError)
+		return internalError
+	}
+	request.Header.Set(acceptHeader, applicationJSON)
+	if validator.IsValueNotEmpty(accessToken) {
+		request.Header.Set(constants.Authorization, constants.Bearer+accessToken)
+	}
+
+	statusCode := client.do(location+".getJSON", request, target)
+	if validator.IsError(statusCode.Error) {
+		return statusCode.Error
+	}
+	if statusCode.Data != http.StatusOK {
+		internalError := domain.NewInternalError(location+".getJSON.StatusCode", fmt.Sprintf(unexpectedStatus, statusCode.Data))
+		client.Logger.Error(internalError)
+		return internalError
+	}
+
+	return nil
+}
+
+// do sends the request and decodes the JSON response into the target, it returns the status code of the response.
+func (client Client) do(location string, request *http.Request, target any) common.Result[int] {
+	response, doError := client.HTTPClient.Do(request)
+	if validator.IsError(doError) {
+		internalError := domain.NewInternalError(location+".do.Do", doError.Error())
+		client.Logger.Error(internalError)
+		return common.NewResultOnFailure[int](internalError)
+	}
+	defer response.Body.Close()
+
+	decodeError := json.NewDecoder(response.Body).Decode(target)
+	if validator.IsError(decodeError) {
+		internalError := domain.NewInternalError(location+".do.Decode", decodeError.Error())
+		client.Logger.Error(internalError)
+		return common.NewResultOnFailure[int](internalError)
+	}
+
+	return common.NewResultOnSuccess[int](response.StatusCode)
+}
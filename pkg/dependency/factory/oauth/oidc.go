@@ -0,0 +1,135 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	discoveryPath = "/.well-known/openid-configuration"
+
+	issuerMismatch  = "the discovery document belongs to the issuer %s instead of %s"
+	missingEndpoint = "the discovery document of %s misses the authorization, token or userinfo endpoint"
+	missingSubject  = "the provider responded without the subject of the user"
+)
+
+var (
+	oidcDefaultScopes = []string{"openid", "email", "profile"}
+)
+
+// OIDC is a generic OpenID Connect provider. Its endpoints are discovered from the issuer on first use
+// and cached afterwards, so the application starts even while the provider is unreachable.
+// The identity is read from the userinfo endpoint with the access token received directly from the provider.
+type OIDC struct {
+	Client
+	Name      string
+	IssuerURL string
+	discovery *oidcDiscovery
+}
+
+type oidcDiscovery struct {
+	mutex    sync.Mutex
+	document *discoveryDocument
+}
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+type userinfoResponse struct {
+	Subject           string `json:"sub"`
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	PreferredUsername string `json:"preferred_username"`
+	Name              string `json:"name"`
+}
+
+func NewOIDC(logger interfaces.Logger, provider config.OAuthProvider) OIDC {
+	return OIDC{
+		Client:    NewClient(logger, provider, oidcDefaultScopes),
+		Name:      provider.Name,
+		IssuerURL: strings.TrimSuffix(provider.IssuerURL, "/"),
+		discovery: &oidcDiscovery{},
+	}
+}
+
+func (oidc OIDC) AuthorizationURL(ctx context.Context, state, codeChallenge string) common.Result[string] {
+	document := oidc.discover(ctx)
+	if validator.IsError(document.Error) {
+		return common.NewResultOnFailure[string](document.Error)
+	}
+
+	return common.NewResultOnSuccess[string](oidc.authorizationURL(document.Data.AuthorizationEndpoint, state, codeChallenge))
+}
+
+func (oidc OIDC) Exchange(ctx context.Context, code, codeVerifier string) common.Result[user.OAuthIdentity] {
+	document := oidc.discover(ctx)
+	if validator.IsError(document.Error) {
+		return common.NewResultOnFailure[user.OAuthIdentity](document.Error)
+	}
+
+	accessToken := oidc.exchangeCode(ctx, location+"OIDC.Exchange", document.Data.TokenEndpoint, code, codeVerifier)
+	if validator.IsError(accessToken.Error) {
+		return common.NewResultOnFailure[user.OAuthIdentity](accessToken.Error)
+	}
+
+	var userinfo userinfoResponse
+	getUserinfoError := oidc.getJSON(ctx, location+"OIDC.Exchange", document.Data.UserinfoEndpoint, accessToken.Data, &userinfo)
+	if validator.IsError(getUserinfoError) {
+		return common.NewResultOnFailure[user.OAuthIdentity](getUserinfoError)
+	}
+	if validator.IsValueEmpty(userinfo.Subject) {
+		internalError := domain.NewInternalError(location+"OIDC.Exchange.Subject", missingSubject)
+		oidc.Logger.Error(internalError)
+		return common.NewResultOnFailure[user.OAuthIdentity](internalError)
+	}
+
+	username := userinfo.PreferredUsername
+	if validator.IsValueEmpty(username) {
+		username = userinfo.Name
+	}
+
+	return common.NewResultOnSuccess[user.OAuthIdentity](user.NewOAuthIdentity(oidc.Name, userinfo.Subject, userinfo.Email, userinfo.EmailVerified, username))
+}
+
+// discover fetches the discovery document of the issuer, a failed attempt is retried on the next login.
+func (oidc OIDC) discover(ctx context.Context) common.Result[discoveryDocument] {
+	oidc.discovery.mutex.Lock()
+	defer oidc.discovery.mutex.Unlock()
+	if oidc.discovery.document != nil {
+		return common.NewResultOnSuccess[discoveryDocument](*oidc.discovery.document)
+	}
+
+	var document discoveryDocument
+	getDocumentError := oidc.getJSON(ctx, location+"OIDC.discover", oidc.IssuerURL+discoveryPath, "", &document)
+	if validator.IsError(getDocumentError) {
+		return common.NewResultOnFailure[discoveryDocument](getDocumentError)
+	}
+
+	// The issuer must match, otherwise another provider could hand out identities in its name (OpenID Connect Discovery 4.3).
+	if strings.TrimSuffix(document.Issuer, "/") != oidc.IssuerURL {
+		internalError := domain.NewInternalError(location+"OIDC.discover.Issuer", fmt.Sprintf(issuerMismatch, document.Issuer, oidc.IssuerURL))
+		oidc.Logger.Error(internalError)
+		return common.NewResultOnFailure[discoveryDocument](internalError)
+	}
+	if validator.IsValueEmpty(document.AuthorizationEndpoint) || validator.IsValueEmpty(document.TokenEndpoint) || validator.IsValueEmpty(document.UserinfoEndpoint) {
+		internalError := domain.NewInternalError(location+"OIDC.discover.Endpoints", fmt.Sprintf(missingEndpoint, oidc.IssuerURL))
+		oidc.Logger.Error(internalError)
+		return common.NewResultOnFailure[discoveryDocument](internalError)
+	}
+
+	oidc.discovery.document = &document
+	return common.NewResultOnSuccess[discoveryDocument](document)
+}
@@ -9,12 +9,14 @@ type Container struct {
 	Logger     interfaces.Logger     // Interface for creating a logger instance.
 	Repository interfaces.Repository // Interface for creating repository instances.
 	Delivery   interfaces.Delivery   // Interface for creating delivery components and initializing the server.
+	Scheduler  interfaces.Scheduler  // Interface for the background jobs, like publishing the scheduled posts.
 } // Add other dependencies as needed.
 
-func NewContainer(logger interfaces.Logger, repository interfaces.Repository, delivery interfaces.Delivery) Container {
+func NewContainer(logger interfaces.Logger, repository interfaces.Repository, delivery interfaces.Delivery, scheduler interfaces.Scheduler) Container {
 	return Container{
 		Logger:     logger,
 		Repository: repository,
 		Delivery:   delivery,
+		Scheduler:  scheduler,
 	} // Add other dependencies as needed.
 }
@@ -8,21 +8,63 @@ type UserController interface {
 	GetAllUsers(controllerContext any)
 	GetCurrentUser(controllerContext any)
 	GetUserById(controllerContext any)
+	GetUserByHandle(controllerContext any)
 	Register(controllerContext any)
 	UpdateCurrentUser(controllerContext any)
+	UpdatePassword(controllerContext any)
+	RequestEmailChange(controllerContext any)
+	ConfirmEmailChange(controllerContext any)
+	CancelEmailChange(controllerContext any)
 	DeleteCurrentUser(controllerContext any)
 	Login(controllerContext any)
+	VerifyTwoFactorLogin(controllerContext any)
+	RequestMagicLink(controllerContext any)
+	LoginWithMagicLink(controllerContext any)
+	EnrollTwoFactor(controllerContext any)
+	ConfirmTwoFactor(controllerContext any)
+	DisableTwoFactor(controllerContext any)
 	RefreshAccessToken(controllerContext any)
 	Logout(controllerContext any)
 	ForgottenPassword(controllerContext any)
 	ResetUserPassword(controllerContext any)
+	VerifyEmail(controllerContext any)
+	ResendVerificationCode(controllerContext any)
+	UnlockAccount(controllerContext any)
+	GetSessions(controllerContext any)
+	RevokeSession(controllerContext any)
+	RevokeAllSessions(controllerContext any)
+	CreatePersonalAccessToken(controllerContext any)
+	GetPersonalAccessTokens(controllerContext any)
+	RevokePersonalAccessToken(controllerContext any)
+	StartOAuthLogin(controllerContext any)
+	CompleteOAuthLogin(controllerContext any)
+	GetJSONWebKeySet(controllerContext any)
+}
+
+type AdminController interface {
+	GetAllUsers(controllerContext any)
+	GetUserById(controllerContext any)
+	UpdateUserRole(controllerContext any)
+	VerifyUser(controllerContext any)
+	UnverifyUser(controllerContext any)
+	SuspendUser(controllerContext any)
+	UnsuspendUser(controllerContext any)
+	ForcePasswordReset(controllerContext any)
+	DeleteUserById(controllerContext any)
+	CreateInvitation(controllerContext any)
+	GetInvitations(controllerContext any)
+	RevokeInvitation(controllerContext any)
 }
 
 type PostController interface {
 	GetAllPosts(controllerContext any)
 	GetPostById(controllerContext any)
+	GetPostBySlug(controllerContext any)
 	CreatePost(controllerContext any)
 	UpdatePostById(controllerContext any)
+	PublishPost(controllerContext any)
+	UnpublishPost(controllerContext any)
+	ArchivePost(controllerContext any)
 	DeletePostByID(controllerContext any)
 }
 
@@ -3,7 +3,9 @@ package interfaces
 import (
 	"context"
 
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
 	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
 )
 
 type Logger interface {
@@ -20,6 +22,25 @@ type Email interface {
 	SendEmail(config *config.ApplicationConfig, logger Logger, location string, data any, emailData EmailData) error
 }
 
+// OAuthProvider is an external identity provider signing users in with the OAuth 2.0 authorization code flow and PKCE.
+type OAuthProvider interface {
+	AuthorizationURL(ctx context.Context, state, codeChallenge string) common.Result[string]
+	Exchange(ctx context.Context, code, codeVerifier string) common.Result[user.OAuthIdentity]
+}
+
+// PasswordHasher hashes passwords with the configured algorithm and verifies the hashes of every supported algorithm.
+type PasswordHasher interface {
+	HashPassword(location, password string) common.Result[string]
+	VerifyPassword(hashedPassword, password string) bool
+	NeedsRehash(hashedPassword string) bool
+}
+
+// BreachedPasswords looks up passwords known from data breaches with the k-anonymity model,
+// only the prefix of the SHA-1 hash is passed and the suffixes of the breached hashes sharing it are returned.
+type BreachedPasswords interface {
+	GetHashSuffixes(ctx context.Context, hashPrefix string) common.Result[[]string]
+}
+
 type Repository interface {
 	CreateRepository(ctx context.Context) any
 	NewRepository(createRepository any, repository any) any
@@ -33,11 +54,17 @@ type Delivery interface {
 	NewHealthCheckController(repository Repository) any
 	NewController(useCase any) any
 	NewHealthRouter(router any, repository Repository) Router
-	NewRouter(router any) Router
+	NewRouter(router any, sessionValidator SessionValidator) Router
 	LaunchServer(ctx context.Context, repository Repository)
 	Close
 }
 
+// Scheduler runs background jobs periodically from Start until it is closed.
+type Scheduler interface {
+	Start(ctx context.Context)
+	Close
+}
+
 // Close is an interface that defines a method for closing resources or services.
 type Close interface {
 	Close(ctx context.Context) // Closes resources or services.
@@ -3,14 +3,16 @@ package interfaces
 type ServerRouters struct {
 	HealthCheckRouter Router
 	UserRouter        Router
+	AdminRouter       Router
 	PostRouter        Router
 	// Add other routers as needed.
 }
 
-func NewServerRouters(healthCheckRouter, userRouter, postRouter Router) ServerRouters {
+func NewServerRouters(healthCheckRouter, userRouter, adminRouter, postRouter Router) ServerRouters {
 	return ServerRouters{
 		HealthCheckRouter: healthCheckRouter,
 		UserRouter:        userRouter,
+		AdminRouter:       adminRouter,
 		PostRouter:        postRouter,
 		// Add other routers as needed.
 	}
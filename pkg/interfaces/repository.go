@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	post "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/model"
 	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
@@ -10,21 +11,97 @@ import (
 
 type UserRepository interface {
 	GetAllUsers(ctx context.Context, paginationQuery common.PaginationQuery) common.Result[user.Users]
+	GetAllUsersByFilter(ctx context.Context, paginationQuery common.PaginationQuery, userFilter user.UserFilter) common.Result[user.Users]
 	GetUserById(ctx context.Context, userID string) common.Result[user.User]
+	CountUsersByRole(ctx context.Context, role string) common.Result[int64]
 	GetUserByEmail(ctx context.Context, email string) common.Result[user.User]
+	GetUserByHandle(ctx context.Context, handle string) common.Result[user.User]
 	CheckEmailDuplicate(ctx context.Context, email string) error
+	CheckUsernameDuplicate(ctx context.Context, userID, handle string) error
 	Register(ctx context.Context, user user.UserCreate) common.Result[user.User]
 	UpdateCurrentUser(ctx context.Context, user user.UserUpdate) common.Result[user.User]
+	UpdatePassword(ctx context.Context, userPasswordUpdate user.UserPasswordUpdate) error
+	RehashPassword(ctx context.Context, userID, currentHashedPassword, password string) error
+	RequestEmailChange(ctx context.Context, userEmailChange user.UserEmailChange) error
+	GetEmailChange(ctx context.Context, confirmationToken string) common.Result[user.UserEmailChange]
+	ConfirmEmailChange(ctx context.Context, userEmailChange user.UserEmailChange) error
+	CancelEmailChange(ctx context.Context, cancelToken string) error
 	DeleteUserById(ctx context.Context, userID string) error
 	ForgottenPassword(ctx context.Context, userForgottenPassword user.UserForgottenPassword) error
 	ResetUserPassword(ctx context.Context, userResetPassword user.UserResetPassword) error
 	GetResetExpiry(ctx context.Context, token string) common.Result[user.UserResetExpiry]
+	GetVerificationExpiry(ctx context.Context, verificationCode string) common.Result[user.UserVerificationExpiry]
+	UpdateVerificationCode(ctx context.Context, userVerificationCode user.UserVerificationCode) error
+	VerifyEmail(ctx context.Context, verificationCode string) error
+	UpdateUserRole(ctx context.Context, userRoleUpdate user.UserRoleUpdate) error
+	UpdateUserVerification(ctx context.Context, userID string, verified bool) error
+	SuspendUser(ctx context.Context, userSuspension user.UserSuspension) error
+	UnsuspendUser(ctx context.Context, userID string) error
+	LiftExpiredSuspension(ctx context.Context, userID string) error
+	EnrollTwoFactor(ctx context.Context, userTwoFactorEnrollment user.UserTwoFactorEnrollment) error
+	EnableTwoFactor(ctx context.Context, userID string) error
+	DisableTwoFactor(ctx context.Context, userID string) error
+	UpdateTwoFactorLastStep(ctx context.Context, userID string, step int64) error
+	UpdateMFAToken(ctx context.Context, userMFAToken user.UserMFAToken) error
+	GetUserByMFAToken(ctx context.Context, mfaToken string) common.Result[user.User]
+	CompleteTwoFactorLogin(ctx context.Context, mfaToken, recoveryCode string) error
+	UpdateMagicLinkToken(ctx context.Context, userMagicLink user.UserMagicLink) error
+	ConsumeMagicLinkToken(ctx context.Context, magicLinkToken string) common.Result[user.User]
+}
+
+type RefreshTokenRepository interface {
+	CreateRefreshToken(ctx context.Context, refreshTokenCreate user.RefreshTokenCreate) common.Result[user.RefreshToken]
+	GetRefreshTokenByTokenID(ctx context.Context, tokenID string) common.Result[user.RefreshToken]
+	RotateRefreshToken(ctx context.Context, tokenID, replacedByTokenID string) error
+	RevokeRefreshToken(ctx context.Context, tokenID string) error
+	RevokeRefreshTokenFamily(ctx context.Context, familyID string) error
+	GetActiveSessions(ctx context.Context, userID string) common.Result[user.UserSessions]
+	CheckActiveSession(ctx context.Context, userID, sessionID string) error
+	RevokeSession(ctx context.Context, userID, sessionID string) error
+	RevokeAllSessions(ctx context.Context, userID string) error
+	RevokeOtherSessions(ctx context.Context, userID, currentSessionID string) error
+}
+
+type PersonalAccessTokenRepository interface {
+	CreatePersonalAccessToken(ctx context.Context, personalAccessTokenCreate user.PersonalAccessTokenCreate) common.Result[user.PersonalAccessToken]
+	GetPersonalAccessTokens(ctx context.Context, userID string) common.Result[user.PersonalAccessTokens]
+	GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) common.Result[user.PersonalAccessToken]
+	UpdatePersonalAccessTokenLastUsedAt(ctx context.Context, personalAccessTokenID string, lastUsedAt time.Time) error
+	RevokePersonalAccessToken(ctx context.Context, userID, personalAccessTokenID string) error
+}
+
+// OAuthRepository stores the pending social logins and the external identities linked to users.
+type OAuthRepository interface {
+	CreateOAuthState(ctx context.Context, oauthState user.OAuthState) error
+	ConsumeOAuthState(ctx context.Context, stateHash string) common.Result[user.OAuthState]
+	GetUserIdentity(ctx context.Context, provider, subject string) common.Result[user.UserIdentity]
+	CreateUserIdentity(ctx context.Context, userIdentityCreate user.UserIdentityCreate) common.Result[user.UserIdentity]
+}
+
+// InvitationRepository stores the invitations that allow registering while the registration is invite-only.
+type InvitationRepository interface {
+	CreateInvitation(ctx context.Context, invitationCreate user.InvitationCreate) common.Result[user.Invitation]
+	GetInvitations(ctx context.Context) common.Result[user.Invitations]
+	RevokeInvitation(ctx context.Context, invitationID string) error
+	UseInvitation(ctx context.Context, codeHash string) error
+	ReleaseInvitation(ctx context.Context, codeHash string) error
+}
+
+type LoginAttemptRepository interface {
+	GetLoginAttempt(ctx context.Context, key string) common.Result[user.LoginAttempt]
+	IncrementFailedLoginAttempts(ctx context.Context, key string, expiresAt time.Time) common.Result[user.LoginAttempt]
+	BlockLoginAttempts(ctx context.Context, loginAttemptBlock user.LoginAttemptBlock) error
+	ResetLoginAttempts(ctx context.Context, key string) error
+	UnlockLoginAttempts(ctx context.Context, unlockToken string) error
 }
 
 type PostRepository interface {
-	GetAllPosts(ctx context.Context, page int, limit int) (*post.Posts, error)
-	GetPostById(ctx context.Context, postID string) (*post.Post, error)
-	CreatePost(ctx context.Context, user *post.PostCreate) (*post.Post, error)
-	UpdatePostById(ctx context.Context, postID string, post *post.PostUpdate) (*post.Post, error)
+	GetAllPosts(ctx context.Context, paginationQuery common.PaginationQuery, postFilter post.PostFilter) common.Result[post.Posts]
+	GetPostById(ctx context.Context, postID string) common.Result[post.Post]
+	GetPostBySlug(ctx context.Context, slug string) common.Result[post.Post]
+	CreatePost(ctx context.Context, postCreate post.PostCreate) common.Result[post.Post]
+	UpdatePostById(ctx context.Context, postUpdate post.PostUpdate) common.Result[post.Post]
+	ChangePostStatus(ctx context.Context, postStatusUpdate post.PostStatusUpdate) common.Result[post.Post]
+	PublishScheduledPosts(ctx context.Context, now time.Time) common.Result[int64]
 	DeletePostByID(ctx context.Context, postID string) error
 }
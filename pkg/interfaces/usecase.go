@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+)
+
+// SessionValidator checks that the session an authenticated token belongs to is still active
+// and that its user is allowed to sign in. Personal access tokens are validated without a session.
+type SessionValidator interface {
+	ValidateSession(ctx context.Context, userID, sessionID string) error
+	ValidatePersonalAccessToken(ctx context.Context, token string) common.Result[user.UserTokenPayload]
+}
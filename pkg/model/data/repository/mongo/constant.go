@@ -6,4 +6,17 @@ const (
 	ID                      = "_id"
 	Set                     = "$set"
 	Unset                   = "$unset"
+	SetOnInsert             = "$setOnInsert"
+	Increment               = "$inc"
+	Max                     = "$max"
+	Pull                    = "$pull"
+	NotEqual                = "$ne"
+	GreaterThan             = "$gt"
+	GreaterThanOrEqual      = "$gte"
+	LessThan                = "$lt"
+	LessThanOrEqual         = "$lte"
+	Expression              = "$expr"
+	Exists                  = "$exists"
+	Or                      = "$or"
+	In                      = "$in"
 )
@@ -1,6 +1,8 @@
 package http
 
 import (
+	"net/http"
+
 	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
 	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
 )
@@ -15,10 +17,20 @@ func HandleError(err error) error {
 		return AuthorizationErrorToHTTPAuthorizationErrorMapper(errorType)
 	case domain.ItemNotFoundError:
 		return ItemNotFoundErrorToHTTPItemNotFoundErrorMapper(errorType)
+	case domain.ConflictError:
+		return ConflictErrorToHTTPConflictErrorMapper(errorType)
 	case domain.InvalidTokenError:
 		return InvalidTokenErrorToHTTPIvalidTokenErrorMapper(errorType)
 	case domain.TimeExpiredError:
 		return TimeExpiredErrorToHTTPTimeExpiredErrorMapper(errorType)
+	case domain.EmailNotVerifiedError:
+		return EmailNotVerifiedErrorToHTTPEmailNotVerifiedErrorMapper(errorType)
+	case domain.MustResetPasswordError:
+		return MustResetPasswordErrorToHTTPMustResetPasswordErrorMapper(errorType)
+	case domain.UserSuspendedError:
+		return UserSuspendedErrorToHTTPUserSuspendedErrorMapper(errorType)
+	case domain.TooManyRequestsError:
+		return TooManyRequestsErrorToHTTPTooManyRequestsErrorMapper(errorType)
 	case domain.PaginationError:
 		return PaginationErrorToHTTPPaginationErrorMapper(errorType)
 	case domain.InternalError:
@@ -30,3 +42,22 @@ func HandleError(err error) error {
 		return errorType
 	}
 }
+
+// StatusCode returns the HTTP status code of an error handled by HandleError.
+// Errors without a status of their own are answered with 400 Bad Request.
+func StatusCode(httpError error) int {
+	switch httpError.(type) {
+	case HTTPAuthorizationError:
+		return http.StatusForbidden
+	case HTTPItemNotFoundError:
+		return http.StatusNotFound
+	case HTTPConflictError:
+		return http.StatusConflict
+	case HTTPTooManyRequestsError:
+		return http.StatusTooManyRequests
+	case HTTPInternalError:
+		return http.StatusInternalServerError
+	default:
+		return http.StatusBadRequest
+	}
+}
@@ -103,6 +103,22 @@ func (httpItemNotFoundError HTTPItemNotFoundError) Error() string {
 	return fmt.Sprintf("notification: %s", httpItemNotFoundError.Notification)
 }
 
+type HTTPConflictError struct {
+	Field string `json:"field"`
+	HTTPBaseError
+}
+
+func NewHTTPConflictError(field, notification string) HTTPConflictError {
+	return HTTPConflictError{
+		Field:         field,
+		HTTPBaseError: NewHTTPBaseError(notification),
+	}
+}
+
+func (httpConflictError HTTPConflictError) Error() string {
+	return fmt.Sprintf("field: %s notification: %s", httpConflictError.Field, httpConflictError.Notification)
+}
+
 type HTTPInvalidTokenError struct {
 	HTTPBaseError
 }
@@ -129,6 +145,77 @@ func (httpTimeExpiredError HTTPTimeExpiredError) Error() string {
 	return fmt.Sprintf("notification: %s", httpTimeExpiredError.Notification)
 }
 
+type HTTPEmailNotVerifiedError struct {
+	HTTPBaseError
+}
+
+func NewHTTPEmailNotVerifiedError(notification string) HTTPEmailNotVerifiedError {
+	return HTTPEmailNotVerifiedError{
+		HTTPBaseError: NewHTTPBaseError(notification),
+	}
+}
+
+func (httpEmailNotVerifiedError HTTPEmailNotVerifiedError) Error() string {
+	return fmt.Sprintf("notification: %s", httpEmailNotVerifiedError.Notification)
+}
+
+type HTTPMustResetPasswordError struct {
+	HTTPBaseError
+}
+
+func NewHTTPMustResetPasswordError(notification string) HTTPMustResetPasswordError {
+	return HTTPMustResetPasswordError{
+		HTTPBaseError: NewHTTPBaseError(notification),
+	}
+}
+
+func (httpMustResetPasswordError HTTPMustResetPasswordError) Error() string {
+	return fmt.Sprintf("notification: %s", httpMustResetPasswordError.Notification)
+}
+
+type HTTPUserSuspendedError struct {
+	Reason         string `json:"reason,omitempty"`
+	SuspendedUntil string `json:"suspended_until,omitempty"`
+	HTTPBaseError
+}
+
+func NewHTTPUserSuspendedError(reason, suspendedUntil, notification string) HTTPUserSuspendedError {
+	return HTTPUserSuspendedError{
+		Reason:         reason,
+		SuspendedUntil: suspendedUntil,
+		HTTPBaseError:  NewHTTPBaseError(notification),
+	}
+}
+
+func (httpUserSuspendedError HTTPUserSuspendedError) Error() string {
+	return fmt.Sprintf(
+		"reason: %s suspended_until: %s notification: %s",
+		httpUserSuspendedError.Reason,
+		httpUserSuspendedError.SuspendedUntil,
+		httpUserSuspendedError.Notification,
+	)
+}
+
+type HTTPTooManyRequestsError struct {
+	RetryAfter string `json:"retry_after"`
+	HTTPBaseError
+}
+
+func NewHTTPTooManyRequestsError(retryAfter, notification string) HTTPTooManyRequestsError {
+	return HTTPTooManyRequestsError{
+		RetryAfter:    retryAfter,
+		HTTPBaseError: NewHTTPBaseError(notification),
+	}
+}
+
+func (httpTooManyRequestsError HTTPTooManyRequestsError) Error() string {
+	return fmt.Sprintf(
+		"retry_after: %s notification: %s",
+		httpTooManyRequestsError.RetryAfter,
+		httpTooManyRequestsError.Notification,
+	)
+}
+
 type HTTPPaginationError struct {
 	CurrentPage string `json:"current_page"`
 	TotalPages  string `json:"total_pages"`
@@ -42,6 +42,13 @@ func ItemNotFoundErrorToHTTPItemNotFoundErrorMapper(itemNotFoundError domain.Ite
 	)
 }
 
+func ConflictErrorToHTTPConflictErrorMapper(conflictError domain.ConflictError) HTTPConflictError {
+	return NewHTTPConflictError(
+		conflictError.Field,
+		conflictError.Notification,
+	)
+}
+
 func InvalidTokenErrorToHTTPIvalidTokenErrorMapper(invalidTokenError domain.InvalidTokenError) HTTPInvalidTokenError {
 	return NewHTTPInvalidTokenError(
 		invalidTokenError.Notification,
@@ -54,6 +61,33 @@ func TimeExpiredErrorToHTTPTimeExpiredErrorMapper(timeExpiredError domain.TimeEx
 	)
 }
 
+func EmailNotVerifiedErrorToHTTPEmailNotVerifiedErrorMapper(emailNotVerifiedError domain.EmailNotVerifiedError) HTTPEmailNotVerifiedError {
+	return NewHTTPEmailNotVerifiedError(
+		emailNotVerifiedError.Notification,
+	)
+}
+
+func MustResetPasswordErrorToHTTPMustResetPasswordErrorMapper(mustResetPasswordError domain.MustResetPasswordError) HTTPMustResetPasswordError {
+	return NewHTTPMustResetPasswordError(
+		mustResetPasswordError.Notification,
+	)
+}
+
+func UserSuspendedErrorToHTTPUserSuspendedErrorMapper(userSuspendedError domain.UserSuspendedError) HTTPUserSuspendedError {
+	return NewHTTPUserSuspendedError(
+		userSuspendedError.Reason,
+		userSuspendedError.SuspendedUntil,
+		userSuspendedError.Notification,
+	)
+}
+
+func TooManyRequestsErrorToHTTPTooManyRequestsErrorMapper(tooManyRequestsError domain.TooManyRequestsError) HTTPTooManyRequestsError {
+	return NewHTTPTooManyRequestsError(
+		tooManyRequestsError.RetryAfter,
+		tooManyRequestsError.Notification,
+	)
+}
+
 func PaginationErrorToHTTPPaginationErrorMapper(paginationError domain.PaginationError) HTTPPaginationError {
 	return NewHTTPPaginationError(
 		paginationError.CurrentPage,
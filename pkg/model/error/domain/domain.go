@@ -124,6 +124,26 @@ func (itemNotFoundError ItemNotFoundError) Error() string {
 		itemNotFoundError.Query)
 }
 
+// ConflictError reports an item that can't be stored because it clashes with an existing one, like a duplicate title.
+type ConflictError struct {
+	BaseError
+	Field string
+}
+
+func NewConflictError(location, field, notification string) ConflictError {
+	return ConflictError{
+		BaseError: NewBaseError(location, notification),
+		Field:     field,
+	}
+}
+
+func (conflictError ConflictError) Error() string {
+	return fmt.Sprintf(constants.BaseErrorMessageFormat+" "+"field: %s",
+		conflictError.Location,
+		conflictError.Notification,
+		conflictError.Field)
+}
+
 type InvalidTokenError struct {
 	BaseError
 }
@@ -144,6 +164,67 @@ func NewTimeExpiredError(location, notification string) TimeExpiredError {
 	}
 }
 
+type EmailNotVerifiedError struct {
+	BaseError
+}
+
+func NewEmailNotVerifiedError(location, notification string) EmailNotVerifiedError {
+	return EmailNotVerifiedError{
+		BaseError: NewBaseError(location, notification),
+	}
+}
+
+type MustResetPasswordError struct {
+	BaseError
+}
+
+func NewMustResetPasswordError(location, notification string) MustResetPasswordError {
+	return MustResetPasswordError{
+		BaseError: NewBaseError(location, notification),
+	}
+}
+
+type UserSuspendedError struct {
+	BaseError
+	Reason         string
+	SuspendedUntil string
+}
+
+func NewUserSuspendedError(location, reason, suspendedUntil, notification string) UserSuspendedError {
+	return UserSuspendedError{
+		BaseError:      NewBaseError(location, notification),
+		Reason:         reason,
+		SuspendedUntil: suspendedUntil,
+	}
+}
+
+func (userSuspendedError UserSuspendedError) Error() string {
+	return fmt.Sprintf(constants.BaseErrorMessageFormat+" "+" reason: %s suspended_until: %s",
+		userSuspendedError.Location,
+		userSuspendedError.Notification,
+		userSuspendedError.Reason,
+		userSuspendedError.SuspendedUntil)
+}
+
+type TooManyRequestsError struct {
+	BaseError
+	RetryAfter string
+}
+
+func NewTooManyRequestsError(location, retryAfter, notification string) TooManyRequestsError {
+	return TooManyRequestsError{
+		BaseError:  NewBaseError(location, notification),
+		RetryAfter: retryAfter,
+	}
+}
+
+func (tooManyRequestsError TooManyRequestsError) Error() string {
+	return fmt.Sprintf(constants.BaseErrorMessageFormat+" "+" retry_after: %s",
+		tooManyRequestsError.Location,
+		tooManyRequestsError.Notification,
+		tooManyRequestsError.RetryAfter)
+}
+
 type PaginationError struct {
 	BaseError
 	CurrentPage string
@@ -16,6 +16,17 @@ func HandleError(err error) error {
 	case ItemNotFoundError:
 		errorType.Notification = constants.ItemNotFoundErrorNotification
 		return errorType
+	case ConflictError:
+		return errorType
+	case EmailNotVerifiedError:
+		errorType.Notification = constants.EmailNotVerifiedNotification
+		return errorType
+	case MustResetPasswordError:
+		errorType.Notification = constants.MustResetPasswordNotification
+		return errorType
+	case UserSuspendedError:
+		errorType.Notification = constants.UserSuspendedNotification
+		return errorType
 	case PaginationError:
 		errorType.Notification = constants.PaginationErrorNotification
 		return errorType
@@ -13,11 +13,9 @@ import (
 // AnonymousMiddleware is a Gin middleware to check if the user is anonymous based on the presence of an access token.
 func AnonymousMiddleware(logger interfaces.Logger) gin.HandlerFunc {
 	return func(ginContext *gin.Context) {
-		anonymousAccessToken := isUserAnonymous(ginContext)
-
 		// If the access token is present, indicating that the user is already authenticated.
-		if anonymousAccessToken {
-			httpAuthorizationError := delivery.NewHTTPAuthorizationError(location+"AnonymousMiddleware.anonymousAccessToken", constants.AlreadyLoggedInNotification)
+		if hasAccessToken(ginContext) {
+			httpAuthorizationError := delivery.NewHTTPAuthorizationError(location+"AnonymousMiddleware.hasAccessToken", constants.AlreadyLoggedInNotification)
 			abortWithStatusJSON(ginContext, logger, httpAuthorizationError, http.StatusForbidden)
 			return
 		}
@@ -26,7 +24,8 @@ func AnonymousMiddleware(logger interfaces.Logger) gin.HandlerFunc {
 	}
 }
 
-func isUserAnonymous(ginContext *gin.Context) bool {
+// hasAccessToken reports whether the request carries an access token in a cookie or a Bearer Authorization header.
+func hasAccessToken(ginContext *gin.Context) bool {
 	authorizationHeader := ginContext.Request.Header.Get(constants.Authorization)
 
 	// Attempt to retrieve the access token from the cookie if no valid Bearer token is found in the Authorization header.
@@ -3,18 +3,21 @@ package middleware
 import (
 	"context"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
 	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
 	utility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
 	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
 	delivery "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/delivery/http"
 	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
 )
 
-// AuthenticationMiddleware is a Gin middleware for handling user authentication using JWT tokens.
-func AuthenticationMiddleware(config *config.ApplicationConfig, logger interfaces.Logger) gin.HandlerFunc {
+// AuthenticationMiddleware is a Gin middleware for handling user authentication using JWT tokens
+// or personal access tokens, which are told apart by their prefix.
+func AuthenticationMiddleware(config *config.ApplicationConfig, logger interfaces.Logger, keyRing utility.KeyRing, sessionValidator interfaces.SessionValidator) gin.HandlerFunc {
 	return func(ginContext *gin.Context) {
 		ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
 		defer cancel()
@@ -26,23 +29,51 @@ func AuthenticationMiddleware(config *config.ApplicationConfig, logger interface
 			return
 		}
 
-		// Validate the JWT token using the public key from the configuration.
-		userTokenPayload := utility.ValidateJWTToken(
-			logger,
-			location+"AuthenticationMiddleware",
-			accessToken.Data,
-			config.AccessToken.PublicKey,
-		)
-		if validator.IsError(userTokenPayload.Error) {
-			httpAuthorizationError := delivery.NewHTTPAuthorizationError(location+"AuthenticationMiddleware.ValidateJWTToken", constants.LoggingErrorNotification)
-			abortWithStatusJSON(ginContext, logger, httpAuthorizationError, http.StatusUnauthorized)
+		authenticatedContext := authenticateAccessToken(ctx, logger, keyRing, sessionValidator, accessToken.Data)
+		if validator.IsError(authenticatedContext.Error) {
+			abortWithSessionError(ginContext, logger, location+"AuthenticationMiddleware.authenticateAccessToken", authenticatedContext.Error)
 			return
 		}
 
-		// Store the user's ID and role in the request context.
+		ginContext.Request = ginContext.Request.WithContext(authenticatedContext.Data)
+		ginContext.Next()
+	}
+}
+
+// authenticateAccessToken validates the access token and returns the context with the user's ID and role stored in it,
+// together with the session of a JWT token or the scopes of a personal access token, which has no session.
+func authenticateAccessToken(ctx context.Context, logger interfaces.Logger, keyRing utility.KeyRing, sessionValidator interfaces.SessionValidator, accessToken string) common.Result[context.Context] {
+	if strings.HasPrefix(accessToken, constants.PersonalAccessTokenPrefix) {
+		userTokenPayload := sessionValidator.ValidatePersonalAccessToken(ctx, accessToken)
+		if validator.IsError(userTokenPayload.Error) {
+			return common.NewResultOnFailure[context.Context](userTokenPayload.Error)
+		}
+
 		ctx = context.WithValue(ctx, constants.ID, userTokenPayload.Data.UserID)
 		ctx = context.WithValue(ctx, constants.UserRole, userTokenPayload.Data.Role)
-		ginContext.Request = ginContext.Request.WithContext(ctx)
-		ginContext.Next()
+		ctx = context.WithValue(ctx, constants.Scopes, userTokenPayload.Data.Scopes)
+		return common.NewResultOnSuccess(ctx)
+	}
+
+	// Validate the JWT token using the key ring of the token type.
+	userTokenPayload := utility.ValidateJWTToken(
+		logger,
+		location+"authenticateAccessToken",
+		accessToken,
+		keyRing,
+	)
+	if validator.IsError(userTokenPayload.Error) {
+		return common.NewResultOnFailure[context.Context](delivery.NewHTTPAuthorizationError(location+"authenticateAccessToken.ValidateJWTToken", constants.LoggingErrorNotification))
 	}
+
+	// Reject tokens that belong to a revoked session or a suspended user.
+	validateSessionError := sessionValidator.ValidateSession(ctx, userTokenPayload.Data.UserID, userTokenPayload.Data.SessionID)
+	if validator.IsError(validateSessionError) {
+		return common.NewResultOnFailure[context.Context](validateSessionError)
+	}
+
+	ctx = context.WithValue(ctx, constants.ID, userTokenPayload.Data.UserID)
+	ctx = context.WithValue(ctx, constants.UserRole, userTokenPayload.Data.Role)
+	ctx = context.WithValue(ctx, constants.SessionID, userTokenPayload.Data.SessionID)
+	return common.NewResultOnSuccess(ctx)
 }
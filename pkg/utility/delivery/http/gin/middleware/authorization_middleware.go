@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	delivery "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/delivery/http"
+	policy "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/policy"
+)
+
+// RequireRole is a Gin middleware that allows only users with one of the provided roles.
+// It must be used after the authentication middleware, which stores the user's role in the request context.
+func RequireRole(logger interfaces.Logger, allowedRoles ...string) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		role, _ := ginContext.Request.Context().Value(constants.UserRole).(string)
+		if !policy.HasRole(role, allowedRoles...) {
+			httpAuthorizationError := delivery.NewHTTPAuthorizationError(location+"RequireRole.HasRole", constants.AuthorizationErrorNotification)
+			abortWithStatusJSON(ginContext, logger, httpAuthorizationError, http.StatusForbidden)
+			return
+		}
+
+		ginContext.Next()
+	}
+}
+
+// RequirePermission is a Gin middleware that allows only users whose role is granted the permission.
+// Requests authenticated with a personal access token also need the permission among the token scopes.
+// It must be used after the authentication middleware, which stores the user's role in the request context.
+func RequirePermission(logger interfaces.Logger, permission string) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		role, _ := ginContext.Request.Context().Value(constants.UserRole).(string)
+		if !policy.HasPermission(role, permission) {
+			httpAuthorizationError := delivery.NewHTTPAuthorizationError(location+"RequirePermission.HasPermission", constants.AuthorizationErrorNotification)
+			abortWithStatusJSON(ginContext, logger, httpAuthorizationError, http.StatusForbidden)
+			return
+		}
+
+		scopes, isPersonalAccessToken := ginContext.Request.Context().Value(constants.Scopes).([]string)
+		if isPersonalAccessToken && !policy.HasScope(scopes, permission) {
+			httpAuthorizationError := delivery.NewHTTPAuthorizationError(location+"RequirePermission.HasScope", constants.AuthorizationErrorNotification)
+			abortWithStatusJSON(ginContext, logger, httpAuthorizationError, http.StatusForbidden)
+			return
+		}
+
+		ginContext.Next()
+	}
+}
+
+// RequireSession is a Gin middleware that rejects requests authenticated with a personal access token,
+// so managing the account and its tokens needs a signed-in session.
+// It must be used after the authentication middleware, which stores the token scopes in the request context.
+func RequireSession(logger interfaces.Logger) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		_, isPersonalAccessToken := ginContext.Request.Context().Value(constants.Scopes).([]string)
+		if isPersonalAccessToken {
+			httpAuthorizationError := delivery.NewHTTPAuthorizationError(location+"RequireSession.Scopes", constants.AuthorizationErrorNotification)
+			abortWithStatusJSON(ginContext, logger, httpAuthorizationError, http.StatusForbidden)
+			return
+		}
+
+		ginContext.Next()
+	}
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	netHTTP "net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -9,6 +10,7 @@ import (
 	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
 	http "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/delivery/http"
 	delivery "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/delivery/http"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
 )
 
 const (
@@ -42,3 +44,16 @@ func abortWithStatusJSON(ginContext *gin.Context, logger interfaces.Logger, err
 	jsonResponse := http.NewJSONResponseOnFailure(delivery.HandleError(err))
 	ginContext.AbortWithStatusJSON(httpCode, jsonResponse)
 }
+
+// abortWithSessionError aborts the request after a failed token or session validation.
+// Suspended users get a dedicated forbidden response, any other failure means the user is not logged in.
+func abortWithSessionError(ginContext *gin.Context, logger interfaces.Logger, location string, err error) {
+	_, isUserSuspendedError := err.(domain.UserSuspendedError)
+	if isUserSuspendedError {
+		abortWithStatusJSON(ginContext, logger, err, netHTTP.StatusForbidden)
+		return
+	}
+
+	httpAuthorizationError := delivery.NewHTTPAuthorizationError(location, constants.LoggingErrorNotification)
+	abortWithStatusJSON(ginContext, logger, httpAuthorizationError, netHTTP.StatusUnauthorized)
+}
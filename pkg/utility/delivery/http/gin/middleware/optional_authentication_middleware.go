@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+// OptionalAuthenticationMiddleware is a Gin middleware for public routes that show more to an authenticated user.
+// Requests with a valid access token are authenticated like in AuthenticationMiddleware, all the others pass anonymously,
+// so a stale or expired cookie doesn't lock the user out of a public route.
+func OptionalAuthenticationMiddleware(config *config.ApplicationConfig, logger interfaces.Logger, keyRing utility.KeyRing, sessionValidator interfaces.SessionValidator) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
+		defer cancel()
+
+		accessToken := extractToken(ginContext, location+"OptionalAuthenticationMiddleware", constants.AccessTokenValue)
+		if validator.IsError(accessToken.Error) {
+			ginContext.Next()
+			return
+		}
+
+		authenticatedContext := authenticateAccessToken(ctx, logger, keyRing, sessionValidator, accessToken.Data)
+		if validator.IsError(authenticatedContext.Error) {
+			logger.Warn(authenticatedContext.Error)
+			ginContext.Next()
+			return
+		}
+
+		ginContext.Request = ginContext.Request.WithContext(authenticatedContext.Data)
+		ginContext.Next()
+	}
+}
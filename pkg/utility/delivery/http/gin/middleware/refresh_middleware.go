@@ -14,7 +14,7 @@ import (
 )
 
 // RefreshTokenMiddleware is a Gin middleware for handling user authentication using refresh tokens.
-func RefreshTokenMiddleware(config *config.ApplicationConfig, logger interfaces.Logger) gin.HandlerFunc {
+func RefreshTokenMiddleware(config *config.ApplicationConfig, logger interfaces.Logger, keyRing utility.KeyRing, sessionValidator interfaces.SessionValidator) gin.HandlerFunc {
 	return func(ginContext *gin.Context) {
 		ctx, cancel := context.WithTimeout(ginContext.Request.Context(), constants.DefaultContextTimer)
 		defer cancel()
@@ -26,12 +26,12 @@ func RefreshTokenMiddleware(config *config.ApplicationConfig, logger interfaces.
 			return
 		}
 
-		// Validate the JWT token using the public key from the configuration.
+		// Validate the JWT token using the key ring of the token type.
 		userTokenPayload := utility.ValidateJWTToken(
 			logger,
 			location+"RefreshTokenMiddleware",
 			refreshToken.Data,
-			config.RefreshToken.PublicKey,
+			keyRing,
 		)
 		if validator.IsError(userTokenPayload.Error) {
 			httpAuthorizationError := delivery.NewHTTPAuthorizationError(location+"RefreshTokenMiddleware.ValidateJWTToken", constants.LoggingErrorNotification)
@@ -39,8 +39,17 @@ func RefreshTokenMiddleware(config *config.ApplicationConfig, logger interfaces.
 			return
 		}
 
+		// Reject tokens that belong to a revoked session or a suspended user.
+		validateSessionError := sessionValidator.ValidateSession(ctx, userTokenPayload.Data.UserID, userTokenPayload.Data.SessionID)
+		if validator.IsError(validateSessionError) {
+			abortWithSessionError(ginContext, logger, location+"RefreshTokenMiddleware.ValidateSession", validateSessionError)
+			return
+		}
+
 		ctx = context.WithValue(ctx, constants.ID, userTokenPayload.Data.UserID)
 		ctx = context.WithValue(ctx, constants.UserRole, userTokenPayload.Data.Role)
+		ctx = context.WithValue(ctx, constants.TokenID, userTokenPayload.Data.TokenID)
+		ctx = context.WithValue(ctx, constants.SessionID, userTokenPayload.Data.SessionID)
 		ginContext.Request = ginContext.Request.WithContext(ctx)
 		ginContext.Next()
 	}
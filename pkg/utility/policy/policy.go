@@ -0,0 +1,151 @@
+package policy
+
+import (
+	"fmt"
+
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	validator "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/validator"
+)
+
+const (
+	permissionDenied = "The role %s does not have the %s permission."
+	roleDenied       = "The role %s is not allowed."
+	roleNotOutranked = "The role %s can't manage users with the role %s."
+	roleGrantDenied  = "The role %s can't grant the role %s."
+	scopeDenied      = "The token scopes do not include the %s permission."
+)
+
+var (
+	userPermissions = []string{
+		constants.PostCreatePermission,
+		constants.PostUpdateOwnPermission,
+		constants.PostDeleteOwnPermission,
+	}
+
+	moderatorPermissions = append([]string{
+		constants.PostUpdateAnyPermission,
+		constants.PostDeleteAnyPermission,
+		constants.UserReadEmailPermission,
+		constants.UserSuspendPermission,
+	}, userPermissions...)
+
+	adminPermissions = append([]string{
+		constants.UserManagePermission,
+	}, moderatorPermissions...)
+
+	// rolePermissions maps every role to the permissions granted to it.
+	rolePermissions = map[string][]string{
+		constants.UserRoleValue:      userPermissions,
+		constants.ModeratorRoleValue: moderatorPermissions,
+		constants.AdminRoleValue:     adminPermissions,
+	}
+
+	// roleRanks orders the roles from the least to the most privileged, an unknown role ranks below all of them.
+	roleRanks = map[string]int{
+		constants.UserRoleValue:      1,
+		constants.ModeratorRoleValue: 2,
+		constants.AdminRoleValue:     3,
+	}
+)
+
+// HasPermission reports whether the role is granted the permission.
+func HasPermission(role, permission string) bool {
+	return validator.IsSliceContains(rolePermissions[role], permission)
+}
+
+// HasScope reports whether the permission is one of the scopes of a personal access token.
+func HasScope(scopes []string, permission string) bool {
+	return validator.IsSliceContains(scopes, permission)
+}
+
+// GrantedPermissions returns the provided permissions that the role is granted, in their original order.
+func GrantedPermissions(role string, permissions []string) []string {
+	grantedPermissions := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		if HasPermission(role, permission) {
+			grantedPermissions = append(grantedPermissions, permission)
+		}
+	}
+
+	return grantedPermissions
+}
+
+// IsRoleValid reports whether the role is known to the policy.
+func IsRoleValid(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+// HasRole reports whether the role is one of the allowed roles.
+func HasRole(role string, allowedRoles ...string) bool {
+	return validator.IsSliceContains(allowedRoles, role)
+}
+
+// Outranks reports whether the role is more privileged than the other role.
+func Outranks(role, otherRole string) bool {
+	return roleRanks[role] > roleRanks[otherRole]
+}
+
+// CheckPermission returns an authorization error if the role is not granted the permission.
+func CheckPermission(logger interfaces.Logger, location, role, permission string) error {
+	if HasPermission(role, permission) {
+		return nil
+	}
+
+	authorizationError := domain.NewAuthorizationError(location+".CheckPermission", fmt.Sprintf(permissionDenied, role, permission))
+	logger.Error(authorizationError)
+	return domain.HandleError(authorizationError)
+}
+
+// CheckRole returns an authorization error if the role is not one of the allowed roles.
+func CheckRole(logger interfaces.Logger, location, role string, allowedRoles ...string) error {
+	if HasRole(role, allowedRoles...) {
+		return nil
+	}
+
+	authorizationError := domain.NewAuthorizationError(location+".CheckRole", fmt.Sprintf(roleDenied, role))
+	logger.Error(authorizationError)
+	return domain.HandleError(authorizationError)
+}
+
+// CheckOutranks returns an authorization error if the role is not more privileged than the target role,
+// so users can't manage users with the same or a higher role.
+func CheckOutranks(logger interfaces.Logger, location, role, targetRole string) error {
+	if Outranks(role, targetRole) {
+		return nil
+	}
+
+	authorizationError := domain.NewAuthorizationError(location+".CheckOutranks", fmt.Sprintf(roleNotOutranked, role, targetRole))
+	logger.Error(authorizationError)
+	return domain.HandleError(authorizationError)
+}
+
+// CheckRoleGrant returns an authorization error if the granted role is more privileged than the role granting it.
+func CheckRoleGrant(logger interfaces.Logger, location, role, grantedRole string) error {
+	if !Outranks(grantedRole, role) {
+		return nil
+	}
+
+	authorizationError := domain.NewAuthorizationError(location+".CheckRoleGrant", fmt.Sprintf(roleGrantDenied, role, grantedRole))
+	logger.Error(authorizationError)
+	return domain.HandleError(authorizationError)
+}
+
+// CheckOwnershipPermission allows the owner of a resource with the own permission
+// and anybody with the any permission, otherwise it returns an authorization error.
+// The scopes are those of a personal access token and nil for a session, a token gets the any permission
+// only when it is also among its scopes, so a token scoped to the own permission can't act on other users' resources.
+func CheckOwnershipPermission(logger interfaces.Logger, location, role string, scopes []string, currentUserID, ownerID, ownPermission, anyPermission string) error {
+	if currentUserID == ownerID && HasPermission(role, ownPermission) {
+		return nil
+	}
+	if scopes != nil && !HasScope(scopes, anyPermission) {
+		authorizationError := domain.NewAuthorizationError(location+".CheckOwnershipPermission.HasScope", fmt.Sprintf(scopeDenied, anyPermission))
+		logger.Error(authorizationError)
+		return domain.HandleError(authorizationError)
+	}
+
+	return CheckPermission(logger, location+".CheckOwnershipPermission", role, anyPermission)
+}
@@ -20,9 +20,14 @@ const (
 	ResponseBodyMismatch = "response body mismatch"
 	StatusCodeMismatch   = "status code mismatch"
 
+	// Suspension.
+	SuspensionReason = "Spam"
+
 	// JSON Response Messages.
 	AlreadyLoggedInMessage = `{"error":{"notification":"Already logged in. This action is not allowed."},"status":"fail"}`
 	NotLoggedInMessage     = `{"error":{"notification":"You are not logged in."},"status":"fail"}`
+	AccessDeniedMessage    = `{"error":{"notification":"Access denied. You do not have the required permissions to perform this action. Please try again or contact our support team for assistance."},"status":"fail"}`
+	UserSuspendedMessage   = `{"error":{"reason":"Spam","notification":"Your account has been suspended. Please contact our support team for assistance."},"status":"fail"}`
 	SuccessResponse        = `{"message":"success"}`
 	Message                = "message"
 	ContentTypeJSON        = "application/json"
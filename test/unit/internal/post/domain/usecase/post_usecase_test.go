@@ -0,0 +1,256 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	post "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/model"
+	usecase "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/usecase"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+	mock "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/common"
+	repository "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/data/repository"
+)
+
+const (
+	postID      = "5f1d7e3e9b1e8b1a2c3d4e5f"
+	authorID    = "6a2e8f4f0c2f9c2b3d4e5f60"
+	moderatorID = "7b3f9a5a1d3a0d3c4e5f6071"
+	otherUserID = "8c4a0b6b2e4b1e4d5f607182"
+)
+
+func newPostUseCase() (usecase.PostUseCase, *repository.MockPostRepository) {
+	mockPostRepository := repository.NewMockPostRepository()
+	return usecase.NewPostUseCase(mock.NewMockLogger(), mockPostRepository), mockPostRepository
+}
+
+func authorPost(status string) common.Result[post.Post] {
+	return common.NewResultOnSuccess(post.NewPost(postID, authorID, "", "", "", "", "", status, time.Time{}, time.Time{}, time.Time{}))
+}
+
+func TestDeletePostByIDModeratorSession(t *testing.T) {
+	t.Parallel()
+	postUseCase, mockPostRepository := newPostUseCase()
+	mockPostRepository.GetPostByIdResult = authorPost(constants.PostStatusPublished)
+
+	deletePostError := postUseCase.DeletePostByID(context.Background(), postID, moderatorID, constants.ModeratorRoleValue, nil)
+
+	assert.NoError(t, deletePostError, test.ErrorNilMessage)
+	assert.Equal(t, []string{postID}, mockPostRepository.DeletedPostIDs, test.EqualMessage)
+}
+
+func TestDeletePostByIDModeratorTokenScopedToOwnPosts(t *testing.T) {
+	t.Parallel()
+	postUseCase, mockPostRepository := newPostUseCase()
+	mockPostRepository.GetPostByIdResult = authorPost(constants.PostStatusPublished)
+	scopes := []string{constants.PostUpdateOwnPermission, constants.PostDeleteOwnPermission}
+
+	deletePostError := postUseCase.DeletePostByID(context.Background(), postID, moderatorID, constants.ModeratorRoleValue, scopes)
+
+	assert.IsType(t, domain.AuthorizationError{}, deletePostError, test.EqualMessage)
+	assert.Empty(t, mockPostRepository.DeletedPostIDs, test.EqualMessage)
+}
+
+func TestArchivePostModeratorTokenScopedToOwnPosts(t *testing.T) {
+	t.Parallel()
+	postUseCase, mockPostRepository := newPostUseCase()
+	mockPostRepository.GetPostByIdResult = authorPost(constants.PostStatusPublished)
+	scopes := []string{constants.PostUpdateOwnPermission}
+
+	archivedPost := postUseCase.ArchivePost(context.Background(), postID, moderatorID, constants.ModeratorRoleValue, scopes)
+
+	assert.IsType(t, domain.AuthorizationError{}, archivedPost.Error, test.EqualMessage)
+	assert.Empty(t, mockPostRepository.PostStatusUpdates, test.EqualMessage)
+}
+
+func TestArchivePostModeratorTokenScopedToAnyPost(t *testing.T) {
+	t.Parallel()
+	postUseCase, mockPostRepository := newPostUseCase()
+	mockPostRepository.GetPostByIdResult = authorPost(constants.PostStatusPublished)
+	scopes := []string{constants.PostUpdateOwnPermission, constants.PostUpdateAnyPermission}
+
+	archivedPost := postUseCase.ArchivePost(context.Background(), postID, moderatorID, constants.ModeratorRoleValue, scopes)
+
+	assert.NoError(t, archivedPost.Error, test.NotFailureMessage)
+	assert.Len(t, mockPostRepository.PostStatusUpdates, 1, test.EqualMessage)
+}
+
+func TestDeletePostByIDAuthorTokenScopedToOwnPosts(t *testing.T) {
+	t.Parallel()
+	postUseCase, mockPostRepository := newPostUseCase()
+	mockPostRepository.GetPostByIdResult = authorPost(constants.PostStatusPublished)
+	scopes := []string{constants.PostDeleteOwnPermission}
+
+	deletePostError := postUseCase.DeletePostByID(context.Background(), postID, authorID, constants.UserRoleValue, scopes)
+
+	assert.NoError(t, deletePostError, test.ErrorNilMessage)
+	assert.Equal(t, []string{postID}, mockPostRepository.DeletedPostIDs, test.EqualMessage)
+}
+
+func TestPublishPostNow(t *testing.T) {
+	t.Parallel()
+	postUseCase, mockPostRepository := newPostUseCase()
+	mockPostRepository.GetPostByIdResult = authorPost(constants.PostStatusDraft)
+
+	publishedPost := postUseCase.PublishPost(context.Background(), postID, time.Time{}, authorID, constants.UserRoleValue, nil)
+
+	assert.NoError(t, publishedPost.Error, test.ErrorNilMessage)
+	assert.Equal(t, constants.PostStatusPublished, publishedPost.Data.Status, test.EqualMessage)
+	assert.WithinDuration(t, time.Now(), publishedPost.Data.PublishAt, time.Second, test.EqualMessage)
+}
+
+func TestPublishPostLater(t *testing.T) {
+	t.Parallel()
+	postUseCase, mockPostRepository := newPostUseCase()
+	mockPostRepository.GetPostByIdResult = authorPost(constants.PostStatusDraft)
+	publishAt := time.Now().Add(time.Hour)
+
+	scheduledPost := postUseCase.PublishPost(context.Background(), postID, publishAt, authorID, constants.UserRoleValue, nil)
+
+	assert.NoError(t, scheduledPost.Error, test.ErrorNilMessage)
+	assert.Equal(t, constants.PostStatusScheduled, scheduledPost.Data.Status, test.EqualMessage)
+	assert.Equal(t, publishAt, scheduledPost.Data.PublishAt, test.EqualMessage)
+}
+
+func TestPublishPostRescheduled(t *testing.T) {
+	t.Parallel()
+	postUseCase, mockPostRepository := newPostUseCase()
+	mockPostRepository.GetPostByIdResult = authorPost(constants.PostStatusScheduled)
+	publishAt := time.Now().Add(time.Hour)
+
+	rescheduledPost := postUseCase.PublishPost(context.Background(), postID, publishAt, authorID, constants.UserRoleValue, nil)
+
+	assert.NoError(t, rescheduledPost.Error, test.ErrorNilMessage)
+	assert.Equal(t, constants.PostStatusScheduled, rescheduledPost.Data.Status, test.EqualMessage)
+	assert.Equal(t, publishAt, rescheduledPost.Data.PublishAt, test.EqualMessage)
+}
+
+func TestPublishPostAlreadyPublished(t *testing.T) {
+	t.Parallel()
+	postUseCase, mockPostRepository := newPostUseCase()
+	mockPostRepository.GetPostByIdResult = authorPost(constants.PostStatusPublished)
+
+	publishedPost := postUseCase.PublishPost(context.Background(), postID, time.Time{}, authorID, constants.UserRoleValue, nil)
+
+	assert.IsType(t, domain.ConflictError{}, publishedPost.Error, test.EqualMessage)
+}
+
+func TestPublishPostNotAuthor(t *testing.T) {
+	t.Parallel()
+	postUseCase, mockPostRepository := newPostUseCase()
+	mockPostRepository.GetPostByIdResult = authorPost(constants.PostStatusDraft)
+
+	publishedPost := postUseCase.PublishPost(context.Background(), postID, time.Time{}, otherUserID, constants.UserRoleValue, nil)
+
+	assert.IsType(t, domain.AuthorizationError{}, publishedPost.Error, test.EqualMessage)
+	assert.Empty(t, mockPostRepository.PostStatusUpdates, test.EqualMessage)
+}
+
+func TestUnpublishPost(t *testing.T) {
+	t.Parallel()
+	postUseCase, mockPostRepository := newPostUseCase()
+	mockPostRepository.GetPostByIdResult = authorPost(constants.PostStatusScheduled)
+
+	unpublishedPost := postUseCase.UnpublishPost(context.Background(), postID, authorID, constants.UserRoleValue, nil)
+
+	assert.NoError(t, unpublishedPost.Error, test.ErrorNilMessage)
+	assert.Equal(t, constants.PostStatusDraft, unpublishedPost.Data.Status, test.EqualMessage)
+	assert.True(t, unpublishedPost.Data.PublishAt.IsZero(), test.NotFailureMessage)
+}
+
+func TestUnpublishPostDraft(t *testing.T) {
+	t.Parallel()
+	postUseCase, mockPostRepository := newPostUseCase()
+	mockPostRepository.GetPostByIdResult = authorPost(constants.PostStatusDraft)
+
+	unpublishedPost := postUseCase.UnpublishPost(context.Background(), postID, authorID, constants.UserRoleValue, nil)
+
+	assert.IsType(t, domain.ConflictError{}, unpublishedPost.Error, test.EqualMessage)
+}
+
+func TestArchivePost(t *testing.T) {
+	t.Parallel()
+	postUseCase, mockPostRepository := newPostUseCase()
+	mockPostRepository.GetPostByIdResult = authorPost(constants.PostStatusPublished)
+
+	archivedPost := postUseCase.ArchivePost(context.Background(), postID, authorID, constants.UserRoleValue, nil)
+
+	assert.NoError(t, archivedPost.Error, test.ErrorNilMessage)
+	assert.Equal(t, constants.PostStatusArchived, archivedPost.Data.Status, test.EqualMessage)
+	assert.True(t, archivedPost.Data.PublishAt.IsZero(), test.NotFailureMessage)
+}
+
+func TestArchivePostArchived(t *testing.T) {
+	t.Parallel()
+	postUseCase, mockPostRepository := newPostUseCase()
+	mockPostRepository.GetPostByIdResult = authorPost(constants.PostStatusArchived)
+
+	archivedPost := postUseCase.ArchivePost(context.Background(), postID, authorID, constants.UserRoleValue, nil)
+
+	assert.IsType(t, domain.ConflictError{}, archivedPost.Error, test.EqualMessage)
+}
+
+// pageOfPosts returns the second page of a listing with a single post per page.
+func pageOfPosts(paginationQuery common.PaginationQuery) common.Result[post.Posts] {
+	paginationQuery.TotalItems = 3
+	return common.NewResultOnSuccess(post.NewPosts([]post.Post{authorPost(constants.PostStatusPublished).Data}, common.NewPaginationResponse(paginationQuery)))
+}
+
+func TestGetAllPosts(t *testing.T) {
+	t.Parallel()
+	postUseCase, mockPostRepository := newPostUseCase()
+	paginationQuery := common.NewPaginationQuery("2", "1", "title", constants.SortAscend, "")
+	mockPostRepository.GetAllPostsResult = pageOfPosts(paginationQuery)
+	createdFrom := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	createdTo := time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	fetchedPosts := postUseCase.GetAllPosts(context.Background(), paginationQuery, post.NewPostFilter(authorID, constants.PostStatusDraft, createdFrom, createdTo), authorID)
+
+	assert.NoError(t, fetchedPosts.Error, test.ErrorNilMessage)
+	assert.Equal(t, []common.PaginationQuery{paginationQuery}, mockPostRepository.PaginationQueries, test.EqualMessage)
+	assert.Equal(t, []post.PostFilter{post.NewPostFilter(authorID, constants.PostStatusDraft, createdFrom, createdTo)}, mockPostRepository.PostFilters, test.EqualMessage)
+	assert.Equal(t, mockPostRepository.GetAllPostsResult.Data, fetchedPosts.Data, test.EqualMessage)
+	assert.Equal(t, 3, fetchedPosts.Data.PaginationResponse.TotalItems, test.EqualMessage)
+	assert.Equal(t, 3, fetchedPosts.Data.PaginationResponse.TotalPages, test.EqualMessage)
+}
+
+func TestGetAllPostsUnsortableOrderBy(t *testing.T) {
+	t.Parallel()
+	postUseCase, mockPostRepository := newPostUseCase()
+	paginationQuery := common.NewPaginationQuery("1", "10", "content", constants.SortAscend, "")
+	mockPostRepository.GetAllPostsResult = pageOfPosts(paginationQuery)
+
+	fetchedPosts := postUseCase.GetAllPosts(context.Background(), paginationQuery, post.PostFilter{}, "")
+
+	assert.NoError(t, fetchedPosts.Error, test.ErrorNilMessage)
+	assert.Len(t, mockPostRepository.PaginationQueries, 1, test.EqualMessage)
+	assert.Equal(t, constants.DefaultOrderBy, mockPostRepository.PaginationQueries[0].OrderBy, test.EqualMessage)
+	assert.Equal(t, constants.SortAscend, mockPostRepository.PaginationQueries[0].SortOrder, test.EqualMessage)
+}
+
+func TestGetAllPostsPublishedByDefault(t *testing.T) {
+	t.Parallel()
+	postUseCase, mockPostRepository := newPostUseCase()
+	paginationQuery := common.NewPaginationQuery("1", "10", constants.DefaultOrderBy, constants.SortAscend, "")
+	mockPostRepository.GetAllPostsResult = pageOfPosts(paginationQuery)
+
+	fetchedPosts := postUseCase.GetAllPosts(context.Background(), paginationQuery, post.NewPostFilter(authorID, "", time.Time{}, time.Time{}), "")
+
+	assert.NoError(t, fetchedPosts.Error, test.ErrorNilMessage)
+	assert.Equal(t, []post.PostFilter{post.NewPostFilter(authorID, constants.PostStatusPublished, time.Time{}, time.Time{})}, mockPostRepository.PostFilters, test.EqualMessage)
+}
+
+func TestGetAllPostsDraftsOfAnotherUser(t *testing.T) {
+	t.Parallel()
+	postUseCase, mockPostRepository := newPostUseCase()
+	paginationQuery := common.NewPaginationQuery("1", "10", constants.DefaultOrderBy, constants.SortAscend, "")
+
+	fetchedPosts := postUseCase.GetAllPosts(context.Background(), paginationQuery, post.NewPostFilter(authorID, constants.PostStatusDraft, time.Time{}, time.Time{}), otherUserID)
+
+	assert.IsType(t, domain.AuthorizationError{}, fetchedPosts.Error, test.EqualMessage)
+	assert.Empty(t, mockPostRepository.PaginationQueries, test.EqualMessage)
+}
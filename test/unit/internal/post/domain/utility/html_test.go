@@ -0,0 +1,52 @@
+package utility
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/utility"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+)
+
+// htmlTagRegex matches anything that a browser would parse as a tag.
+var htmlTagRegex = regexp.MustCompile(`<[a-zA-Z/!]`)
+
+func TestSanitizeHTMLStripsTags(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "Hello world", utility.SanitizeHTML("<p>Hello <b>world</b></p>"), test.EqualMessage)
+	assert.Equal(t, "click", utility.SanitizeHTML(`<a href="javascript:alert(1)">click</a><img src=x onerror=alert(1)>`), test.EqualMessage)
+}
+
+func TestSanitizeHTMLDropsUnsafeElements(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "before after", utility.SanitizeHTML("before <script>alert('x')</script>after"), test.EqualMessage)
+	assert.Equal(t, "text", utility.SanitizeHTML("<STYLE type=\"text/css\">body{}</STYLE>text<!-- comment -->"), test.EqualMessage)
+}
+
+func TestSanitizeHTMLKeepsPlainText(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "a < b and c > d", utility.SanitizeHTML("a < b and c > d"), test.EqualMessage)
+	assert.Equal(t, "Tom & Jerry", utility.SanitizeHTML("Tom &amp; Jerry"), test.EqualMessage)
+}
+
+func TestSanitizeHTMLStripsEscapedTags(t *testing.T) {
+	t.Parallel()
+	escapedTexts := []string{
+		"&lt;b&gt;bold&lt;/b&gt;",
+		"&lt;script&gt;alert(1)&lt;/script&gt;",
+		"&amp;lt;img src=x onerror=alert(1)&amp;gt;",
+		"<scr<script></script>ipt>alert(1)</script>",
+	}
+
+	for _, escapedText := range escapedTexts {
+		sanitizedText := utility.SanitizeHTML(escapedText)
+		assert.NotRegexp(t, htmlTagRegex, sanitizedText, test.NotFailureMessage)
+		assert.Equal(t, sanitizedText, utility.SanitizeHTML(sanitizedText), test.EqualMessage)
+	}
+}
+
+func TestSanitizeHTMLNormalizesLineBreaks(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "first\nsecond\n\nthird", utility.SanitizeHTML("  first  \r\nsecond\r\n\r\n\r\n\nthird\n"), test.EqualMessage)
+}
@@ -0,0 +1,59 @@
+package utility
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/utility"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+)
+
+func TestNormalizeSlug(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "hello-world", utility.NormalizeSlug("Hello, World!"), test.EqualMessage)
+	assert.Equal(t, "go-1-23-release-notes", utility.NormalizeSlug("  Go 1.23 -- release notes  "), test.EqualMessage)
+	assert.Equal(t, "dont-panic", utility.NormalizeSlug("Don't panic"), test.EqualMessage)
+}
+
+func TestNormalizeSlugTransliterates(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "creme-brulee-a-la-francaise", utility.NormalizeSlug("Crème brûlée à la française"), test.EqualMessage)
+	assert.Equal(t, "strasse-und-smorrebrod", utility.NormalizeSlug("Straße und Smørrebrød"), test.EqualMessage)
+	assert.Equal(t, "pryvit-svite", utility.NormalizeSlug("Привіт, світе"), test.EqualMessage)
+	assert.Equal(t, "zghoda-shchodnia", utility.NormalizeSlug("Згода щодня"), test.EqualMessage)
+	assert.Equal(t, "kyiv-i-zhytomyr", utility.NormalizeSlug("Київ і Житомир"), test.EqualMessage)
+	assert.Equal(t, "yuliia-yaremchuk", utility.NormalizeSlug("Юлія Яремчук"), test.EqualMessage)
+}
+
+func TestNormalizeSlugWithoutUsableCharacters(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "", utility.NormalizeSlug("!!! ???"), test.EqualMessage)
+	assert.Equal(t, "", utility.NormalizeSlug("日本語"), test.EqualMessage)
+}
+
+func TestNormalizeSlugCutsLongTitles(t *testing.T) {
+	t.Parallel()
+	slug := utility.NormalizeSlug(strings.Repeat("word ", 30))
+	assert.LessOrEqual(t, len(slug), utility.MaxSlugLength, test.EqualMessage)
+	assert.False(t, strings.HasSuffix(slug, "-"), test.EqualMessage)
+	assert.True(t, strings.HasSuffix(slug, "word"), test.EqualMessage)
+}
+
+func TestIsSlugCandidate(t *testing.T) {
+	t.Parallel()
+	assert.True(t, utility.IsSlugCandidate("hello-world", "hello-world"), test.EqualMessage)
+	assert.True(t, utility.IsSlugCandidate("hello-world-3", "hello-world"), test.EqualMessage)
+	assert.True(t, utility.IsSlugCandidate(utility.DefaultSlug+"-2", ""), test.EqualMessage)
+	assert.False(t, utility.IsSlugCandidate("hello-world-1", "hello-world"), test.EqualMessage)
+	assert.False(t, utility.IsSlugCandidate("hello-world-03", "hello-world"), test.EqualMessage)
+	assert.False(t, utility.IsSlugCandidate("hello-world-again", "hello-world"), test.EqualMessage)
+	assert.False(t, utility.IsSlugCandidate("hello", "hello-world"), test.EqualMessage)
+}
+
+func TestSlugCandidate(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "hello-world", utility.SlugCandidate("hello-world", 1), test.EqualMessage)
+	assert.Equal(t, "hello-world-2", utility.SlugCandidate("hello-world", 2), test.EqualMessage)
+	assert.Equal(t, utility.DefaultSlug+"-3", utility.SlugCandidate("", 3), test.EqualMessage)
+}
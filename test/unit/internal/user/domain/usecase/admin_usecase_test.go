@@ -0,0 +1,278 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	usecase "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/usecase"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+	mock "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/common"
+	repository "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/data/repository"
+	email "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/dependency/email"
+)
+
+const (
+	currentUserID    = "6a2e8f4f0c2f9c2b3d4e5f60"
+	suspensionReason = "Spamming other users."
+)
+
+func newAdminUseCase() (usecase.AdminUseCase, userUseCaseMocks) {
+	mocks := userUseCaseMocks{
+		Logger:                 mock.NewMockLogger(),
+		Email:                  email.NewMockEmail(),
+		UserRepository:         repository.NewMockUserRepository(),
+		RefreshTokenRepository: repository.NewMockRefreshTokenRepository(),
+		InvitationRepository:   repository.NewMockInvitationRepository(),
+	}
+	adminUseCase := usecase.NewAdminUseCase(mock.NewMockConfig(), mocks.Logger, mocks.Email, mocks.UserRepository, mocks.RefreshTokenRepository, mocks.InvitationRepository)
+	return adminUseCase, mocks
+}
+
+func userWithRole(id, role string) common.Result[user.User] {
+	fetchedUser := newUser(id)
+	fetchedUser.Role = role
+	return common.NewResultOnSuccess(fetchedUser)
+}
+
+func TestUpdateUserRole(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+	mocks.UserRepository.GetUserByIdResult = userWithRole(userID, constants.UserRoleValue)
+
+	updatedUser := adminUseCase.UpdateUserRole(context.Background(), user.NewUserRoleUpdate(userID, constants.ModeratorRoleValue), currentUserID, constants.AdminRoleValue)
+
+	assert.NoError(t, updatedUser.Error, test.ErrorNilMessage)
+	assert.Equal(t, []user.UserRoleUpdate{user.NewUserRoleUpdate(userID, constants.ModeratorRoleValue)}, mocks.UserRepository.UpdatedUserRoles, test.EqualMessage)
+	assert.Equal(t, []string{userID}, mocks.RefreshTokenRepository.RevokedSessionUserIDs, test.EqualMessage)
+}
+
+func TestUpdateUserRoleYourself(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+
+	updatedUser := adminUseCase.UpdateUserRole(context.Background(), user.NewUserRoleUpdate(currentUserID, constants.UserRoleValue), currentUserID, constants.AdminRoleValue)
+
+	assert.IsType(t, domain.AuthorizationError{}, updatedUser.Error, test.EqualMessage)
+	assert.IsType(t, domain.AuthorizationError{}, mocks.Logger.LastError, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.UpdatedUserRoles, test.EqualMessage)
+}
+
+func TestUpdateUserRoleSameRole(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+	mocks.UserRepository.GetUserByIdResult = userWithRole(userID, constants.AdminRoleValue)
+	mocks.UserRepository.CountUsersByRoleResult = common.NewResultOnSuccess[int64](2)
+
+	updatedUser := adminUseCase.UpdateUserRole(context.Background(), user.NewUserRoleUpdate(userID, constants.UserRoleValue), currentUserID, constants.AdminRoleValue)
+
+	assert.IsType(t, domain.AuthorizationError{}, updatedUser.Error, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.UpdatedUserRoles, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.RevokedSessionUserIDs, test.EqualMessage)
+}
+
+func TestUpdateUserRoleHigherThanOwnRole(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+	mocks.UserRepository.GetUserByIdResult = userWithRole(userID, constants.UserRoleValue)
+
+	updatedUser := adminUseCase.UpdateUserRole(context.Background(), user.NewUserRoleUpdate(userID, constants.AdminRoleValue), currentUserID, constants.ModeratorRoleValue)
+
+	assert.IsType(t, domain.AuthorizationError{}, updatedUser.Error, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.UpdatedUserRoles, test.EqualMessage)
+}
+
+func TestUpdateUserRoleLastAdmin(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+	mocks.UserRepository.GetUserByIdResult = userWithRole(userID, constants.AdminRoleValue)
+	mocks.UserRepository.CountUsersByRoleResult = common.NewResultOnSuccess[int64](1)
+
+	updatedUser := adminUseCase.UpdateUserRole(context.Background(), user.NewUserRoleUpdate(userID, constants.ModeratorRoleValue), currentUserID, constants.AdminRoleValue)
+
+	assert.IsType(t, domain.ConflictError{}, updatedUser.Error, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.UpdatedUserRoles, test.EqualMessage)
+}
+
+func TestSuspendUser(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+	mocks.UserRepository.GetUserByIdResult = userWithRole(userID, constants.UserRoleValue)
+
+	suspendedUser := adminUseCase.SuspendUser(context.Background(), user.NewUserSuspension(userID, suspensionReason, time.Time{}), currentUserID, constants.ModeratorRoleValue)
+
+	assert.NoError(t, suspendedUser.Error, test.ErrorNilMessage)
+	assert.Len(t, mocks.UserRepository.SuspendedUsers, 1, test.EqualMessage)
+	assert.Equal(t, []string{userID}, mocks.RefreshTokenRepository.RevokedSessionUserIDs, test.EqualMessage)
+}
+
+func TestSuspendUserYourself(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+
+	suspendedUser := adminUseCase.SuspendUser(context.Background(), user.NewUserSuspension(currentUserID, suspensionReason, time.Time{}), currentUserID, constants.AdminRoleValue)
+
+	assert.IsType(t, domain.AuthorizationError{}, suspendedUser.Error, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.SuspendedUsers, test.EqualMessage)
+}
+
+func TestSuspendUserSameRole(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+	mocks.UserRepository.GetUserByIdResult = userWithRole(userID, constants.ModeratorRoleValue)
+
+	suspendedUser := adminUseCase.SuspendUser(context.Background(), user.NewUserSuspension(userID, suspensionReason, time.Time{}), currentUserID, constants.ModeratorRoleValue)
+
+	assert.IsType(t, domain.AuthorizationError{}, suspendedUser.Error, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.SuspendedUsers, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.RevokedSessionUserIDs, test.EqualMessage)
+}
+
+func TestSuspendUserHigherRole(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+	mocks.UserRepository.GetUserByIdResult = userWithRole(userID, constants.AdminRoleValue)
+
+	suspendedUser := adminUseCase.SuspendUser(context.Background(), user.NewUserSuspension(userID, suspensionReason, time.Time{}), currentUserID, constants.ModeratorRoleValue)
+
+	assert.IsType(t, domain.AuthorizationError{}, suspendedUser.Error, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.SuspendedUsers, test.EqualMessage)
+}
+
+func TestUnsuspendUser(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+	mocks.UserRepository.GetUserByIdResult = userWithRole(userID, constants.UserRoleValue)
+
+	unsuspendedUser := adminUseCase.UnsuspendUser(context.Background(), userID, currentUserID, constants.ModeratorRoleValue)
+
+	assert.NoError(t, unsuspendedUser.Error, test.ErrorNilMessage)
+	assert.Equal(t, []string{userID}, mocks.UserRepository.UnsuspendedUserIDs, test.EqualMessage)
+}
+
+func TestUnsuspendUserYourself(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+
+	unsuspendedUser := adminUseCase.UnsuspendUser(context.Background(), currentUserID, currentUserID, constants.ModeratorRoleValue)
+
+	assert.IsType(t, domain.AuthorizationError{}, unsuspendedUser.Error, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.UnsuspendedUserIDs, test.EqualMessage)
+}
+
+func TestUnsuspendUserSameRole(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+	mocks.UserRepository.GetUserByIdResult = userWithRole(userID, constants.ModeratorRoleValue)
+
+	unsuspendedUser := adminUseCase.UnsuspendUser(context.Background(), userID, currentUserID, constants.ModeratorRoleValue)
+
+	assert.IsType(t, domain.AuthorizationError{}, unsuspendedUser.Error, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.UnsuspendedUserIDs, test.EqualMessage)
+}
+
+func TestUpdateUserVerificationSameRole(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+	mocks.UserRepository.GetUserByIdResult = userWithRole(userID, constants.AdminRoleValue)
+
+	updatedUser := adminUseCase.UpdateUserVerification(context.Background(), userID, false, currentUserID, constants.AdminRoleValue)
+
+	assert.IsType(t, domain.AuthorizationError{}, updatedUser.Error, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.UserVerifications, test.EqualMessage)
+}
+
+func TestForcePasswordResetYourself(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+
+	forcePasswordResetError := adminUseCase.ForcePasswordReset(context.Background(), currentUserID, currentUserID, constants.AdminRoleValue)
+
+	assert.IsType(t, domain.AuthorizationError{}, forcePasswordResetError, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.RevokedSessionUserIDs, test.EqualMessage)
+}
+
+func TestForcePasswordResetSameRole(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+	mocks.UserRepository.GetUserByIdResult = userWithRole(userID, constants.AdminRoleValue)
+
+	forcePasswordResetError := adminUseCase.ForcePasswordReset(context.Background(), userID, currentUserID, constants.AdminRoleValue)
+
+	assert.IsType(t, domain.AuthorizationError{}, forcePasswordResetError, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.RevokedSessionUserIDs, test.EqualMessage)
+	assert.Equal(t, int64(0), mocks.Email.SentEmails(), test.EqualMessage)
+}
+
+func TestDeleteUserById(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+	mocks.UserRepository.GetUserByIdResult = userWithRole(userID, constants.ModeratorRoleValue)
+
+	deleteUserError := adminUseCase.DeleteUserById(context.Background(), userID, currentUserID, constants.AdminRoleValue)
+
+	assert.NoError(t, deleteUserError, test.ErrorNilMessage)
+	assert.Equal(t, []string{userID}, mocks.UserRepository.DeletedUserIDs, test.EqualMessage)
+	assert.Equal(t, []string{userID}, mocks.RefreshTokenRepository.RevokedSessionUserIDs, test.EqualMessage)
+}
+
+func TestDeleteUserByIdYourself(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+
+	deleteUserError := adminUseCase.DeleteUserById(context.Background(), currentUserID, currentUserID, constants.AdminRoleValue)
+
+	assert.IsType(t, domain.AuthorizationError{}, deleteUserError, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.DeletedUserIDs, test.EqualMessage)
+}
+
+func TestDeleteUserByIdPeerAdmin(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+	mocks.UserRepository.GetUserByIdResult = userWithRole(userID, constants.AdminRoleValue)
+	mocks.UserRepository.CountUsersByRoleResult = common.NewResultOnSuccess[int64](2)
+
+	deleteUserError := adminUseCase.DeleteUserById(context.Background(), userID, currentUserID, constants.AdminRoleValue)
+
+	assert.IsType(t, domain.AuthorizationError{}, deleteUserError, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.DeletedUserIDs, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.RevokedSessionUserIDs, test.EqualMessage)
+}
+
+func TestDeleteUserByIdLastAdmin(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+	mocks.UserRepository.GetUserByIdResult = userWithRole(userID, constants.AdminRoleValue)
+	mocks.UserRepository.CountUsersByRoleResult = common.NewResultOnSuccess[int64](1)
+
+	deleteUserError := adminUseCase.DeleteUserById(context.Background(), userID, currentUserID, constants.AdminRoleValue)
+
+	assert.IsType(t, domain.ConflictError{}, deleteUserError, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.DeletedUserIDs, test.EqualMessage)
+}
+
+func TestForcePasswordResetRefusesOldPassword(t *testing.T) {
+	t.Parallel()
+	requireEmailDomain(t)
+	userUseCase, mocks := newUserUseCase()
+	adminUseCase := usecase.NewAdminUseCase(mock.NewMockConfig(), mocks.Logger, mocks.Email, mocks.UserRepository, mocks.RefreshTokenRepository, mocks.InvitationRepository)
+	forcedUser := passwordUser()
+	forcedUser.Verified = true
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(forcedUser)
+	mocks.UserRepository.GetUserByEmailResult = common.NewResultOnSuccess(forcedUser)
+
+	forcePasswordResetError := adminUseCase.ForcePasswordReset(context.Background(), userID, currentUserID, constants.AdminRoleValue)
+	userToken := userUseCase.Login(context.Background(), user.NewUserLogin(userEmail, userPassword), user.UserDevice{IPAddress: ipAddress})
+
+	assert.NoError(t, forcePasswordResetError, test.ErrorNilMessage)
+	assert.True(t, mocks.UserRepository.ForgottenPasswords[0].MustResetPassword, test.NotFailureMessage)
+	assert.Equal(t, []string{userID}, mocks.RefreshTokenRepository.RevokedSessionUserIDs, test.EqualMessage)
+	assert.IsType(t, domain.MustResetPasswordError{}, userToken.Error, test.EqualMessage)
+	assert.Equal(t, constants.MustResetPasswordNotification, userToken.Error.(domain.MustResetPasswordError).Notification, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.CreatedRefreshTokens, test.EqualMessage)
+}
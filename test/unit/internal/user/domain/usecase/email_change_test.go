@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	usecase "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/usecase"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/common"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+	repository "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/data/repository"
+)
+
+const (
+	emailChangeToken       = "emailChangeToken1234"
+	emailChangeCancelToken = "emailChangeCancel123"
+)
+
+// pendingEmailChange stores a change of the email of the user to otherUserEmail, which expires at the provided time.
+func pendingEmailChange(userRepository *repository.MockUserRepository, expiry time.Time) {
+	userEmailChange := user.NewUserEmailChange(userID, otherUserEmail, "")
+	userEmailChange.ConfirmationToken = utility.HashToken(emailChangeToken)
+	userEmailChange.CancelToken = utility.HashToken(emailChangeCancelToken)
+	userEmailChange.Expiry = expiry
+	userRepository.EmailChanges[userEmailChange.ConfirmationToken] = userEmailChange
+}
+
+func TestRequestEmailChange(t *testing.T) {
+	t.Parallel()
+	requireEmailDomain(t)
+	userUseCase, mocks := newUserUseCase()
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(passwordUser())
+
+	requestEmailChangeError := userUseCase.RequestEmailChange(context.Background(), user.NewUserEmailChange(userID, otherUserEmail, userPassword), user.UserDevice{IPAddress: ipAddress})
+
+	assert.NoError(t, requestEmailChangeError, test.ErrorNilMessage)
+	assert.Len(t, mocks.UserRepository.EmailChanges, 1, test.EqualMessage)
+	for _, userEmailChange := range mocks.UserRepository.EmailChanges {
+		assert.Equal(t, otherUserEmail, userEmailChange.Email, test.EqualMessage)
+		assert.NotEqual(t, userEmailChange.ConfirmationToken, userEmailChange.CancelToken, test.EqualMessage)
+	}
+	assert.Equal(t, int64(2), mocks.Email.SentEmails(), test.EqualMessage)
+}
+
+func TestRequestEmailChangeWrongPassword(t *testing.T) {
+	t.Parallel()
+	requireEmailDomain(t)
+	userUseCase, mocks := newUserUseCase()
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(passwordUser())
+
+	requestEmailChangeError := userUseCase.RequestEmailChange(context.Background(), user.NewUserEmailChange(userID, otherUserEmail, wrongPassword), user.UserDevice{IPAddress: ipAddress})
+
+	assert.IsType(t, domain.ValidationError{}, requestEmailChangeError, test.EqualMessage)
+	assert.Equal(t, 1, mocks.LoginAttemptRepository.LoginAttempts[emailAttemptsKey].FailedAttempts, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.EmailChanges, test.EqualMessage)
+	assert.Equal(t, int64(0), mocks.Email.SentEmails(), test.EqualMessage)
+}
+
+func TestConfirmEmailChange(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	pendingEmailChange(mocks.UserRepository, time.Now().Add(time.Hour))
+
+	confirmEmailChangeError := userUseCase.ConfirmEmailChange(context.Background(), utility.Encode(emailChangeToken))
+
+	assert.NoError(t, confirmEmailChangeError, test.ErrorNilMessage)
+	assert.Len(t, mocks.UserRepository.ConfirmedEmailChanges, 1, test.EqualMessage)
+	assert.Equal(t, otherUserEmail, mocks.UserRepository.ConfirmedEmailChanges[0].Email, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.EmailChanges, test.EqualMessage)
+}
+
+func TestConfirmEmailChangeExpiredToken(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	pendingEmailChange(mocks.UserRepository, time.Now().Add(-time.Minute))
+
+	confirmEmailChangeError := userUseCase.ConfirmEmailChange(context.Background(), utility.Encode(emailChangeToken))
+
+	assert.IsType(t, domain.TimeExpiredError{}, confirmEmailChangeError, test.EqualMessage)
+	assert.Equal(t, constants.TimeExpiredErrorNotification, confirmEmailChangeError.(domain.TimeExpiredError).Notification, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.ConfirmedEmailChanges, test.EqualMessage)
+}
+
+func TestConfirmEmailChangeDuplicateEmail(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	pendingEmailChange(mocks.UserRepository, time.Now().Add(time.Hour))
+	mocks.UserRepository.CheckEmailDuplicateError = domain.NewValidationError(location+"TestConfirmEmailChangeDuplicateEmail", usecase.EmailField, constants.FieldRequired, constants.EmailAlreadyExists)
+
+	confirmEmailChangeError := userUseCase.ConfirmEmailChange(context.Background(), utility.Encode(emailChangeToken))
+
+	assert.IsType(t, domain.ValidationError{}, confirmEmailChangeError, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.ConfirmedEmailChanges, test.EqualMessage)
+	assert.Len(t, mocks.UserRepository.EmailChanges, 1, test.EqualMessage)
+}
+
+func TestConfirmEmailChangeAfterCancel(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	pendingEmailChange(mocks.UserRepository, time.Now().Add(time.Hour))
+
+	cancelEmailChangeError := userUseCase.CancelEmailChange(context.Background(), utility.Encode(emailChangeCancelToken))
+	confirmEmailChangeError := userUseCase.ConfirmEmailChange(context.Background(), utility.Encode(emailChangeToken))
+
+	assert.NoError(t, cancelEmailChangeError, test.ErrorNilMessage)
+	assert.IsType(t, domain.InvalidTokenError{}, confirmEmailChangeError, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.ConfirmedEmailChanges, test.EqualMessage)
+}
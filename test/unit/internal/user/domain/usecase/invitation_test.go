@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	usecase "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/usecase"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/common"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+)
+
+const (
+	invitationID    = "6b3f9a5a1d3a0d3c4e5f6071"
+	invitationCode  = "Invitation1234567890"
+	username        = "Invited User"
+	otherUsername   = "Other User"
+	otherUserEmail  = "other.user@gmail.com"
+	tooManyUses     = 1001
+	invitationUsers = 1
+)
+
+// newInviteOnlyUserUseCase returns a use case that requires an invitation to register,
+// with an invitation of the code that can be used maxUses times until expiresAt.
+func newInviteOnlyUserUseCase(maxUses int, expiresAt time.Time) (usecase.UserUseCase, userUseCaseMocks) {
+	userUseCase, mocks := newUserUseCase()
+	userUseCase.Config.Security.Registration.Mode = constants.InviteOnlyRegistration
+	mocks.InvitationRepository.Invitations[utility.HashToken(invitationCode)] = user.NewInvitation(invitationID, currentUserID, maxUses, 0, expiresAt, false, time.Now(), time.Now())
+	return userUseCase, mocks
+}
+
+func invitationUses(mocks userUseCaseMocks) int {
+	return mocks.InvitationRepository.Invitations[utility.HashToken(invitationCode)].Uses
+}
+
+func TestCreateInvitationDefaults(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+
+	createdInvitation := adminUseCase.CreateInvitation(context.Background(), user.NewInvitationCreate(currentUserID, 0, time.Time{}))
+
+	assert.NoError(t, createdInvitation.Error, test.ErrorNilMessage)
+	assert.Equal(t, 1, createdInvitation.Data.Invitation.MaxUses, test.EqualMessage)
+	assert.WithinDuration(t, time.Now().Add(constants.InvitationExpirationTime), createdInvitation.Data.Invitation.ExpiresAt, time.Minute, test.EqualMessage)
+	assert.Len(t, createdInvitation.Data.Code, len(invitationCode), test.EqualMessage)
+	assert.Equal(t, utility.HashToken(createdInvitation.Data.Code), mocks.InvitationRepository.CreatedInvitations[0].CodeHash, test.EqualMessage)
+}
+
+func TestCreateInvitationTooManyUses(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+
+	createdInvitation := adminUseCase.CreateInvitation(context.Background(), user.NewInvitationCreate(currentUserID, tooManyUses, time.Time{}))
+
+	assert.IsType(t, domain.ValidationErrors{}, createdInvitation.Error, test.EqualMessage)
+	assert.Empty(t, mocks.InvitationRepository.CreatedInvitations, test.EqualMessage)
+}
+
+func TestCreateInvitationExpired(t *testing.T) {
+	t.Parallel()
+	adminUseCase, mocks := newAdminUseCase()
+
+	createdInvitation := adminUseCase.CreateInvitation(context.Background(), user.NewInvitationCreate(currentUserID, 1, time.Now().Add(-time.Hour)))
+
+	assert.IsType(t, domain.ValidationErrors{}, createdInvitation.Error, test.EqualMessage)
+	assert.Empty(t, mocks.InvitationRepository.CreatedInvitations, test.EqualMessage)
+}
+
+func TestRegisterWithoutInvitation(t *testing.T) {
+	t.Parallel()
+	requireEmailDomain(t)
+	userUseCase, mocks := newInviteOnlyUserUseCase(invitationUsers, time.Now().Add(time.Hour))
+
+	createdUser := userUseCase.Register(context.Background(), user.NewUserCreate(username, userEmail, userPassword, userPassword, ""))
+
+	assert.IsType(t, domain.ValidationError{}, createdUser.Error, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.RegisteredUsers, test.EqualMessage)
+}
+
+func TestRegisterWithUsedUpInvitation(t *testing.T) {
+	t.Parallel()
+	requireEmailDomain(t)
+	userUseCase, mocks := newInviteOnlyUserUseCase(invitationUsers, time.Now().Add(time.Hour))
+
+	createdUser := userUseCase.Register(context.Background(), user.NewUserCreate(username, userEmail, userPassword, userPassword, invitationCode))
+	rejectedUser := userUseCase.Register(context.Background(), user.NewUserCreate(otherUsername, otherUserEmail, userPassword, userPassword, invitationCode))
+
+	assert.NoError(t, createdUser.Error, test.ErrorNilMessage)
+	assert.IsType(t, domain.ValidationError{}, rejectedUser.Error, test.EqualMessage)
+	assert.Len(t, mocks.UserRepository.RegisteredUsers, 1, test.EqualMessage)
+	assert.Equal(t, invitationUsers, invitationUses(mocks), test.EqualMessage)
+}
+
+func TestRegisterWithExpiredInvitation(t *testing.T) {
+	t.Parallel()
+	requireEmailDomain(t)
+	userUseCase, mocks := newInviteOnlyUserUseCase(invitationUsers, time.Now().Add(-time.Minute))
+
+	createdUser := userUseCase.Register(context.Background(), user.NewUserCreate(username, userEmail, userPassword, userPassword, invitationCode))
+
+	assert.IsType(t, domain.ValidationError{}, createdUser.Error, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.RegisteredUsers, test.EqualMessage)
+	assert.Equal(t, 0, invitationUses(mocks), test.EqualMessage)
+}
+
+func TestRegisterFailureReleasesInvitation(t *testing.T) {
+	t.Parallel()
+	requireEmailDomain(t)
+	userUseCase, mocks := newInviteOnlyUserUseCase(invitationUsers, time.Now().Add(time.Hour))
+	mocks.UserRepository.RegisterError = domain.NewInternalError(location+"TestRegisterFailureReleasesInvitation", unknownKey)
+
+	createdUser := userUseCase.Register(context.Background(), user.NewUserCreate(username, userEmail, userPassword, userPassword, invitationCode))
+
+	assert.IsType(t, domain.InternalError{}, createdUser.Error, test.EqualMessage)
+	assert.Equal(t, 0, invitationUses(mocks), test.EqualMessage)
+}
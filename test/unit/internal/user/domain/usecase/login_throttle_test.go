@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/common"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+	repository "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/data/repository"
+	hasher "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/dependency/hasher"
+)
+
+const (
+	newPassword      = "New-password-5678"
+	unlockToken      = "unlockToken1234567890"
+	otherIPAddress   = "198.51.100.23"
+	emailAttemptsKey = "email:" + userEmail
+	ipAttemptsKey    = "ip:" + ipAddress
+)
+
+// passwordUser returns a user whose current password is userPassword.
+func passwordUser() user.User {
+	passwordUser := newUser(userID)
+	passwordUser.Email = userEmail
+	passwordUser.Password = hasher.Hash(userPassword)
+	return passwordUser
+}
+
+// lockedLoginAttempts stores a locked email with the unlock token and blocked IP addresses.
+func lockedLoginAttempts(loginAttemptRepository *repository.MockLoginAttemptRepository, ipAddresses ...string) {
+	lockedUntil := time.Now().Add(time.Hour)
+	loginAttemptRepository.LoginAttempts[emailAttemptsKey] = user.NewLoginAttempt(emailAttemptsKey, maxAttemptsPerEmail, lockedUntil, true, lockedUntil)
+	loginAttemptRepository.UnlockTokens[utility.HashToken(unlockToken)] = emailAttemptsKey
+	for _, ipAddress := range ipAddresses {
+		loginAttemptRepository.LoginAttempts["ip:"+ipAddress] = user.NewLoginAttempt("ip:"+ipAddress, maxAttemptsPerIP, lockedUntil, true, lockedUntil)
+	}
+}
+
+func TestUpdatePasswordBlocksAfterFailedAttempt(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(passwordUser())
+
+	wrongPasswordError := userUseCase.UpdatePassword(context.Background(), user.NewUserPasswordUpdate(userID, wrongPassword, newPassword, newPassword), "", user.UserDevice{IPAddress: ipAddress})
+	throttledError := userUseCase.UpdatePassword(context.Background(), user.NewUserPasswordUpdate(userID, userPassword, newPassword, newPassword), "", user.UserDevice{IPAddress: ipAddress})
+
+	assert.IsType(t, domain.ValidationError{}, wrongPasswordError, test.EqualMessage)
+	assert.IsType(t, domain.TooManyRequestsError{}, throttledError, test.EqualMessage)
+	assert.Equal(t, constants.TooManyLoginAttemptsNotification, throttledError.(domain.TooManyRequestsError).Notification, test.EqualMessage)
+	assert.False(t, mocks.LoginAttemptRepository.LoginAttempts[emailAttemptsKey].Locked, test.FailureMessage)
+	assert.Empty(t, mocks.LoginAttemptRepository.UnlockTokens, test.EqualMessage)
+	assert.Equal(t, int64(0), mocks.Email.SentEmails(), test.EqualMessage)
+}
+
+func TestUpdatePasswordLocksAccount(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(passwordUser())
+	mocks.UserRepository.GetUserByEmailResult = common.NewResultOnSuccess(passwordUser())
+	mocks.LoginAttemptRepository.LoginAttempts[emailAttemptsKey] = user.NewLoginAttempt(emailAttemptsKey, maxAttemptsPerEmail-1, time.Time{}, false, time.Now().Add(time.Hour))
+
+	wrongPasswordError := userUseCase.UpdatePassword(context.Background(), user.NewUserPasswordUpdate(userID, wrongPassword, newPassword, newPassword), "", user.UserDevice{IPAddress: ipAddress})
+	lockedError := userUseCase.UpdatePassword(context.Background(), user.NewUserPasswordUpdate(userID, userPassword, newPassword, newPassword), "", user.UserDevice{IPAddress: otherIPAddress})
+
+	assert.IsType(t, domain.ValidationError{}, wrongPasswordError, test.EqualMessage)
+	assert.True(t, mocks.LoginAttemptRepository.LoginAttempts[emailAttemptsKey].Locked, test.NotFailureMessage)
+	assert.Len(t, mocks.LoginAttemptRepository.UnlockTokens, 1, test.EqualMessage)
+	assert.Equal(t, int64(1), mocks.Email.SentEmails(), test.EqualMessage)
+	assert.IsType(t, domain.TooManyRequestsError{}, lockedError, test.EqualMessage)
+	assert.Equal(t, constants.LoginLockedNotification, lockedError.(domain.TooManyRequestsError).Notification, test.EqualMessage)
+}
+
+func TestUnlockAccount(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	lockedLoginAttempts(mocks.LoginAttemptRepository, ipAddress, otherIPAddress)
+
+	unlockAccountError := userUseCase.UnlockAccount(context.Background(), utility.Encode(unlockToken), user.UserDevice{IPAddress: ipAddress})
+
+	assert.NoError(t, unlockAccountError, test.ErrorNilMessage)
+	assert.NotContains(t, mocks.LoginAttemptRepository.LoginAttempts, emailAttemptsKey, test.EqualMessage)
+	assert.NotContains(t, mocks.LoginAttemptRepository.LoginAttempts, ipAttemptsKey, test.EqualMessage)
+	assert.Contains(t, mocks.LoginAttemptRepository.LoginAttempts, "ip:"+otherIPAddress, test.EqualMessage)
+	assert.Empty(t, mocks.LoginAttemptRepository.UnlockTokens, test.EqualMessage)
+}
+
+func TestUnlockAccountInvalidToken(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	lockedLoginAttempts(mocks.LoginAttemptRepository, ipAddress)
+
+	unlockAccountError := userUseCase.UnlockAccount(context.Background(), utility.Encode(unknownKey), user.UserDevice{IPAddress: ipAddress})
+
+	assert.IsType(t, domain.InvalidTokenError{}, unlockAccountError, test.EqualMessage)
+	assert.Contains(t, mocks.LoginAttemptRepository.LoginAttempts, emailAttemptsKey, test.EqualMessage)
+	assert.Contains(t, mocks.LoginAttemptRepository.LoginAttempts, ipAttemptsKey, test.EqualMessage)
+}
@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/common"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+	repository "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/data/repository"
+)
+
+const (
+	magicLinkToken = "magicLinkToken123456"
+)
+
+// magicLinkUser returns a verified user that can log in with a magic link.
+func magicLinkUser() user.User {
+	magicLinkUser := newUser(userID)
+	magicLinkUser.Email = userEmail
+	magicLinkUser.Verified = true
+	return magicLinkUser
+}
+
+// storedMagicLink stores a magic link of the user that expires at the provided time.
+func storedMagicLink(userRepository *repository.MockUserRepository, expiry time.Time) {
+	userMagicLink := user.NewUserMagicLink(userEmail)
+	userMagicLink.ID = userID
+	userMagicLink.MagicLinkToken = utility.HashToken(magicLinkToken)
+	userMagicLink.MagicLinkExpiry = expiry
+	userRepository.MagicLinks[userMagicLink.MagicLinkToken] = userMagicLink
+}
+
+func TestRequestMagicLink(t *testing.T) {
+	t.Parallel()
+	requireEmailDomain(t)
+	userUseCase, mocks := newUserUseCase()
+	mocks.UserRepository.GetUserByEmailResult = common.NewResultOnSuccess(magicLinkUser())
+
+	requestMagicLinkError := userUseCase.RequestMagicLink(context.Background(), user.NewUserMagicLink(userEmail))
+
+	assert.NoError(t, requestMagicLinkError, test.ErrorNilMessage)
+	assert.Len(t, mocks.UserRepository.MagicLinks, 1, test.EqualMessage)
+	assert.Equal(t, int64(1), mocks.Email.SentEmails(), test.EqualMessage)
+}
+
+func TestRequestMagicLinkUnknownEmail(t *testing.T) {
+	t.Parallel()
+	requireEmailDomain(t)
+	userUseCase, mocks := newUserUseCase()
+	notFoundError := domain.NewValidationError(location+"TestRequestMagicLinkUnknownEmail", unknownKey, "", unknownKey)
+	mocks.UserRepository.GetUserByEmailResult = common.NewResultOnFailure[user.User](notFoundError)
+
+	requestMagicLinkError := userUseCase.RequestMagicLink(context.Background(), user.NewUserMagicLink(userEmail))
+
+	assert.NoError(t, requestMagicLinkError, test.ErrorNilMessage)
+	assert.Empty(t, mocks.UserRepository.MagicLinks, test.EqualMessage)
+	assert.Equal(t, int64(0), mocks.Email.SentEmails(), test.EqualMessage)
+}
+
+func TestRequestMagicLinkDatabaseError(t *testing.T) {
+	t.Parallel()
+	requireEmailDomain(t)
+	userUseCase, mocks := newUserUseCase()
+	mocks.UserRepository.GetUserByEmailResult = common.NewResultOnFailure[user.User](domain.NewInternalError(location+"TestRequestMagicLinkDatabaseError", unknownKey))
+
+	requestMagicLinkError := userUseCase.RequestMagicLink(context.Background(), user.NewUserMagicLink(userEmail))
+
+	assert.IsType(t, domain.InternalError{}, requestMagicLinkError, test.EqualMessage)
+	assert.Equal(t, int64(0), mocks.Email.SentEmails(), test.EqualMessage)
+}
+
+func TestLoginWithMagicLinkConsumedToken(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(magicLinkUser())
+	storedMagicLink(mocks.UserRepository, time.Now().Add(time.Hour))
+
+	userToken := userUseCase.LoginWithMagicLink(context.Background(), utility.Encode(magicLinkToken), user.UserDevice{IPAddress: ipAddress})
+	reusedUserToken := userUseCase.LoginWithMagicLink(context.Background(), utility.Encode(magicLinkToken), user.UserDevice{IPAddress: ipAddress})
+
+	assert.NoError(t, userToken.Error, test.ErrorNilMessage)
+	assert.NotEmpty(t, userToken.Data.AccessToken, test.EqualMessage)
+	assert.IsType(t, domain.InvalidTokenError{}, reusedUserToken.Error, test.EqualMessage)
+	assert.Len(t, mocks.RefreshTokenRepository.CreatedRefreshTokens, 1, test.EqualMessage)
+}
+
+func TestLoginWithMagicLinkExpiredToken(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(magicLinkUser())
+	storedMagicLink(mocks.UserRepository, time.Now().Add(-time.Minute))
+
+	userToken := userUseCase.LoginWithMagicLink(context.Background(), utility.Encode(magicLinkToken), user.UserDevice{IPAddress: ipAddress})
+
+	assert.IsType(t, domain.InvalidTokenError{}, userToken.Error, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.CreatedRefreshTokens, test.EqualMessage)
+}
+
+func TestLoginWithMagicLinkSuspendedUser(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	suspendedUser := magicLinkUser()
+	suspendedUser.Suspended = true
+	suspendedUser.SuspensionReason = suspensionReason
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(suspendedUser)
+	storedMagicLink(mocks.UserRepository, time.Now().Add(time.Hour))
+
+	userToken := userUseCase.LoginWithMagicLink(context.Background(), utility.Encode(magicLinkToken), user.UserDevice{IPAddress: ipAddress})
+
+	assert.IsType(t, domain.UserSuspendedError{}, userToken.Error, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.CreatedRefreshTokens, test.EqualMessage)
+}
+
+func TestLoginWithMagicLinkUnverifiedUser(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	unverifiedUser := magicLinkUser()
+	unverifiedUser.Verified = false
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(unverifiedUser)
+	storedMagicLink(mocks.UserRepository, time.Now().Add(time.Hour))
+
+	userToken := userUseCase.LoginWithMagicLink(context.Background(), utility.Encode(magicLinkToken), user.UserDevice{IPAddress: ipAddress})
+
+	assert.IsType(t, domain.EmailNotVerifiedError{}, userToken.Error, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.CreatedRefreshTokens, test.EqualMessage)
+}
+
+func TestLoginWithMagicLinkTwoFactorUser(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	fetchedUser, _ := twoFactorUser(t)
+	fetchedUser.Verified = true
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(fetchedUser)
+	storedMagicLink(mocks.UserRepository, time.Now().Add(time.Hour))
+
+	userToken := userUseCase.LoginWithMagicLink(context.Background(), utility.Encode(magicLinkToken), user.UserDevice{IPAddress: ipAddress})
+
+	assert.NoError(t, userToken.Error, test.ErrorNilMessage)
+	assert.NotEmpty(t, userToken.Data.MFAToken, test.EqualMessage)
+	assert.Empty(t, userToken.Data.AccessToken, test.EqualMessage)
+	assert.Len(t, mocks.UserRepository.MFATokens, 1, test.EqualMessage)
+	assert.Equal(t, utility.HashToken(userToken.Data.MFAToken), mocks.UserRepository.MFATokens[0].MFAToken, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.CreatedRefreshTokens, test.EqualMessage)
+}
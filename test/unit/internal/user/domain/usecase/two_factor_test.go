@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+	mock "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/common"
+	hasher "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/dependency/hasher"
+)
+
+const wrongPassword = "Wrong-password-1234"
+
+// twoFactorUser returns a user with two-factor authentication enabled and a TOTP code that is valid right now.
+func twoFactorUser(t *testing.T) (user.User, string) {
+	secret := utility.GenerateTOTPSecret(mock.NewMockLogger(), location+"twoFactorUser")
+	assert.NoError(t, secret.Error, test.ErrorNilMessage)
+	code := utility.GenerateTOTPCode(mock.NewMockLogger(), location+"twoFactorUser", secret.Data, time.Now())
+	assert.NoError(t, code.Error, test.ErrorNilMessage)
+
+	twoFactorUser := newUser(userID)
+	twoFactorUser.Email = userEmail
+	twoFactorUser.Password = hasher.Hash(userPassword)
+	twoFactorUser.TwoFactorEnabled = true
+	twoFactorUser.TwoFactorSecret = secret.Data
+	return twoFactorUser, code.Data
+}
+
+func TestDisableTwoFactor(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	fetchedUser, code := twoFactorUser(t)
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(fetchedUser)
+
+	disableTwoFactorError := userUseCase.DisableTwoFactor(context.Background(), user.NewUserTwoFactorDisable(userID, userPassword, code, ""), user.UserDevice{IPAddress: ipAddress})
+
+	assert.NoError(t, disableTwoFactorError, test.ErrorNilMessage)
+	assert.Equal(t, []string{userID}, mocks.UserRepository.DisabledTwoFactorUserIDs, test.EqualMessage)
+	assert.Len(t, mocks.UserRepository.TwoFactorLastSteps, 1, test.EqualMessage)
+}
+
+func TestDisableTwoFactorWithoutPassword(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	fetchedUser, code := twoFactorUser(t)
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(fetchedUser)
+
+	disableTwoFactorError := userUseCase.DisableTwoFactor(context.Background(), user.NewUserTwoFactorDisable(userID, "", code, ""), user.UserDevice{IPAddress: ipAddress})
+
+	assert.IsType(t, domain.ValidationErrors{}, disableTwoFactorError, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.DisabledTwoFactorUserIDs, test.EqualMessage)
+	assert.Empty(t, mocks.LoginAttemptRepository.LoginAttempts, test.EqualMessage)
+}
+
+func TestDisableTwoFactorWrongPassword(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	fetchedUser, code := twoFactorUser(t)
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(fetchedUser)
+
+	disableTwoFactorError := userUseCase.DisableTwoFactor(context.Background(), user.NewUserTwoFactorDisable(userID, wrongPassword, code, ""), user.UserDevice{IPAddress: ipAddress})
+
+	assert.IsType(t, domain.ValidationError{}, disableTwoFactorError, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.DisabledTwoFactorUserIDs, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.TwoFactorLastSteps, test.EqualMessage)
+	assert.Equal(t, 1, mocks.LoginAttemptRepository.LoginAttempts["email:"+userEmail].FailedAttempts, test.EqualMessage)
+	assert.Equal(t, 1, mocks.LoginAttemptRepository.LoginAttempts["ip:"+ipAddress].FailedAttempts, test.EqualMessage)
+}
+
+func TestDisableTwoFactorThrottled(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	fetchedUser, code := twoFactorUser(t)
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(fetchedUser)
+
+	userUseCase.DisableTwoFactor(context.Background(), user.NewUserTwoFactorDisable(userID, wrongPassword, code, ""), user.UserDevice{IPAddress: ipAddress})
+	// The backoff of the failed attempt blocks the right password too.
+	disableTwoFactorError := userUseCase.DisableTwoFactor(context.Background(), user.NewUserTwoFactorDisable(userID, userPassword, code, ""), user.UserDevice{IPAddress: ipAddress})
+
+	assert.IsType(t, domain.TooManyRequestsError{}, disableTwoFactorError, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.DisabledTwoFactorUserIDs, test.EqualMessage)
+}
+
+func TestDisableTwoFactorWrongCode(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	fetchedUser, _ := twoFactorUser(t)
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(fetchedUser)
+
+	disableTwoFactorError := userUseCase.DisableTwoFactor(context.Background(), user.NewUserTwoFactorDisable(userID, userPassword, "000000", ""), user.UserDevice{IPAddress: ipAddress})
+
+	assert.IsType(t, domain.ValidationError{}, disableTwoFactorError, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.DisabledTwoFactorUserIDs, test.EqualMessage)
+	assert.Equal(t, 1, mocks.LoginAttemptRepository.LoginAttempts["email:"+userEmail].FailedAttempts, test.EqualMessage)
+}
+
+func TestDisableTwoFactorReplayedCode(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	fetchedUser, code := twoFactorUser(t)
+	// The code has been accepted already, the next step covers a step change while the test runs.
+	fetchedUser.TwoFactorLastStep = time.Now().Unix()/30 + 1
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(fetchedUser)
+
+	disableTwoFactorError := userUseCase.DisableTwoFactor(context.Background(), user.NewUserTwoFactorDisable(userID, userPassword, code, ""), user.UserDevice{IPAddress: ipAddress})
+
+	assert.IsType(t, domain.ValidationError{}, disableTwoFactorError, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.TwoFactorLastSteps, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.DisabledTwoFactorUserIDs, test.EqualMessage)
+}
+
+func TestDisableTwoFactorConcurrentlyUsedCode(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	fetchedUser, code := twoFactorUser(t)
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(fetchedUser)
+	// Another request has stored the step of the code after the user was fetched.
+	mocks.UserRepository.UpdateTwoFactorLastStepError = domain.NewItemNotFoundError(location+"TestDisableTwoFactorConcurrentlyUsedCode", userID, unknownKey)
+
+	disableTwoFactorError := userUseCase.DisableTwoFactor(context.Background(), user.NewUserTwoFactorDisable(userID, userPassword, code, ""), user.UserDevice{IPAddress: ipAddress})
+
+	assert.IsType(t, domain.ValidationError{}, disableTwoFactorError, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.DisabledTwoFactorUserIDs, test.EqualMessage)
+}
@@ -1 +1,327 @@
 package usecase
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	usecase "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/usecase"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/domain"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+	mock "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/common"
+	repository "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/data/repository"
+	breach "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/dependency/breach"
+	email "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/dependency/email"
+	hasher "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/dependency/hasher"
+)
+
+const (
+	location = "test.unit.internal.user.domain.usecase."
+
+	userID         = "5f1d7e3e9b1e8b1a2c3d4e5f"
+	userEmail      = "user@gmail.com"
+	emailHost      = "gmail.com"
+	unknownKey     = "unknown"
+	userPassword   = "Password-1234"
+	ipAddress      = "203.0.113.7"
+	refreshTokenID = "3b0c9c1e-8f1c-4b8e-9a51-2f4f8d1c7e10"
+	familyID       = "7c1d0e2f-9a3b-4c5d-8e6f-0a1b2c3d4e5f"
+
+	maxAttemptsPerEmail = 3
+	maxAttemptsPerIP    = 10
+)
+
+// userUseCaseMocks holds the mocks of the dependencies a use case under test is created with.
+type userUseCaseMocks struct {
+	Logger                 *mock.MockLogger
+	Email                  *email.MockEmail
+	UserRepository         *repository.MockUserRepository
+	RefreshTokenRepository *repository.MockRefreshTokenRepository
+	LoginAttemptRepository *repository.MockLoginAttemptRepository
+	InvitationRepository   *repository.MockInvitationRepository
+	BreachedPasswords      *breach.MockBreachedPasswords
+}
+
+// requireEmailDomain skips the test when the domain of the test email can't be resolved,
+// the use cases check the domain of an email address with a DNS lookup.
+func requireEmailDomain(t *testing.T) {
+	_, lookupMXError := net.LookupMX(emailHost)
+	if lookupMXError != nil {
+		t.Skip("the email domain can't be resolved: " + lookupMXError.Error())
+	}
+}
+
+func setupKeyRings() utility.KeyRings {
+	keyRing := utility.NewKeyRing(mock.NewMockLogger(), location+"setupKeyRings", constants.RS256, "", test.PrivateKey, test.PublicKey, nil, utility.TokenClaims{}).Data
+	return utility.KeyRings{AccessToken: keyRing, RefreshToken: keyRing}
+}
+
+func newUserUseCase() (usecase.UserUseCase, userUseCaseMocks) {
+	mockConfig := mock.NewMockConfig()
+	mockConfig.AccessToken.ExpiredIn = time.Minute
+	mockConfig.RefreshToken.ExpiredIn = time.Hour
+	mockConfig.Security.PasswordPolicy = config.PasswordPolicy{MinLength: constants.DefaultMinStringLength, MaxLength: constants.DefaultMaxStringLength}
+	mockConfig.Security.LoginThrottle = config.LoginThrottle{
+		MaxAttemptsPerEmail: maxAttemptsPerEmail,
+		MaxAttemptsPerIP:    maxAttemptsPerIP,
+		AttemptWindow:       time.Hour,
+		BackoffBase:         time.Second,
+		BackoffMax:          time.Minute,
+		LockoutDuration:     time.Hour,
+	}
+	mocks := userUseCaseMocks{
+		Logger:                 mock.NewMockLogger(),
+		Email:                  email.NewMockEmail(),
+		UserRepository:         repository.NewMockUserRepository(),
+		RefreshTokenRepository: repository.NewMockRefreshTokenRepository(),
+		LoginAttemptRepository: repository.NewMockLoginAttemptRepository(),
+		InvitationRepository:   repository.NewMockInvitationRepository(),
+		BreachedPasswords:      breach.NewMockBreachedPasswords(),
+	}
+	userUseCase := usecase.NewUserUseCase(
+		mockConfig,
+		mocks.Logger,
+		mocks.Email,
+		setupKeyRings(),
+		mocks.UserRepository,
+		mocks.RefreshTokenRepository,
+		mocks.LoginAttemptRepository,
+		nil,
+		nil,
+		nil,
+		hasher.NewMockPasswordHasher(),
+		mocks.BreachedPasswords,
+		mocks.InvitationRepository,
+	)
+
+	return userUseCase, mocks
+}
+
+func newUser(id string) user.User {
+	return user.User{BaseEntity: model.BaseEntity{ID: id}}
+}
+
+func activeRefreshToken() common.Result[user.RefreshToken] {
+	return common.NewResultOnSuccess(user.RefreshToken{TokenID: refreshTokenID, FamilyID: familyID, UserID: userID, ExpiresAt: time.Now().Add(time.Hour)})
+}
+
+func TestResendVerificationCodeUnverifiedUser(t *testing.T) {
+	t.Parallel()
+	requireEmailDomain(t)
+	userUseCase, mocks := newUserUseCase()
+	mocks.UserRepository.GetUserByEmailResult = common.NewResultOnSuccess(user.User{Email: userEmail})
+
+	resendVerificationCodeError := userUseCase.ResendVerificationCode(context.Background(), user.UserVerificationCode{Email: userEmail})
+
+	assert.NoError(t, resendVerificationCodeError, test.ErrorNilMessage)
+	assert.Len(t, mocks.UserRepository.UpdateVerificationCodes, 1, test.EqualMessage)
+	assert.Equal(t, int64(1), mocks.Email.SentEmails(), test.EqualMessage)
+}
+
+func TestResendVerificationCodeUnknownEmail(t *testing.T) {
+	t.Parallel()
+	requireEmailDomain(t)
+	userUseCase, mocks := newUserUseCase()
+	notFoundError := domain.NewValidationError(location+"TestResendVerificationCodeUnknownEmail", unknownKey, "", unknownKey)
+	mocks.UserRepository.GetUserByEmailResult = common.NewResultOnFailure[user.User](notFoundError)
+
+	resendVerificationCodeError := userUseCase.ResendVerificationCode(context.Background(), user.UserVerificationCode{Email: userEmail})
+
+	assert.NoError(t, resendVerificationCodeError, test.ErrorNilMessage)
+	assert.Empty(t, mocks.UserRepository.UpdateVerificationCodes, test.EqualMessage)
+	assert.Equal(t, int64(0), mocks.Email.SentEmails(), test.EqualMessage)
+}
+
+func TestResendVerificationCodeVerifiedUser(t *testing.T) {
+	t.Parallel()
+	requireEmailDomain(t)
+	userUseCase, mocks := newUserUseCase()
+	mocks.UserRepository.GetUserByEmailResult = common.NewResultOnSuccess(user.User{Email: userEmail, Verified: true})
+
+	resendVerificationCodeError := userUseCase.ResendVerificationCode(context.Background(), user.UserVerificationCode{Email: userEmail})
+
+	assert.NoError(t, resendVerificationCodeError, test.ErrorNilMessage)
+	assert.Empty(t, mocks.UserRepository.UpdateVerificationCodes, test.EqualMessage)
+	assert.Equal(t, int64(0), mocks.Email.SentEmails(), test.EqualMessage)
+}
+
+func TestResendVerificationCodeDatabaseError(t *testing.T) {
+	t.Parallel()
+	requireEmailDomain(t)
+	userUseCase, mocks := newUserUseCase()
+	internalError := domain.NewInternalError(location+"TestResendVerificationCodeDatabaseError", unknownKey)
+	mocks.UserRepository.GetUserByEmailResult = common.NewResultOnFailure[user.User](internalError)
+
+	resendVerificationCodeError := userUseCase.ResendVerificationCode(context.Background(), user.UserVerificationCode{Email: userEmail})
+
+	assert.IsType(t, domain.InternalError{}, resendVerificationCodeError, test.EqualMessage)
+	assert.Equal(t, int64(0), mocks.Email.SentEmails(), test.EqualMessage)
+}
+
+func TestRefreshAccessTokenRotatesToken(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	mocks.RefreshTokenRepository.GetRefreshTokenByTokenIDResult = activeRefreshToken()
+
+	userToken := userUseCase.RefreshAccessToken(context.Background(), newUser(userID), refreshTokenID, user.UserDevice{})
+
+	assert.NoError(t, userToken.Error, test.NotFailureMessage)
+	assert.NotEmpty(t, userToken.Data.AccessToken, test.DataNotNilMessage)
+	assert.NotEmpty(t, userToken.Data.RefreshToken, test.DataNotNilMessage)
+	assert.Len(t, mocks.RefreshTokenRepository.CreatedRefreshTokens, 1, test.EqualMessage)
+	assert.Equal(t, familyID, mocks.RefreshTokenRepository.CreatedRefreshTokens[0].FamilyID, test.EqualMessage)
+	assert.Equal(t, []string{refreshTokenID}, mocks.RefreshTokenRepository.RotatedRefreshTokens, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.RevokedRefreshTokens, test.EqualMessage)
+}
+
+func TestRefreshAccessTokenReusedToken(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	revokedRefreshToken := activeRefreshToken()
+	revokedRefreshToken.Data.Revoked = true
+	mocks.RefreshTokenRepository.GetRefreshTokenByTokenIDResult = revokedRefreshToken
+
+	userToken := userUseCase.RefreshAccessToken(context.Background(), newUser(userID), refreshTokenID, user.UserDevice{})
+
+	assert.IsType(t, domain.InvalidTokenError{}, userToken.Error, test.EqualMessage)
+	assert.Equal(t, []string{familyID}, mocks.RefreshTokenRepository.RevokedRefreshTokenFamilies, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.CreatedRefreshTokens, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.RotatedRefreshTokens, test.EqualMessage)
+}
+
+func TestRefreshAccessTokenConcurrentReuse(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	mocks.RefreshTokenRepository.GetRefreshTokenByTokenIDResult = activeRefreshToken()
+	mocks.RefreshTokenRepository.RotateRefreshTokenError = domain.NewInvalidTokenError(location+"TestRefreshAccessTokenConcurrentReuse", constants.InvalidTokenErrorMessage)
+
+	userToken := userUseCase.RefreshAccessToken(context.Background(), newUser(userID), refreshTokenID, user.UserDevice{})
+
+	assert.IsType(t, domain.InvalidTokenError{}, userToken.Error, test.EqualMessage)
+	assert.Equal(t, []string{familyID}, mocks.RefreshTokenRepository.RevokedRefreshTokenFamilies, test.EqualMessage)
+}
+
+func TestRefreshAccessTokenAnotherUser(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	mocks.RefreshTokenRepository.GetRefreshTokenByTokenIDResult = activeRefreshToken()
+
+	userToken := userUseCase.RefreshAccessToken(context.Background(), newUser(unknownKey), refreshTokenID, user.UserDevice{})
+
+	assert.IsType(t, domain.InvalidTokenError{}, userToken.Error, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.CreatedRefreshTokens, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.RotatedRefreshTokens, test.EqualMessage)
+}
+
+func TestRefreshAccessTokenSigningFailureKeepsToken(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	// A key without its private key can't sign, like a retired key.
+	keyRings := setupKeyRings()
+	signingKey := keyRings.RefreshToken.Keys[keyRings.RefreshToken.ActiveKeyID]
+	signingKey.PrivateKey = nil
+	keyRings.RefreshToken.Keys = map[string]utility.SigningKey{keyRings.RefreshToken.ActiveKeyID: signingKey}
+	userUseCase.KeyRings = keyRings
+	mocks.RefreshTokenRepository.GetRefreshTokenByTokenIDResult = activeRefreshToken()
+
+	userToken := userUseCase.RefreshAccessToken(context.Background(), newUser(userID), refreshTokenID, user.UserDevice{})
+
+	assert.Error(t, userToken.Error, test.ErrorNotNilMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.CreatedRefreshTokens, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.RotatedRefreshTokens, test.EqualMessage)
+}
+
+func TestRefreshAccessTokenStoringFailureKeepsToken(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	mocks.RefreshTokenRepository.GetRefreshTokenByTokenIDResult = activeRefreshToken()
+	mocks.RefreshTokenRepository.CreateRefreshTokenError = domain.NewInternalError(location+"TestRefreshAccessTokenStoringFailureKeepsToken", unknownKey)
+
+	userToken := userUseCase.RefreshAccessToken(context.Background(), newUser(userID), refreshTokenID, user.UserDevice{})
+
+	assert.IsType(t, domain.InternalError{}, userToken.Error, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.RotatedRefreshTokens, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.RevokedRefreshTokenFamilies, test.EqualMessage)
+}
+
+func TestRefreshAccessTokenRotationFailureRevokesNewToken(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	mocks.RefreshTokenRepository.GetRefreshTokenByTokenIDResult = activeRefreshToken()
+	mocks.RefreshTokenRepository.RotateRefreshTokenError = domain.NewInternalError(location+"TestRefreshAccessTokenRotationFailureRevokesNewToken", unknownKey)
+
+	userToken := userUseCase.RefreshAccessToken(context.Background(), newUser(userID), refreshTokenID, user.UserDevice{})
+
+	assert.IsType(t, domain.InternalError{}, userToken.Error, test.EqualMessage)
+	assert.Len(t, mocks.RefreshTokenRepository.CreatedRefreshTokens, 1, test.EqualMessage)
+	assert.Equal(t, []string{mocks.RefreshTokenRepository.CreatedRefreshTokens[0].TokenID}, mocks.RefreshTokenRepository.RevokedRefreshTokens, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.RevokedRefreshTokenFamilies, test.EqualMessage)
+}
+
+func TestUpdatePassword(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(passwordUser())
+
+	updatePasswordError := userUseCase.UpdatePassword(context.Background(), user.NewUserPasswordUpdate(userID, userPassword, newPassword, newPassword), familyID, user.UserDevice{IPAddress: ipAddress})
+
+	assert.NoError(t, updatePasswordError, test.ErrorNilMessage)
+	assert.Len(t, mocks.UserRepository.UpdatedPasswords, 1, test.EqualMessage)
+	assert.Equal(t, newPassword, mocks.UserRepository.UpdatedPasswords[0].Password, test.EqualMessage)
+	assert.Equal(t, map[string]string{userID: familyID}, mocks.RefreshTokenRepository.KeptSessionIDs, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.RevokedSessionUserIDs, test.EqualMessage)
+	assert.Equal(t, int64(1), mocks.Email.SentEmails(), test.EqualMessage)
+}
+
+func TestUpdatePasswordWrongCurrentPassword(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(passwordUser())
+
+	updatePasswordError := userUseCase.UpdatePassword(context.Background(), user.NewUserPasswordUpdate(userID, wrongPassword, newPassword, newPassword), familyID, user.UserDevice{IPAddress: ipAddress})
+
+	assert.IsType(t, domain.ValidationError{}, updatePasswordError, test.EqualMessage)
+	assert.Equal(t, 1, mocks.LoginAttemptRepository.LoginAttempts[emailAttemptsKey].FailedAttempts, test.EqualMessage)
+	assert.Equal(t, 1, mocks.LoginAttemptRepository.LoginAttempts[ipAttemptsKey].FailedAttempts, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.UpdatedPasswords, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.KeptSessionIDs, test.EqualMessage)
+	assert.Equal(t, int64(0), mocks.Email.SentEmails(), test.EqualMessage)
+}
+
+func TestUpdatePasswordTooShortPassword(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(passwordUser())
+	shortPassword := newPassword[:constants.DefaultMinStringLength-1]
+
+	updatePasswordError := userUseCase.UpdatePassword(context.Background(), user.NewUserPasswordUpdate(userID, userPassword, shortPassword, shortPassword), familyID, user.UserDevice{IPAddress: ipAddress})
+
+	assert.Error(t, updatePasswordError, test.ErrorNotNilMessage)
+	assert.Empty(t, mocks.UserRepository.UpdatedPasswords, test.EqualMessage)
+	assert.Empty(t, mocks.LoginAttemptRepository.LoginAttempts, test.EqualMessage)
+	assert.Equal(t, int64(0), mocks.Email.SentEmails(), test.EqualMessage)
+}
+
+func TestUpdatePasswordBreachedPassword(t *testing.T) {
+	t.Parallel()
+	userUseCase, mocks := newUserUseCase()
+	mocks.UserRepository.GetUserByIdResult = common.NewResultOnSuccess(passwordUser())
+	_, hashSuffix := utility.SplitPasswordHash(newPassword)
+	mocks.BreachedPasswords.HashSuffixes = []string{hashSuffix}
+
+	updatePasswordError := userUseCase.UpdatePassword(context.Background(), user.NewUserPasswordUpdate(userID, userPassword, newPassword, newPassword), familyID, user.UserDevice{IPAddress: ipAddress})
+
+	assert.IsType(t, domain.ValidationError{}, updatePasswordError, test.EqualMessage)
+	assert.Empty(t, mocks.UserRepository.UpdatedPasswords, test.EqualMessage)
+	assert.Empty(t, mocks.LoginAttemptRepository.LoginAttempts, test.EqualMessage)
+	assert.Empty(t, mocks.RefreshTokenRepository.KeptSessionIDs, test.EqualMessage)
+	assert.Equal(t, int64(0), mocks.Email.SentEmails(), test.EqualMessage)
+}
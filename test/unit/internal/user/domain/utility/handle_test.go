@@ -0,0 +1,36 @@
+package utility
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+)
+
+func TestNormalizeHandle(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "john-smith", utility.NormalizeHandle("John Smith"), test.EqualMessage)
+	assert.Equal(t, "john-smith", utility.NormalizeHandle("  JOHN \t smith "), test.EqualMessage)
+	assert.Equal(t, "john_smith-2", utility.NormalizeHandle("John_Smith-2"), test.EqualMessage)
+}
+
+func TestNormalizeHandleDropsPunctuation(t *testing.T) {
+	t.Parallel()
+	// Usernames created before the username validation was fixed may contain punctuation.
+	assert.Equal(t, "johnsmith", utility.NormalizeHandle("[John]^Smith`"), test.EqualMessage)
+	assert.Equal(t, "", utility.NormalizeHandle("^^^"), test.EqualMessage)
+}
+
+func TestHandleCandidate(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "john-smith", utility.HandleCandidate("john-smith", 1), test.EqualMessage)
+	assert.Equal(t, "john-smith-2", utility.HandleCandidate("john-smith", 2), test.EqualMessage)
+	assert.Equal(t, "john-smith-10", utility.HandleCandidate("john-smith", 10), test.EqualMessage)
+}
+
+func TestHandleCandidateEmptyHandle(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, utility.DefaultHandle, utility.HandleCandidate("", 1), test.EqualMessage)
+	assert.Equal(t, utility.DefaultHandle+"-3", utility.HandleCandidate("", 3), test.EqualMessage)
+}
@@ -0,0 +1,169 @@
+package utility
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+	mock "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/common"
+)
+
+const (
+	location = "test.unit.internal.user.domain.utility."
+
+	activeKeyID  = "active"
+	retiredKeyID = "retired"
+)
+
+var (
+	keyRingTokenPayload = user.NewUserTokenPayload("12345", "user")
+)
+
+// generateEncodedKeyPair generates a key pair encoded the same way as the keys in the configuration.
+func generateEncodedKeyPair(t *testing.T, algorithm string) (string, string) {
+	keyPair := utility.GenerateKeyPair(mock.NewMockLogger(), location+"generateEncodedKeyPair", algorithm, constants.DefaultRSAKeySize)
+	assert.NoError(t, keyPair.Error, test.ErrorNilMessage)
+	return base64.StdEncoding.EncodeToString(keyPair.Data.PrivateKey), base64.StdEncoding.EncodeToString(keyPair.Data.PublicKey)
+}
+
+// rotateKeyRing returns a key ring with a new active key, where the test key is retired until the provided time.
+func rotateKeyRing(t *testing.T, validUntil time.Time) utility.KeyRing {
+	privateKey, publicKey := generateEncodedKeyPair(t, constants.RS256)
+	retiredKeys := []config.RetiredKey{{KeyID: retiredKeyID, PublicKey: test.PublicKey, ValidUntil: validUntil}}
+	keyRing := utility.NewKeyRing(mock.NewMockLogger(), location+"rotateKeyRing", constants.RS256, activeKeyID, privateKey, publicKey, retiredKeys, utility.TokenClaims{})
+	assert.NoError(t, keyRing.Error, test.ErrorNilMessage)
+	return keyRing.Data
+}
+
+func TestGenerateJWTTokenSetsKeyID(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	keyRing := utility.NewKeyRing(mockLogger, location+"TestGenerateJWTTokenSetsKeyID", constants.RS256, "", test.PrivateKey, test.PublicKey, nil, utility.TokenClaims{})
+	assert.NoError(t, keyRing.Error, test.ErrorNilMessage)
+
+	token := utility.GenerateJWTToken(mockLogger, location+"TestGenerateJWTTokenSetsKeyID", keyRing.Data, time.Minute, keyRingTokenPayload)
+	assert.NoError(t, token.Error, test.ErrorNilMessage)
+
+	parsedToken, _, parseError := new(jwt.Parser).ParseUnverified(token.Data, jwt.MapClaims{})
+	assert.NoError(t, parseError, test.ErrorNilMessage)
+	assert.Equal(t, keyRing.Data.ActiveKeyID, parsedToken.Header["kid"], test.EqualMessage)
+
+	payload := utility.ValidateJWTToken(mockLogger, location+"TestGenerateJWTTokenSetsKeyID", token.Data, keyRing.Data)
+	assert.NoError(t, payload.Error, test.ErrorNilMessage)
+	assert.Equal(t, keyRingTokenPayload.UserID, payload.Data.UserID, test.EqualMessage)
+}
+
+func TestValidateJWTTokenWithRetiredKey(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	previousKeyRing := utility.NewKeyRing(mockLogger, location+"TestValidateJWTTokenWithRetiredKey", constants.RS256, retiredKeyID, test.PrivateKey, test.PublicKey, nil, utility.TokenClaims{})
+	assert.NoError(t, previousKeyRing.Error, test.ErrorNilMessage)
+	token := utility.GenerateJWTToken(mockLogger, location+"TestValidateJWTTokenWithRetiredKey", previousKeyRing.Data, time.Minute, keyRingTokenPayload)
+	assert.NoError(t, token.Error, test.ErrorNilMessage)
+
+	payload := utility.ValidateJWTToken(mockLogger, location+"TestValidateJWTTokenWithRetiredKey", token.Data, rotateKeyRing(t, time.Now().Add(time.Hour)))
+	assert.NoError(t, payload.Error, test.ErrorNilMessage)
+
+	payload = utility.ValidateJWTToken(mockLogger, location+"TestValidateJWTTokenWithRetiredKey", token.Data, rotateKeyRing(t, time.Now().Add(-time.Hour)))
+	assert.Error(t, payload.Error, test.ErrorNotNilMessage)
+}
+
+func TestValidateJWTTokenUnknownKeyID(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	unknownKeyRing := utility.NewKeyRing(mockLogger, location+"TestValidateJWTTokenUnknownKeyID", constants.RS256, "unknown", test.PrivateKey, test.PublicKey, nil, utility.TokenClaims{})
+	assert.NoError(t, unknownKeyRing.Error, test.ErrorNilMessage)
+	token := utility.GenerateJWTToken(mockLogger, location+"TestValidateJWTTokenUnknownKeyID", unknownKeyRing.Data, time.Minute, keyRingTokenPayload)
+	assert.NoError(t, token.Error, test.ErrorNilMessage)
+
+	payload := utility.ValidateJWTToken(mockLogger, location+"TestValidateJWTTokenUnknownKeyID", token.Data, rotateKeyRing(t, time.Now().Add(time.Hour)))
+	assert.Error(t, payload.Error, test.ErrorNotNilMessage)
+}
+
+func TestNewKeyRingMismatchingKeyPair(t *testing.T) {
+	t.Parallel()
+	_, publicKey := generateEncodedKeyPair(t, constants.RS256)
+
+	keyRing := utility.NewKeyRing(mock.NewMockLogger(), location+"TestNewKeyRingMismatchingKeyPair", constants.RS256, activeKeyID, test.PrivateKey, publicKey, nil, utility.TokenClaims{})
+	assert.Error(t, keyRing.Error, test.ErrorNotNilMessage)
+}
+
+func TestGenerateJSONWebKeySet(t *testing.T) {
+	t.Parallel()
+
+	jsonWebKeySet := utility.GenerateJSONWebKeySet(rotateKeyRing(t, time.Now().Add(time.Hour)))
+	assert.Len(t, jsonWebKeySet.Keys, 2, test.EqualMessage)
+	assert.Equal(t, activeKeyID, jsonWebKeySet.Keys[0].KeyID, test.EqualMessage)
+	assert.Equal(t, retiredKeyID, jsonWebKeySet.Keys[1].KeyID, test.EqualMessage)
+	assert.Equal(t, "RSA", jsonWebKeySet.Keys[1].KeyType, test.EqualMessage)
+	assert.Equal(t, "RS256", jsonWebKeySet.Keys[1].Algorithm, test.EqualMessage)
+	assert.Equal(t, "AQAB", jsonWebKeySet.Keys[1].Exponent, test.EqualMessage)
+
+	// Expired retired keys are no longer published.
+	jsonWebKeySet = utility.GenerateJSONWebKeySet(rotateKeyRing(t, time.Now().Add(-time.Hour)))
+	assert.Len(t, jsonWebKeySet.Keys, 1, test.EqualMessage)
+	assert.Equal(t, activeKeyID, jsonWebKeySet.Keys[0].KeyID, test.EqualMessage)
+}
+
+func TestKeyRingAlgorithms(t *testing.T) {
+	t.Parallel()
+
+	expectedKeyTypes := map[string]string{
+		constants.RS256: "RSA",
+		constants.ES256: "EC",
+		constants.EdDSA: "OKP",
+	}
+
+	for algorithm, expectedKeyType := range expectedKeyTypes {
+		mockLogger := mock.NewMockLogger()
+		privateKey, publicKey := generateEncodedKeyPair(t, algorithm)
+		keyRing := utility.NewKeyRing(mockLogger, location+"TestKeyRingAlgorithms", algorithm, "", privateKey, publicKey, nil, utility.TokenClaims{})
+		assert.NoError(t, keyRing.Error, test.ErrorNilMessage)
+
+		token := utility.GenerateJWTToken(mockLogger, location+"TestKeyRingAlgorithms", keyRing.Data, time.Minute, keyRingTokenPayload)
+		assert.NoError(t, token.Error, test.ErrorNilMessage)
+		payload := utility.ValidateJWTToken(mockLogger, location+"TestKeyRingAlgorithms", token.Data, keyRing.Data)
+		assert.NoError(t, payload.Error, test.ErrorNilMessage)
+
+		jsonWebKeySet := utility.GenerateJSONWebKeySet(keyRing.Data)
+		assert.Equal(t, expectedKeyType, jsonWebKeySet.Keys[0].KeyType, test.EqualMessage)
+		assert.Equal(t, algorithm, jsonWebKeySet.Keys[0].Algorithm, test.EqualMessage)
+		assert.Equal(t, keyRing.Data.ActiveKeyID, jsonWebKeySet.Keys[0].KeyID, test.EqualMessage)
+	}
+}
+
+func TestValidateJWTTokenPinsAlgorithm(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	privateKey, publicKey := generateEncodedKeyPair(t, constants.ES256)
+	ecdsaKeyRing := utility.NewKeyRing(mockLogger, location+"TestValidateJWTTokenPinsAlgorithm", constants.ES256, activeKeyID, privateKey, publicKey, nil, utility.TokenClaims{})
+	assert.NoError(t, ecdsaKeyRing.Error, test.ErrorNilMessage)
+	rsaKeyRing := utility.NewKeyRing(mockLogger, location+"TestValidateJWTTokenPinsAlgorithm", constants.RS256, activeKeyID, test.PrivateKey, test.PublicKey, nil, utility.TokenClaims{})
+	assert.NoError(t, rsaKeyRing.Error, test.ErrorNilMessage)
+
+	// The token names a key of the ring, but was signed with another algorithm than the key is pinned to.
+	token := utility.GenerateJWTToken(mockLogger, location+"TestValidateJWTTokenPinsAlgorithm", ecdsaKeyRing.Data, time.Minute, keyRingTokenPayload)
+	assert.NoError(t, token.Error, test.ErrorNilMessage)
+	payload := utility.ValidateJWTToken(mockLogger, location+"TestValidateJWTTokenPinsAlgorithm", token.Data, rsaKeyRing.Data)
+	assert.Error(t, payload.Error, test.ErrorNotNilMessage)
+}
+
+func TestNewKeyRingInvalidAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	// RSA keys can't be used for ES256 and unknown algorithms are rejected.
+	keyRing := utility.NewKeyRing(mock.NewMockLogger(), location+"TestNewKeyRingInvalidAlgorithm", constants.ES256, "", test.PrivateKey, test.PublicKey, nil, utility.TokenClaims{})
+	assert.Error(t, keyRing.Error, test.ErrorNotNilMessage)
+	keyRing = utility.NewKeyRing(mock.NewMockLogger(), location+"TestNewKeyRingInvalidAlgorithm", "HS256", "", test.PrivateKey, test.PublicKey, nil, utility.TokenClaims{})
+	assert.Error(t, keyRing.Error, test.ErrorNotNilMessage)
+
+	keyPair := utility.GenerateKeyPair(mock.NewMockLogger(), location+"TestNewKeyRingInvalidAlgorithm", "HS256", constants.DefaultRSAKeySize)
+	assert.Error(t, keyPair.Error, test.ErrorNotNilMessage)
+}
@@ -0,0 +1,23 @@
+package utility
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+)
+
+func TestCodeChallenge(t *testing.T) {
+	t.Parallel()
+	codeVerifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	hash := sha256.Sum256([]byte(codeVerifier))
+
+	codeChallenge := utility.CodeChallenge(codeVerifier)
+
+	assert.Equal(t, base64.RawURLEncoding.EncodeToString(hash[:]), codeChallenge, test.EqualMessage)
+	assert.Len(t, codeChallenge, 43, test.EqualMessage)
+	assert.NotEqual(t, utility.CodeChallenge(codeVerifier+"a"), codeChallenge, test.EqualMessage)
+}
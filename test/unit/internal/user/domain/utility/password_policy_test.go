@@ -0,0 +1,90 @@
+package utility
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+)
+
+var (
+	passwordPolicy = config.PasswordPolicy{
+		MinLength:        8,
+		MaxLength:        16,
+		RequireLowercase: true,
+		RequireUppercase: true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+	}
+)
+
+func TestCheckPasswordPolicyValid(t *testing.T) {
+	t.Parallel()
+	notifications := utility.CheckPasswordPolicy(passwordPolicy, "Passw0rd!")
+	assert.Empty(t, notifications, test.EqualMessage)
+}
+
+func TestCheckPasswordPolicyUnicode(t *testing.T) {
+	t.Parallel()
+	// The length is counted in characters, the password has more bytes than the maximum length.
+	notifications := utility.CheckPasswordPolicy(passwordPolicy, "Пароль1€Пароль")
+	assert.Empty(t, notifications, test.EqualMessage)
+}
+
+func TestCheckPasswordPolicyLength(t *testing.T) {
+	t.Parallel()
+	expectedNotification := fmt.Sprintf(constants.StringAllowedLength, passwordPolicy.MinLength, passwordPolicy.MaxLength)
+
+	assert.Equal(t, []string{expectedNotification}, utility.CheckPasswordPolicy(passwordPolicy, "Pa0!"), test.EqualMessage)
+	assert.Equal(t, []string{expectedNotification}, utility.CheckPasswordPolicy(passwordPolicy, "Passw0rd!"+strings.Repeat("a", 8)), test.EqualMessage)
+}
+
+func TestCheckPasswordPolicyCharacterClasses(t *testing.T) {
+	t.Parallel()
+	assert.Len(t, utility.CheckPasswordPolicy(passwordPolicy, "PASSW0RD!"), 1, test.EqualMessage)
+	assert.Len(t, utility.CheckPasswordPolicy(passwordPolicy, "passw0rd!"), 1, test.EqualMessage)
+	assert.Len(t, utility.CheckPasswordPolicy(passwordPolicy, "Password!"), 1, test.EqualMessage)
+	assert.Len(t, utility.CheckPasswordPolicy(passwordPolicy, "Passw0rd"), 1, test.EqualMessage)
+	assert.Len(t, utility.CheckPasswordPolicy(passwordPolicy, "        "), 4, test.EqualMessage)
+}
+
+func TestCheckPasswordPolicyNoRequirements(t *testing.T) {
+	t.Parallel()
+	lengthOnly := config.PasswordPolicy{MinLength: 4, MaxLength: 40}
+	assert.Empty(t, utility.CheckPasswordPolicy(lengthOnly, "password"), test.EqualMessage)
+}
+
+func TestContainsPersonalData(t *testing.T) {
+	t.Parallel()
+	email := "john.smith@example.com"
+	username := "Johnny Appleseed"
+
+	assert.True(t, utility.ContainsPersonalData("my-JOHN.SMITH-pass", email, username), test.NotFailureMessage)
+	assert.True(t, utility.ContainsPersonalData("appleseed2024", email, username), test.NotFailureMessage)
+	assert.True(t, utility.ContainsPersonalData("x johnny appleseed x", email, username), test.NotFailureMessage)
+	assert.False(t, utility.ContainsPersonalData("correct horse battery", email, username), test.FailureMessage)
+}
+
+func TestContainsPersonalDataSkipsShortParts(t *testing.T) {
+	t.Parallel()
+	assert.False(t, utility.ContainsPersonalData("bobcat-lover-7", "bo@example.com", "Al Bob"), test.FailureMessage)
+}
+
+func TestSplitPasswordHash(t *testing.T) {
+	t.Parallel()
+	hash := sha1.Sum([]byte("password"))
+	expectedHash := strings.ToUpper(hex.EncodeToString(hash[:]))
+
+	hashPrefix, hashSuffix := utility.SplitPasswordHash("password")
+
+	assert.Equal(t, "5BAA6", hashPrefix, test.EqualMessage)
+	assert.Equal(t, expectedHash, hashPrefix+hashSuffix, test.EqualMessage)
+	assert.Len(t, hashPrefix, utility.HashPrefixLength, test.EqualMessage)
+}
@@ -0,0 +1,28 @@
+package utility
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+)
+
+var (
+	allowedDomains = []string{"example.com", " Company.ORG "}
+)
+
+func TestIsEmailDomainAllowed(t *testing.T) {
+	t.Parallel()
+	assert.True(t, utility.IsEmailDomainAllowed(allowedDomains, "john@example.com"), test.NotFailureMessage)
+	assert.True(t, utility.IsEmailDomainAllowed(allowedDomains, "john@EXAMPLE.com"), test.NotFailureMessage)
+	assert.True(t, utility.IsEmailDomainAllowed(allowedDomains, "john@company.org"), test.NotFailureMessage)
+}
+
+func TestIsEmailDomainAllowedRejected(t *testing.T) {
+	t.Parallel()
+	assert.False(t, utility.IsEmailDomainAllowed(allowedDomains, "john@mail.example.com"), test.FailureMessage)
+	assert.False(t, utility.IsEmailDomainAllowed(allowedDomains, "john@example.com.evil.io"), test.FailureMessage)
+	assert.False(t, utility.IsEmailDomainAllowed(allowedDomains, "example.com"), test.FailureMessage)
+	assert.False(t, utility.IsEmailDomainAllowed(nil, "john@example.com"), test.FailureMessage)
+}
@@ -0,0 +1,106 @@
+package utility
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+	mock "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/common"
+)
+
+const (
+	issuer   = "issuer"
+	audience = "audience"
+)
+
+// setupClaimsKeyRing returns a key ring with the test keys that issues and accepts the provided claims.
+func setupClaimsKeyRing(t *testing.T, claims utility.TokenClaims) utility.KeyRing {
+	keyRing := utility.NewKeyRing(mock.NewMockLogger(), location+"setupClaimsKeyRing", constants.RS256, activeKeyID, test.PrivateKey, test.PublicKey, nil, claims)
+	assert.NoError(t, keyRing.Error, test.ErrorNilMessage)
+	return keyRing.Data
+}
+
+func TestGenerateJWTTokenSetsClaims(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	keyRing := setupClaimsKeyRing(t, utility.NewTokenClaims(constants.AccessTokenValue, issuer, audience, 0))
+
+	firstToken := utility.GenerateJWTToken(mockLogger, location+"TestGenerateJWTTokenSetsClaims", keyRing, time.Minute, keyRingTokenPayload)
+	assert.NoError(t, firstToken.Error, test.ErrorNilMessage)
+	secondToken := utility.GenerateJWTToken(mockLogger, location+"TestGenerateJWTTokenSetsClaims", keyRing, time.Minute, keyRingTokenPayload)
+	assert.NoError(t, secondToken.Error, test.ErrorNilMessage)
+
+	firstClaims := jwt.MapClaims{}
+	_, _, parseError := new(jwt.Parser).ParseUnverified(firstToken.Data, firstClaims)
+	assert.NoError(t, parseError, test.ErrorNilMessage)
+	secondClaims := jwt.MapClaims{}
+	_, _, parseError = new(jwt.Parser).ParseUnverified(secondToken.Data, secondClaims)
+	assert.NoError(t, parseError, test.ErrorNilMessage)
+
+	assert.Equal(t, issuer, firstClaims["iss"], test.EqualMessage)
+	assert.Equal(t, audience, firstClaims["aud"], test.EqualMessage)
+	assert.Equal(t, constants.AccessTokenValue, firstClaims["token_type"], test.EqualMessage)
+	assert.NotEmpty(t, firstClaims["jti"], test.DataNotNilMessage)
+	assert.NotEqual(t, firstClaims["jti"], secondClaims["jti"], "token ids must be unique")
+
+	payload := utility.ValidateJWTToken(mockLogger, location+"TestGenerateJWTTokenSetsClaims", firstToken.Data, keyRing)
+	assert.NoError(t, payload.Error, test.ErrorNilMessage)
+	assert.Equal(t, firstClaims["jti"], payload.Data.TokenID, test.EqualMessage)
+}
+
+func TestValidateJWTTokenRejectsOtherTokenType(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	accessTokenKeyRing := setupClaimsKeyRing(t, utility.NewTokenClaims(constants.AccessTokenValue, issuer, audience, 0))
+	refreshTokenKeyRing := setupClaimsKeyRing(t, utility.NewTokenClaims(constants.RefreshTokenValue, issuer, audience, 0))
+
+	// Both key rings share the same keys, only the token type tells the tokens apart.
+	accessToken := utility.GenerateJWTToken(mockLogger, location+"TestValidateJWTTokenRejectsOtherTokenType", accessTokenKeyRing, time.Minute, keyRingTokenPayload)
+	assert.NoError(t, accessToken.Error, test.ErrorNilMessage)
+
+	payload := utility.ValidateJWTToken(mockLogger, location+"TestValidateJWTTokenRejectsOtherTokenType", accessToken.Data, refreshTokenKeyRing)
+	assert.Error(t, payload.Error, test.ErrorNotNilMessage)
+}
+
+func TestValidateJWTTokenRejectsOtherIssuerAndAudience(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	keyRing := setupClaimsKeyRing(t, utility.NewTokenClaims(constants.AccessTokenValue, issuer, audience, 0))
+	token := utility.GenerateJWTToken(mockLogger, location+"TestValidateJWTTokenRejectsOtherIssuerAndAudience", keyRing, time.Minute, keyRingTokenPayload)
+	assert.NoError(t, token.Error, test.ErrorNilMessage)
+
+	otherIssuerKeyRing := setupClaimsKeyRing(t, utility.NewTokenClaims(constants.AccessTokenValue, "other", audience, 0))
+	payload := utility.ValidateJWTToken(mockLogger, location+"TestValidateJWTTokenRejectsOtherIssuerAndAudience", token.Data, otherIssuerKeyRing)
+	assert.Error(t, payload.Error, test.ErrorNotNilMessage)
+
+	otherAudienceKeyRing := setupClaimsKeyRing(t, utility.NewTokenClaims(constants.AccessTokenValue, issuer, "other", 0))
+	payload = utility.ValidateJWTToken(mockLogger, location+"TestValidateJWTTokenRejectsOtherIssuerAndAudience", token.Data, otherAudienceKeyRing)
+	assert.Error(t, payload.Error, test.ErrorNotNilMessage)
+
+	// A token without an issuer and audience is rejected once they are configured.
+	unboundKeyRing := setupClaimsKeyRing(t, utility.NewTokenClaims(constants.AccessTokenValue, "", "", 0))
+	unboundToken := utility.GenerateJWTToken(mockLogger, location+"TestValidateJWTTokenRejectsOtherIssuerAndAudience", unboundKeyRing, time.Minute, keyRingTokenPayload)
+	assert.NoError(t, unboundToken.Error, test.ErrorNilMessage)
+	payload = utility.ValidateJWTToken(mockLogger, location+"TestValidateJWTTokenRejectsOtherIssuerAndAudience", unboundToken.Data, keyRing)
+	assert.Error(t, payload.Error, test.ErrorNotNilMessage)
+}
+
+func TestValidateJWTTokenLeeway(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	keyRing := setupClaimsKeyRing(t, utility.NewTokenClaims(constants.AccessTokenValue, issuer, audience, 0))
+	lenientKeyRing := setupClaimsKeyRing(t, utility.NewTokenClaims(constants.AccessTokenValue, issuer, audience, time.Minute))
+
+	// The token expired a few seconds ago, within the leeway of the lenient key ring.
+	token := utility.GenerateJWTToken(mockLogger, location+"TestValidateJWTTokenLeeway", keyRing, -10*time.Second, keyRingTokenPayload)
+	assert.NoError(t, token.Error, test.ErrorNilMessage)
+
+	payload := utility.ValidateJWTToken(mockLogger, location+"TestValidateJWTTokenLeeway", token.Data, keyRing)
+	assert.Error(t, payload.Error, test.ErrorNotNilMessage)
+	payload = utility.ValidateJWTToken(mockLogger, location+"TestValidateJWTTokenLeeway", token.Data, lenientKeyRing)
+	assert.NoError(t, payload.Error, test.ErrorNilMessage)
+}
@@ -0,0 +1,100 @@
+package utility
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+	mock "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/common"
+)
+
+const (
+	// Base32 encoding of the "12345678901234567890" secret used by the RFC 6238 test vectors.
+	rfcSecret     = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	invalidSecret = "not a base32 secret!"
+	totpIssuer    = "Issuer"
+	totpAccount   = "user@example.com"
+)
+
+func TestGenerateTOTPCodeRFCVectors(t *testing.T) {
+	t.Parallel()
+
+	// The RFC 6238 SHA1 test vectors, truncated to the last 6 digits.
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+
+	for unixTime, expectedCode := range vectors {
+		code := utility.GenerateTOTPCode(mock.NewMockLogger(), location+"TestGenerateTOTPCodeRFCVectors", rfcSecret, time.Unix(unixTime, 0))
+		assert.Nil(t, code.Error, test.ErrorNilMessage)
+		assert.Equal(t, expectedCode, code.Data, test.EqualMessage)
+	}
+}
+
+func TestGenerateTOTPCodeInvalidSecret(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+
+	code := utility.GenerateTOTPCode(mockLogger, location+"TestGenerateTOTPCodeInvalidSecret", invalidSecret, time.Now())
+	assert.IsType(t, domain.InternalError{}, code.Error, test.EqualMessage)
+	assert.IsType(t, domain.InternalError{}, mockLogger.LastError, test.EqualMessage)
+}
+
+func TestValidateTOTPCodeAcceptsAdjacentSteps(t *testing.T) {
+	t.Parallel()
+	at := time.Unix(1111111109, 0)
+	counter := at.Unix() / 30
+
+	for step, offset := range []time.Duration{-30 * time.Second, 0, 30 * time.Second} {
+		code := utility.GenerateTOTPCode(mock.NewMockLogger(), location+"TestValidateTOTPCodeAcceptsAdjacentSteps", rfcSecret, at.Add(offset))
+		acceptedStep := utility.ValidateTOTPCode(mock.NewMockLogger(), location+"TestValidateTOTPCodeAcceptsAdjacentSteps", rfcSecret, code.Data, 0, at)
+		assert.Equal(t, counter+int64(step)-1, acceptedStep, test.EqualMessage)
+	}
+}
+
+func TestValidateTOTPCodeRejectsDistantSteps(t *testing.T) {
+	t.Parallel()
+	at := time.Unix(1111111109, 0)
+
+	code := utility.GenerateTOTPCode(mock.NewMockLogger(), location+"TestValidateTOTPCodeRejectsDistantSteps", rfcSecret, at.Add(-90*time.Second))
+	assert.Zero(t, utility.ValidateTOTPCode(mock.NewMockLogger(), location+"TestValidateTOTPCodeRejectsDistantSteps", rfcSecret, code.Data, 0, at), test.FailureMessage)
+	assert.Zero(t, utility.ValidateTOTPCode(mock.NewMockLogger(), location+"TestValidateTOTPCodeRejectsDistantSteps", invalidSecret, code.Data, 0, at), test.FailureMessage)
+}
+
+func TestValidateTOTPCodeRejectsUsedSteps(t *testing.T) {
+	t.Parallel()
+	at := time.Unix(1111111109, 0)
+
+	code := utility.GenerateTOTPCode(mock.NewMockLogger(), location+"TestValidateTOTPCodeRejectsUsedSteps", rfcSecret, at)
+	acceptedStep := utility.ValidateTOTPCode(mock.NewMockLogger(), location+"TestValidateTOTPCodeRejectsUsedSteps", rfcSecret, code.Data, 0, at)
+	assert.NotZero(t, acceptedStep, test.NotFailureMessage)
+	// The same code is rejected once its step is stored, also later while it is still within the skew.
+	assert.Zero(t, utility.ValidateTOTPCode(mock.NewMockLogger(), location+"TestValidateTOTPCodeRejectsUsedSteps", rfcSecret, code.Data, acceptedStep, at), test.FailureMessage)
+	assert.Zero(t, utility.ValidateTOTPCode(mock.NewMockLogger(), location+"TestValidateTOTPCodeRejectsUsedSteps", rfcSecret, code.Data, acceptedStep, at.Add(30*time.Second)), test.FailureMessage)
+
+	previousCode := utility.GenerateTOTPCode(mock.NewMockLogger(), location+"TestValidateTOTPCodeRejectsUsedSteps", rfcSecret, at.Add(-30*time.Second))
+	assert.Zero(t, utility.ValidateTOTPCode(mock.NewMockLogger(), location+"TestValidateTOTPCodeRejectsUsedSteps", rfcSecret, previousCode.Data, acceptedStep, at), test.FailureMessage)
+	nextCode := utility.GenerateTOTPCode(mock.NewMockLogger(), location+"TestValidateTOTPCodeRejectsUsedSteps", rfcSecret, at.Add(30*time.Second))
+	assert.Equal(t, acceptedStep+1, utility.ValidateTOTPCode(mock.NewMockLogger(), location+"TestValidateTOTPCodeRejectsUsedSteps", rfcSecret, nextCode.Data, acceptedStep, at), test.EqualMessage)
+}
+
+func TestGenerateTOTPSecretAndURI(t *testing.T) {
+	t.Parallel()
+
+	secret := utility.GenerateTOTPSecret(mock.NewMockLogger(), location+"TestGenerateTOTPSecretAndURI")
+	assert.Nil(t, secret.Error, test.ErrorNilMessage)
+	assert.Len(t, secret.Data, 32, test.EqualMessage)
+
+	uri := utility.GenerateTOTPURI(totpIssuer, totpAccount, secret.Data)
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/Issuer:user@example.com?"), test.NotFailureMessage)
+	assert.Contains(t, uri, "secret="+secret.Data, test.EqualMessage)
+	assert.Contains(t, uri, "issuer=Issuer", test.EqualMessage)
+}
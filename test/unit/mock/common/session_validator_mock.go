@@ -0,0 +1,25 @@
+package common
+
+import (
+	"context"
+
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+)
+
+type MockSessionValidator struct {
+	ValidateSessionError              error
+	ValidatePersonalAccessTokenResult common.Result[user.UserTokenPayload]
+}
+
+func NewMockSessionValidator() MockSessionValidator {
+	return MockSessionValidator{}
+}
+
+func (mockSessionValidator MockSessionValidator) ValidateSession(ctx context.Context, userID, sessionID string) error {
+	return mockSessionValidator.ValidateSessionError
+}
+
+func (mockSessionValidator MockSessionValidator) ValidatePersonalAccessToken(ctx context.Context, token string) common.Result[user.UserTokenPayload] {
+	return mockSessionValidator.ValidatePersonalAccessTokenResult
+}
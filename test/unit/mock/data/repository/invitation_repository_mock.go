@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+)
+
+const invitationNotUsable = "The invitation does not exist, has been revoked, has expired or has been used up."
+
+// MockInvitationRepository keeps the invitations in memory by the hash of their code,
+// so the uses of an invitation can be counted across several registrations.
+type MockInvitationRepository struct {
+	interfaces.InvitationRepository
+	Invitations        map[string]user.Invitation
+	CreatedInvitations []user.InvitationCreate
+}
+
+func NewMockInvitationRepository() *MockInvitationRepository {
+	return &MockInvitationRepository{
+		Invitations: make(map[string]user.Invitation),
+	}
+}
+
+func (mockInvitationRepository *MockInvitationRepository) CreateInvitation(ctx context.Context, invitationCreate user.InvitationCreate) common.Result[user.Invitation] {
+	mockInvitationRepository.CreatedInvitations = append(mockInvitationRepository.CreatedInvitations, invitationCreate)
+	invitationID := strconv.Itoa(len(mockInvitationRepository.CreatedInvitations))
+	invitation := user.NewInvitation(invitationID, invitationCreate.CreatedBy, invitationCreate.MaxUses, 0, invitationCreate.ExpiresAt, false, time.Now(), time.Now())
+	mockInvitationRepository.Invitations[invitationCreate.CodeHash] = invitation
+	return common.NewResultOnSuccess(invitation)
+}
+
+func (mockInvitationRepository *MockInvitationRepository) UseInvitation(ctx context.Context, codeHash string) error {
+	invitation, ok := mockInvitationRepository.Invitations[codeHash]
+	if !ok || invitation.Revoked || !invitation.ExpiresAt.After(time.Now()) || invitation.Uses >= invitation.MaxUses {
+		return domain.NewItemNotFoundError(location+"UseInvitation", codeHash, invitationNotUsable)
+	}
+
+	invitation.Uses++
+	mockInvitationRepository.Invitations[codeHash] = invitation
+	return nil
+}
+
+func (mockInvitationRepository *MockInvitationRepository) ReleaseInvitation(ctx context.Context, codeHash string) error {
+	invitation, ok := mockInvitationRepository.Invitations[codeHash]
+	if ok && invitation.Uses > 0 {
+		invitation.Uses--
+		mockInvitationRepository.Invitations[codeHash] = invitation
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+)
+
+const (
+	location            = "test.unit.mock.data.repository."
+	unlockTokenNotFound = "The unlock token does not match any locked login."
+)
+
+// MockLoginAttemptRepository keeps the login attempts in memory, so the throttling of several attempts in a row can be tested.
+// The attempt windows are not tracked, the attempts of a key stay until they are reset or unlocked.
+type MockLoginAttemptRepository struct {
+	interfaces.LoginAttemptRepository
+	LoginAttempts      map[string]user.LoginAttempt
+	UnlockTokens       map[string]string
+	LoginAttemptBlocks []user.LoginAttemptBlock
+}
+
+func NewMockLoginAttemptRepository() *MockLoginAttemptRepository {
+	return &MockLoginAttemptRepository{
+		LoginAttempts: make(map[string]user.LoginAttempt),
+		UnlockTokens:  make(map[string]string),
+	}
+}
+
+func (mockLoginAttemptRepository *MockLoginAttemptRepository) GetLoginAttempt(ctx context.Context, key string) common.Result[user.LoginAttempt] {
+	loginAttempt, ok := mockLoginAttemptRepository.LoginAttempts[key]
+	if !ok {
+		return common.NewResultOnSuccess(user.LoginAttempt{Key: key})
+	}
+
+	return common.NewResultOnSuccess(loginAttempt)
+}
+
+func (mockLoginAttemptRepository *MockLoginAttemptRepository) IncrementFailedLoginAttempts(ctx context.Context, key string, expiresAt time.Time) common.Result[user.LoginAttempt] {
+	loginAttempt := mockLoginAttemptRepository.LoginAttempts[key]
+	loginAttempt.Key = key
+	loginAttempt.FailedAttempts++
+	loginAttempt.ExpiresAt = expiresAt
+	mockLoginAttemptRepository.LoginAttempts[key] = loginAttempt
+
+	return common.NewResultOnSuccess(loginAttempt)
+}
+
+func (mockLoginAttemptRepository *MockLoginAttemptRepository) BlockLoginAttempts(ctx context.Context, loginAttemptBlock user.LoginAttemptBlock) error {
+	mockLoginAttemptRepository.LoginAttemptBlocks = append(mockLoginAttemptRepository.LoginAttemptBlocks, loginAttemptBlock)
+	loginAttempt := mockLoginAttemptRepository.LoginAttempts[loginAttemptBlock.Key]
+	loginAttempt.BlockedUntil = loginAttemptBlock.BlockedUntil
+	loginAttempt.Locked = loginAttemptBlock.Locked
+	mockLoginAttemptRepository.LoginAttempts[loginAttemptBlock.Key] = loginAttempt
+	if loginAttemptBlock.UnlockToken != "" {
+		mockLoginAttemptRepository.UnlockTokens[loginAttemptBlock.UnlockToken] = loginAttemptBlock.Key
+	}
+
+	return nil
+}
+
+func (mockLoginAttemptRepository *MockLoginAttemptRepository) ResetLoginAttempts(ctx context.Context, key string) error {
+	delete(mockLoginAttemptRepository.LoginAttempts, key)
+	return nil
+}
+
+func (mockLoginAttemptRepository *MockLoginAttemptRepository) UnlockLoginAttempts(ctx context.Context, unlockToken string) error {
+	key, ok := mockLoginAttemptRepository.UnlockTokens[unlockToken]
+	if !ok || !mockLoginAttemptRepository.LoginAttempts[key].Locked {
+		return domain.NewInvalidTokenError(location+"UnlockLoginAttempts", unlockTokenNotFound)
+	}
+
+	delete(mockLoginAttemptRepository.UnlockTokens, unlockToken)
+	delete(mockLoginAttemptRepository.LoginAttempts, key)
+	return nil
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	post "github.com/yachnytskyi/golang-mongo-grpc/internal/post/domain/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+)
+
+const postStatusField = "status"
+
+// MockPostRepository returns the configured results and records the changes it is asked for.
+// The methods a test doesn't expect are left to the embedded nil interface, so calling them fails the test.
+type MockPostRepository struct {
+	interfaces.PostRepository
+	GetAllPostsResult    common.Result[post.Posts]
+	GetPostByIdResult    common.Result[post.Post]
+	UpdatePostByIdResult common.Result[post.Post]
+	DeletePostByIDError  error
+	UpdatedPosts         []post.PostUpdate
+	PostStatusUpdates    []post.PostStatusUpdate
+	DeletedPostIDs       []string
+	PaginationQueries    []common.PaginationQuery
+	PostFilters          []post.PostFilter
+}
+
+func NewMockPostRepository() *MockPostRepository {
+	return &MockPostRepository{}
+}
+
+func (mockPostRepository *MockPostRepository) GetAllPosts(ctx context.Context, paginationQuery common.PaginationQuery, postFilter post.PostFilter) common.Result[post.Posts] {
+	mockPostRepository.PaginationQueries = append(mockPostRepository.PaginationQueries, paginationQuery)
+	mockPostRepository.PostFilters = append(mockPostRepository.PostFilters, postFilter)
+	return mockPostRepository.GetAllPostsResult
+}
+
+func (mockPostRepository *MockPostRepository) GetPostById(ctx context.Context, postID string) common.Result[post.Post] {
+	return mockPostRepository.GetPostByIdResult
+}
+
+func (mockPostRepository *MockPostRepository) UpdatePostById(ctx context.Context, postUpdate post.PostUpdate) common.Result[post.Post] {
+	mockPostRepository.UpdatedPosts = append(mockPostRepository.UpdatedPosts, postUpdate)
+	return mockPostRepository.UpdatePostByIdResult
+}
+
+// ChangePostStatus changes the post of GetPostByIdResult like the database does,
+// only while its status is one of the previous statuses, and reports a conflict otherwise.
+func (mockPostRepository *MockPostRepository) ChangePostStatus(ctx context.Context, postStatusUpdate post.PostStatusUpdate) common.Result[post.Post] {
+	mockPostRepository.PostStatusUpdates = append(mockPostRepository.PostStatusUpdates, postStatusUpdate)
+	changedPost := mockPostRepository.GetPostByIdResult.Data
+	if !slices.Contains(postStatusUpdate.PreviousStatuses, changedPost.Status) {
+		notification := fmt.Sprintf(constants.PostStatusChangeNotAllowed, postStatusUpdate.Status)
+		return common.NewResultOnFailure[post.Post](domain.NewConflictError(location+"ChangePostStatus", postStatusField, notification))
+	}
+
+	changedPost.Status = postStatusUpdate.Status
+	changedPost.PublishAt = postStatusUpdate.PublishAt
+	mockPostRepository.GetPostByIdResult = common.NewResultOnSuccess(changedPost)
+	return mockPostRepository.GetPostByIdResult
+}
+
+func (mockPostRepository *MockPostRepository) DeletePostByID(ctx context.Context, postID string) error {
+	mockPostRepository.DeletedPostIDs = append(mockPostRepository.DeletedPostIDs, postID)
+	return mockPostRepository.DeletePostByIDError
+}
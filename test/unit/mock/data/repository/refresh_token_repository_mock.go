@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+)
+
+// MockRefreshTokenRepository returns the configured results and records the tokens it is asked to store, rotate and revoke.
+type MockRefreshTokenRepository struct {
+	interfaces.RefreshTokenRepository
+	GetRefreshTokenByTokenIDResult common.Result[user.RefreshToken]
+	CreateRefreshTokenError        error
+	RotateRefreshTokenError        error
+	CreatedRefreshTokens           []user.RefreshTokenCreate
+	RotatedRefreshTokens           []string
+	RevokedRefreshTokens           []string
+	RevokedRefreshTokenFamilies    []string
+	RevokedSessionUserIDs          []string
+	KeptSessionIDs                 map[string]string
+}
+
+func NewMockRefreshTokenRepository() *MockRefreshTokenRepository {
+	return &MockRefreshTokenRepository{
+		KeptSessionIDs: make(map[string]string),
+	}
+}
+
+func (mockRefreshTokenRepository *MockRefreshTokenRepository) CreateRefreshToken(ctx context.Context, refreshTokenCreate user.RefreshTokenCreate) common.Result[user.RefreshToken] {
+	if mockRefreshTokenRepository.CreateRefreshTokenError != nil {
+		return common.NewResultOnFailure[user.RefreshToken](mockRefreshTokenRepository.CreateRefreshTokenError)
+	}
+
+	mockRefreshTokenRepository.CreatedRefreshTokens = append(mockRefreshTokenRepository.CreatedRefreshTokens, refreshTokenCreate)
+	return common.NewResultOnSuccess(user.RefreshToken{TokenID: refreshTokenCreate.TokenID, FamilyID: refreshTokenCreate.FamilyID, UserID: refreshTokenCreate.UserID})
+}
+
+func (mockRefreshTokenRepository *MockRefreshTokenRepository) GetRefreshTokenByTokenID(ctx context.Context, tokenID string) common.Result[user.RefreshToken] {
+	return mockRefreshTokenRepository.GetRefreshTokenByTokenIDResult
+}
+
+func (mockRefreshTokenRepository *MockRefreshTokenRepository) RotateRefreshToken(ctx context.Context, tokenID, replacedByTokenID string) error {
+	if mockRefreshTokenRepository.RotateRefreshTokenError != nil {
+		return mockRefreshTokenRepository.RotateRefreshTokenError
+	}
+
+	mockRefreshTokenRepository.RotatedRefreshTokens = append(mockRefreshTokenRepository.RotatedRefreshTokens, tokenID)
+	return nil
+}
+
+func (mockRefreshTokenRepository *MockRefreshTokenRepository) RevokeRefreshToken(ctx context.Context, tokenID string) error {
+	mockRefreshTokenRepository.RevokedRefreshTokens = append(mockRefreshTokenRepository.RevokedRefreshTokens, tokenID)
+	return nil
+}
+
+func (mockRefreshTokenRepository *MockRefreshTokenRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	mockRefreshTokenRepository.RevokedRefreshTokenFamilies = append(mockRefreshTokenRepository.RevokedRefreshTokenFamilies, familyID)
+	return nil
+}
+
+func (mockRefreshTokenRepository *MockRefreshTokenRepository) RevokeAllSessions(ctx context.Context, userID string) error {
+	mockRefreshTokenRepository.RevokedSessionUserIDs = append(mockRefreshTokenRepository.RevokedSessionUserIDs, userID)
+	return nil
+}
+
+// RevokeOtherSessions records the session that is kept for the user.
+func (mockRefreshTokenRepository *MockRefreshTokenRepository) RevokeOtherSessions(ctx context.Context, userID, currentSessionID string) error {
+	mockRefreshTokenRepository.KeptSessionIDs[userID] = currentSessionID
+	return nil
+}
@@ -0,0 +1,206 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	user "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/model"
+	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+)
+
+const (
+	emailChangeNotFound = "The token does not match any pending email change."
+	magicLinkNotFound   = "The token does not match any magic link that has not expired."
+)
+
+// MockUserRepository returns the configured results and records the changes it is asked for.
+// The pending email changes and the magic links are kept in memory by their hashed tokens, like the stored user documents.
+// The methods a test doesn't expect are left to the embedded nil interface, so calling them fails the test.
+type MockUserRepository struct {
+	interfaces.UserRepository
+	GetUserByIdResult            common.Result[user.User]
+	GetUserByEmailResult         common.Result[user.User]
+	CheckEmailDuplicateError     error
+	CountUsersByRoleResult       common.Result[int64]
+	RegisteredUsers              []user.UserCreate
+	RegisterError                error
+	UpdatedUserRoles             []user.UserRoleUpdate
+	SuspendedUsers               []user.UserSuspension
+	UnsuspendedUserIDs           []string
+	UserVerifications            map[string]bool
+	DeletedUserIDs               []string
+	ForgottenPasswords           []user.UserForgottenPassword
+	UpdatedPasswords             []user.UserPasswordUpdate
+	EmailChanges                 map[string]user.UserEmailChange
+	ConfirmedEmailChanges        []user.UserEmailChange
+	MagicLinks                   map[string]user.UserMagicLink
+	MFATokens                    []user.UserMFAToken
+	UpdateVerificationCodes      []user.UserVerificationCode
+	UpdateVerificationCodeError  error
+	DisabledTwoFactorUserIDs     []string
+	TwoFactorLastSteps           []int64
+	UpdateTwoFactorLastStepError error
+}
+
+func NewMockUserRepository() *MockUserRepository {
+	return &MockUserRepository{
+		UserVerifications: make(map[string]bool),
+		EmailChanges:      make(map[string]user.UserEmailChange),
+		MagicLinks:        make(map[string]user.UserMagicLink),
+	}
+}
+
+func (mockUserRepository *MockUserRepository) GetUserById(ctx context.Context, userID string) common.Result[user.User] {
+	return mockUserRepository.GetUserByIdResult
+}
+
+func (mockUserRepository *MockUserRepository) GetUserByEmail(ctx context.Context, email string) common.Result[user.User] {
+	return mockUserRepository.GetUserByEmailResult
+}
+
+func (mockUserRepository *MockUserRepository) CheckEmailDuplicate(ctx context.Context, email string) error {
+	return mockUserRepository.CheckEmailDuplicateError
+}
+
+func (mockUserRepository *MockUserRepository) CheckUsernameDuplicate(ctx context.Context, userID, handle string) error {
+	return nil
+}
+
+func (mockUserRepository *MockUserRepository) Register(ctx context.Context, userCreate user.UserCreate) common.Result[user.User] {
+	if mockUserRepository.RegisterError != nil {
+		return common.NewResultOnFailure[user.User](mockUserRepository.RegisterError)
+	}
+
+	mockUserRepository.RegisteredUsers = append(mockUserRepository.RegisteredUsers, userCreate)
+	return common.NewResultOnSuccess(user.User{Email: userCreate.Email, Username: userCreate.Username, Handle: userCreate.Handle})
+}
+
+func (mockUserRepository *MockUserRepository) UpdatePassword(ctx context.Context, userPasswordUpdate user.UserPasswordUpdate) error {
+	mockUserRepository.UpdatedPasswords = append(mockUserRepository.UpdatedPasswords, userPasswordUpdate)
+	return nil
+}
+
+func (mockUserRepository *MockUserRepository) RequestEmailChange(ctx context.Context, userEmailChange user.UserEmailChange) error {
+	mockUserRepository.EmailChanges[userEmailChange.ConfirmationToken] = userEmailChange
+	return nil
+}
+
+func (mockUserRepository *MockUserRepository) GetEmailChange(ctx context.Context, confirmationToken string) common.Result[user.UserEmailChange] {
+	userEmailChange, ok := mockUserRepository.EmailChanges[confirmationToken]
+	if !ok {
+		return common.NewResultOnFailure[user.UserEmailChange](domain.NewInvalidTokenError(location+"GetEmailChange", emailChangeNotFound))
+	}
+
+	return common.NewResultOnSuccess(userEmailChange)
+}
+
+// ConfirmEmailChange applies only a pending change that has not expired, like the update query.
+func (mockUserRepository *MockUserRepository) ConfirmEmailChange(ctx context.Context, userEmailChange user.UserEmailChange) error {
+	pendingEmailChange, ok := mockUserRepository.EmailChanges[userEmailChange.ConfirmationToken]
+	if !ok || pendingEmailChange.Email != userEmailChange.Email || !pendingEmailChange.Expiry.After(time.Now()) {
+		return domain.NewInvalidTokenError(location+"ConfirmEmailChange", emailChangeNotFound)
+	}
+
+	delete(mockUserRepository.EmailChanges, userEmailChange.ConfirmationToken)
+	mockUserRepository.ConfirmedEmailChanges = append(mockUserRepository.ConfirmedEmailChanges, pendingEmailChange)
+	return nil
+}
+
+func (mockUserRepository *MockUserRepository) CancelEmailChange(ctx context.Context, cancelToken string) error {
+	for confirmationToken, userEmailChange := range mockUserRepository.EmailChanges {
+		if userEmailChange.CancelToken == cancelToken {
+			delete(mockUserRepository.EmailChanges, confirmationToken)
+			return nil
+		}
+	}
+
+	return domain.NewInvalidTokenError(location+"CancelEmailChange", emailChangeNotFound)
+}
+
+func (mockUserRepository *MockUserRepository) CountUsersByRole(ctx context.Context, role string) common.Result[int64] {
+	return mockUserRepository.CountUsersByRoleResult
+}
+
+func (mockUserRepository *MockUserRepository) UpdateUserRole(ctx context.Context, userRoleUpdate user.UserRoleUpdate) error {
+	mockUserRepository.UpdatedUserRoles = append(mockUserRepository.UpdatedUserRoles, userRoleUpdate)
+	return nil
+}
+
+func (mockUserRepository *MockUserRepository) SuspendUser(ctx context.Context, userSuspension user.UserSuspension) error {
+	mockUserRepository.SuspendedUsers = append(mockUserRepository.SuspendedUsers, userSuspension)
+	return nil
+}
+
+func (mockUserRepository *MockUserRepository) UnsuspendUser(ctx context.Context, userID string) error {
+	mockUserRepository.UnsuspendedUserIDs = append(mockUserRepository.UnsuspendedUserIDs, userID)
+	return nil
+}
+
+func (mockUserRepository *MockUserRepository) UpdateUserVerification(ctx context.Context, userID string, verified bool) error {
+	mockUserRepository.UserVerifications[userID] = verified
+	return nil
+}
+
+func (mockUserRepository *MockUserRepository) DeleteUserById(ctx context.Context, userID string) error {
+	mockUserRepository.DeletedUserIDs = append(mockUserRepository.DeletedUserIDs, userID)
+	return nil
+}
+
+// ForgottenPassword marks the password of the returned user with the same email when the reset is forced.
+func (mockUserRepository *MockUserRepository) ForgottenPassword(ctx context.Context, userForgottenPassword user.UserForgottenPassword) error {
+	mockUserRepository.ForgottenPasswords = append(mockUserRepository.ForgottenPasswords, userForgottenPassword)
+	if userForgottenPassword.MustResetPassword && mockUserRepository.GetUserByEmailResult.Data.Email == userForgottenPassword.Email {
+		mockUserRepository.GetUserByEmailResult.Data.MustResetPassword = true
+	}
+
+	return nil
+}
+
+func (mockUserRepository *MockUserRepository) UpdateVerificationCode(ctx context.Context, userVerificationCode user.UserVerificationCode) error {
+	mockUserRepository.UpdateVerificationCodes = append(mockUserRepository.UpdateVerificationCodes, userVerificationCode)
+	return mockUserRepository.UpdateVerificationCodeError
+}
+
+func (mockUserRepository *MockUserRepository) DisableTwoFactor(ctx context.Context, userID string) error {
+	mockUserRepository.DisabledTwoFactorUserIDs = append(mockUserRepository.DisabledTwoFactorUserIDs, userID)
+	return nil
+}
+
+func (mockUserRepository *MockUserRepository) UpdateTwoFactorLastStep(ctx context.Context, userID string, step int64) error {
+	if mockUserRepository.UpdateTwoFactorLastStepError != nil {
+		return mockUserRepository.UpdateTwoFactorLastStepError
+	}
+
+	mockUserRepository.TwoFactorLastSteps = append(mockUserRepository.TwoFactorLastSteps, step)
+	return nil
+}
+
+func (mockUserRepository *MockUserRepository) UpdateMFAToken(ctx context.Context, userMFAToken user.UserMFAToken) error {
+	mockUserRepository.MFATokens = append(mockUserRepository.MFATokens, userMFAToken)
+	return nil
+}
+
+// UpdateMagicLinkToken replaces the previous magic link of the user.
+func (mockUserRepository *MockUserRepository) UpdateMagicLinkToken(ctx context.Context, userMagicLink user.UserMagicLink) error {
+	for magicLinkToken, previousMagicLink := range mockUserRepository.MagicLinks {
+		if previousMagicLink.ID == userMagicLink.ID {
+			delete(mockUserRepository.MagicLinks, magicLinkToken)
+		}
+	}
+
+	mockUserRepository.MagicLinks[userMagicLink.MagicLinkToken] = userMagicLink
+	return nil
+}
+
+// ConsumeMagicLinkToken consumes only a magic link that has not expired, like the update query, and returns the configured user.
+func (mockUserRepository *MockUserRepository) ConsumeMagicLinkToken(ctx context.Context, magicLinkToken string) common.Result[user.User] {
+	userMagicLink, ok := mockUserRepository.MagicLinks[magicLinkToken]
+	if !ok || !userMagicLink.MagicLinkExpiry.After(time.Now()) {
+		return common.NewResultOnFailure[user.User](domain.NewInvalidTokenError(location+"ConsumeMagicLinkToken", magicLinkNotFound))
+	}
+
+	delete(mockUserRepository.MagicLinks, magicLinkToken)
+	return mockUserRepository.GetUserByIdResult
+}
@@ -0,0 +1,20 @@
+package breach
+
+import (
+	"context"
+
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+)
+
+// MockBreachedPasswords returns the configured hash suffixes for every prefix, none by default.
+type MockBreachedPasswords struct {
+	HashSuffixes []string
+}
+
+func NewMockBreachedPasswords() *MockBreachedPasswords {
+	return &MockBreachedPasswords{}
+}
+
+func (mockBreachedPasswords *MockBreachedPasswords) GetHashSuffixes(ctx context.Context, hashPrefix string) common.Result[[]string] {
+	return common.NewResultOnSuccess(mockBreachedPasswords.HashSuffixes)
+}
@@ -2,22 +2,31 @@ package common
 
 import (
 	"fmt"
+	"sync/atomic"
 
 	model "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
 	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
 )
 
+// MockEmail only counts the emails instead of sending them.
 type MockEmail struct {
-	IsError bool
+	IsError    bool
+	sentEmails atomic.Int64
 }
 
-func NewMockEmail() MockEmail {
-	return MockEmail{}
+func NewMockEmail() *MockEmail {
+	return &MockEmail{}
 }
 
-func (mockEmail MockEmail) SendEmail(config *model.ApplicationConfig, logger interfaces.Logger, location string, data any, emailData interfaces.EmailData) error {
+func (mockEmail *MockEmail) SendEmail(config *model.ApplicationConfig, logger interfaces.Logger, location string, data any, emailData interfaces.EmailData) error {
 	if mockEmail.IsError {
 		return fmt.Errorf("")
 	}
+	mockEmail.sentEmails.Add(1)
 	return nil
 }
+
+// SentEmails returns how many emails have been sent.
+func (mockEmail *MockEmail) SentEmails() int64 {
+	return mockEmail.sentEmails.Load()
+}
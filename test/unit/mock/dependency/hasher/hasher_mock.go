@@ -0,0 +1,31 @@
+package hasher
+
+import (
+	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
+)
+
+const hashedPrefix = "hashed:"
+
+// MockPasswordHasher "hashes" a password by prefixing it, so tests can store known hashes without a real algorithm.
+type MockPasswordHasher struct{}
+
+func NewMockPasswordHasher() MockPasswordHasher {
+	return MockPasswordHasher{}
+}
+
+// Hash returns the hash that VerifyPassword accepts for the password.
+func Hash(password string) string {
+	return hashedPrefix + password
+}
+
+func (mockPasswordHasher MockPasswordHasher) HashPassword(location, password string) common.Result[string] {
+	return common.NewResultOnSuccess(Hash(password))
+}
+
+func (mockPasswordHasher MockPasswordHasher) VerifyPassword(hashedPassword, password string) bool {
+	return hashedPassword == Hash(password)
+}
+
+func (mockPasswordHasher MockPasswordHasher) NeedsRehash(hashedPassword string) bool {
+	return false
+}
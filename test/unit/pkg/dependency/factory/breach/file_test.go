@@ -0,0 +1,86 @@
+package breach
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	domainUtility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	breach "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/breach"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+	mock "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/common"
+)
+
+const (
+	// SHA-1 of "password" and "123456".
+	passwordHash = "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8"
+	numbersHash  = "7c4a8d09ca3762af61e59520943dc26494f8941b"
+
+	sampleFile = "../../../../../../pkg/dependency/factory/breach/list/breached_passwords.txt"
+)
+
+func writeFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "breached_passwords.txt")
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600), test.ErrorNilMessage)
+	return path
+}
+
+func TestNewFile(t *testing.T) {
+	t.Parallel()
+	path := writeFile(t, "# comment\n\n"+passwordHash+":9659365\n"+numbersHash+"\n")
+
+	file := breach.NewFile(mock.NewMockLogger(), path)
+	assert.NoError(t, file.Error, test.ErrorNilMessage)
+
+	ctx := context.Background()
+	hashSuffixes := file.Data.GetHashSuffixes(ctx, "5baa6")
+	assert.NoError(t, hashSuffixes.Error, test.ErrorNilMessage)
+	assert.Equal(t, []string{passwordHash[5:]}, hashSuffixes.Data, test.EqualMessage)
+
+	// Lowercase hashes of the file are normalized.
+	hashSuffixes = file.Data.GetHashSuffixes(ctx, "7C4A8")
+	assert.Equal(t, []string{"D09CA3762AF61E59520943DC26494F8941B"}, hashSuffixes.Data, test.EqualMessage)
+
+	hashSuffixes = file.Data.GetHashSuffixes(ctx, "00000")
+	assert.Empty(t, hashSuffixes.Data, test.EqualMessage)
+}
+
+func TestNewFileEmptyPath(t *testing.T) {
+	t.Parallel()
+	file := breach.NewFile(mock.NewMockLogger(), "")
+	assert.NoError(t, file.Error, test.ErrorNilMessage)
+
+	hashSuffixes := file.Data.GetHashSuffixes(context.Background(), "5BAA6")
+	assert.NoError(t, hashSuffixes.Error, test.ErrorNilMessage)
+	assert.Empty(t, hashSuffixes.Data, test.EqualMessage)
+}
+
+func TestNewFileMissing(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+
+	file := breach.NewFile(mockLogger, filepath.Join(t.TempDir(), "missing.txt"))
+	assert.Error(t, file.Error, test.ErrorNotNilMessage)
+	assert.Equal(t, file.Error, mockLogger.LastError, test.EqualMessage)
+}
+
+func TestNewFileInvalidLine(t *testing.T) {
+	t.Parallel()
+	path := writeFile(t, passwordHash+"\npassword\n")
+
+	file := breach.NewFile(mock.NewMockLogger(), path)
+	assert.Error(t, file.Error, test.ErrorNotNilMessage)
+	assert.Contains(t, file.Error.Error(), "line 2", test.EqualMessage)
+}
+
+func TestSampleFile(t *testing.T) {
+	t.Parallel()
+	file := breach.NewFile(mock.NewMockLogger(), sampleFile)
+	assert.NoError(t, file.Error, test.ErrorNilMessage)
+
+	hashPrefix, hashSuffix := domainUtility.SplitPasswordHash("password")
+	hashSuffixes := file.Data.GetHashSuffixes(context.Background(), hashPrefix)
+	assert.Contains(t, hashSuffixes.Data, hashSuffix, test.EqualMessage)
+}
@@ -7,10 +7,13 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	domainUtility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
 	factory "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory"
+	breach "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/breach"
 	repository "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/data/repository"
 	delivery "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/delivery"
 	email "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/email"
+	hasher "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/hasher"
 	logger "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/logger"
 	interfaces "github.com/yachnytskyi/golang-mongo-grpc/pkg/interfaces"
 	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
@@ -49,11 +52,78 @@ func TestNewRepositoryMongoDB(t *testing.T) {
 	mockConfig.Core.Database = constants.MongoDB
 	mockLogger := mock.NewMockLogger()
 
-	mongoDBRepository := factory.NewRepositoryFactory(mockConfig, mockLogger)
+	mongoDBRepository := factory.NewRepositoryFactory(mockConfig, mockLogger, nil)
 	assert.IsType(t, &repository.MongoDBRepository{}, mongoDBRepository, test.EqualMessage)
 	assert.Implements(t, (*interfaces.Repository)(nil), mongoDBRepository, test.EqualMessage)
 }
 
+func TestNewPasswordHasherArgon2id(t *testing.T) {
+	t.Parallel()
+	mockConfig := mock.NewMockConfig()
+	mockConfig.Security.PasswordHashing.Algorithm = constants.Argon2id
+	mockLogger := mock.NewMockLogger()
+
+	passwordHasher := factory.NewPasswordHasher(mockConfig, mockLogger)
+	assert.IsType(t, hasher.PasswordHasher{}, passwordHasher, test.EqualMessage)
+	assert.IsType(t, hasher.Argon2id{}, passwordHasher.(hasher.PasswordHasher).Current, test.EqualMessage)
+	assert.Nil(t, mockLogger.LastPanic, test.ErrorNilMessage)
+}
+
+func TestNewPasswordHasherBcrypt(t *testing.T) {
+	t.Parallel()
+	mockConfig := mock.NewMockConfig()
+	mockConfig.Security.PasswordHashing.Algorithm = constants.Bcrypt
+	mockLogger := mock.NewMockLogger()
+
+	passwordHasher := factory.NewPasswordHasher(mockConfig, mockLogger)
+	assert.IsType(t, hasher.Bcrypt{}, passwordHasher.(hasher.PasswordHasher).Current, test.EqualMessage)
+	assert.Nil(t, mockLogger.LastPanic, test.ErrorNilMessage)
+}
+
+func TestNewPasswordHasherInvalidType(t *testing.T) {
+	t.Parallel()
+	mockConfig := mock.NewMockConfig()
+	mockConfig.Security.PasswordHashing.Algorithm = constants.Argon2id + "1"
+	mockLogger := mock.NewMockLogger()
+
+	notification := fmt.Sprintf(constants.UnsupportedHasher, mockConfig.Security.PasswordHashing.Algorithm)
+	expectedError := domain.NewInternalError(expectedLocation+"NewPasswordHasher", notification)
+	factory.NewPasswordHasher(mockConfig, mockLogger)
+	assert.Equal(t, expectedError, mockLogger.LastPanic, test.EqualMessage)
+}
+
+func TestNewPasswordHasherInvalidParameters(t *testing.T) {
+	t.Parallel()
+	mockConfig := mock.NewMockConfig()
+	mockConfig.Security.PasswordHashing.Algorithm = constants.Bcrypt
+	mockConfig.Security.PasswordHashing.Bcrypt.Cost = 100
+	mockLogger := mock.NewMockLogger()
+
+	factory.NewPasswordHasher(mockConfig, mockLogger)
+	assert.Error(t, mockLogger.LastPanic, test.ErrorNotNilMessage)
+}
+
+func TestNewBreachedPasswordsDisabled(t *testing.T) {
+	t.Parallel()
+	mockConfig := mock.NewMockConfig()
+	mockLogger := mock.NewMockLogger()
+
+	breachedPasswords := factory.NewBreachedPasswords(mockConfig, mockLogger)
+	assert.IsType(t, breach.File{}, breachedPasswords, test.EqualMessage)
+	assert.Implements(t, (*interfaces.BreachedPasswords)(nil), breachedPasswords, test.EqualMessage)
+	assert.Nil(t, mockLogger.LastPanic, test.ErrorNilMessage)
+}
+
+func TestNewBreachedPasswordsMissingFile(t *testing.T) {
+	t.Parallel()
+	mockConfig := mock.NewMockConfig()
+	mockConfig.Security.PasswordPolicy.BreachedPasswordsPath = t.TempDir() + "/missing.txt"
+	mockLogger := mock.NewMockLogger()
+
+	factory.NewBreachedPasswords(mockConfig, mockLogger)
+	assert.Error(t, mockLogger.LastPanic, test.ErrorNotNilMessage)
+}
+
 func TestNewDeliveryGin(t *testing.T) {
 	t.Parallel()
 	mockConfig := mock.NewMockConfig()
@@ -62,7 +132,7 @@ func TestNewDeliveryGin(t *testing.T) {
 	mockRepository := mock.NewMockRepository()
 
 	ctx := context.Background()
-	ginDelivery := factory.NewDeliveryFactory(ctx, mockConfig, mockLogger, mockRepository)
+	ginDelivery := factory.NewDeliveryFactory(ctx, mockConfig, mockLogger, domainUtility.KeyRings{}, mockRepository)
 	assert.IsType(t, &delivery.GinDelivery{}, ginDelivery, test.EqualMessage)
 	assert.Implements(t, (*interfaces.Delivery)(nil), ginDelivery, test.EqualMessage)
 }
@@ -116,7 +186,7 @@ func TestNewRepositoryInvalidType(t *testing.T) {
 		}
 	}()
 
-	factory.NewRepositoryFactory(mockConfig, mockLogger)
+	factory.NewRepositoryFactory(mockConfig, mockLogger, nil)
 }
 
 func TestNewDeliveryInvalidType(t *testing.T) {
@@ -136,5 +206,5 @@ func TestNewDeliveryInvalidType(t *testing.T) {
 	}()
 
 	ctx := context.Background()
-	factory.NewDeliveryFactory(ctx, mockConfig, mockLogger, mockRepository)
+	factory.NewDeliveryFactory(ctx, mockConfig, mockLogger, domainUtility.KeyRings{}, mockRepository)
 }
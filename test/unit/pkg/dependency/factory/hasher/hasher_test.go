@@ -0,0 +1,191 @@
+package hasher
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	hasher "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/hasher"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+	mock "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/common"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	location      = "test.unit.pkg.dependency.factory.hasher."
+	password      = "password"
+	otherPassword = "other-password"
+)
+
+var (
+	phcArgon2idRegex = regexp.MustCompile(`^\$argon2id\$v=19\$m=1024,t=1,p=1\$[A-Za-z0-9+/]{22}\$[A-Za-z0-9+/]{43}$`)
+
+	// Small parameters keep the tests fast.
+	argon2idConfig = config.Argon2id{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+)
+
+func setupArgon2id(t *testing.T, argon2idConfig config.Argon2id) hasher.Argon2id {
+	argon2id := hasher.NewArgon2id(mock.NewMockLogger(), location+"setupArgon2id", argon2idConfig)
+	assert.NoError(t, argon2id.Error, test.ErrorNilMessage)
+	return argon2id.Data
+}
+
+func setupBcrypt(t *testing.T, cost int) hasher.Bcrypt {
+	bcryptAlgorithm := hasher.NewBcrypt(mock.NewMockLogger(), location+"setupBcrypt", config.Bcrypt{Cost: cost})
+	assert.NoError(t, bcryptAlgorithm.Error, test.ErrorNilMessage)
+	return bcryptAlgorithm.Data
+}
+
+func TestArgon2idHashPHCFormat(t *testing.T) {
+	t.Parallel()
+	passwordHasher := hasher.NewPasswordHasher(mock.NewMockLogger(), setupArgon2id(t, argon2idConfig))
+
+	hashedPassword := passwordHasher.HashPassword(location+"TestArgon2idHashPHCFormat", password)
+
+	assert.NoError(t, hashedPassword.Error, test.ErrorNilMessage)
+	assert.Regexp(t, phcArgon2idRegex, hashedPassword.Data, test.EqualMessage)
+	assert.True(t, passwordHasher.VerifyPassword(hashedPassword.Data, password), test.NotFailureMessage)
+	assert.False(t, passwordHasher.VerifyPassword(hashedPassword.Data, otherPassword), test.FailureMessage)
+	assert.False(t, passwordHasher.NeedsRehash(hashedPassword.Data), test.FailureMessage)
+}
+
+func TestArgon2idHashUsesRandomSalt(t *testing.T) {
+	t.Parallel()
+	passwordHasher := hasher.NewPasswordHasher(mock.NewMockLogger(), setupArgon2id(t, argon2idConfig))
+
+	firstHash := passwordHasher.HashPassword(location+"TestArgon2idHashUsesRandomSalt", password)
+	secondHash := passwordHasher.HashPassword(location+"TestArgon2idHashUsesRandomSalt", password)
+
+	assert.NotEqual(t, firstHash.Data, secondHash.Data, test.EqualMessage)
+}
+
+func TestArgon2idDefaults(t *testing.T) {
+	t.Parallel()
+	argon2id := setupArgon2id(t, config.Argon2id{})
+
+	assert.Equal(t, hasher.Argon2id{Memory: 64 * 1024, Iterations: 3, Parallelism: 4, SaltLength: 16, KeyLength: 32}, argon2id, test.EqualMessage)
+}
+
+func TestArgon2idInvalidParameters(t *testing.T) {
+	t.Parallel()
+	tests := []config.Argon2id{
+		{Memory: 8, Parallelism: 4},
+		{SaltLength: 4},
+		{KeyLength: 8},
+	}
+
+	for _, invalidConfig := range tests {
+		mockLogger := mock.NewMockLogger()
+		argon2id := hasher.NewArgon2id(mockLogger, location+"TestArgon2idInvalidParameters", invalidConfig)
+		assert.Error(t, argon2id.Error, test.ErrorNotNilMessage)
+		assert.Error(t, mockLogger.LastError, test.ErrorNotNilMessage)
+	}
+}
+
+func TestArgon2idNeedsRehashOnChangedParameters(t *testing.T) {
+	t.Parallel()
+	oldPasswordHasher := hasher.NewPasswordHasher(mock.NewMockLogger(), setupArgon2id(t, argon2idConfig))
+	hashedPassword := oldPasswordHasher.HashPassword(location+"TestArgon2idNeedsRehashOnChangedParameters", password)
+	assert.NoError(t, hashedPassword.Error, test.ErrorNilMessage)
+
+	changedConfigs := []config.Argon2id{
+		{Memory: 2048, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32},
+		{Memory: 1024, Iterations: 2, Parallelism: 1, SaltLength: 16, KeyLength: 32},
+		{Memory: 1024, Iterations: 1, Parallelism: 2, SaltLength: 16, KeyLength: 32},
+		{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 32, KeyLength: 32},
+		{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 64},
+	}
+	for _, changedConfig := range changedConfigs {
+		passwordHasher := hasher.NewPasswordHasher(mock.NewMockLogger(), setupArgon2id(t, changedConfig))
+		assert.True(t, passwordHasher.NeedsRehash(hashedPassword.Data), test.NotFailureMessage)
+		// The old hash is still verified with its own parameters.
+		assert.True(t, passwordHasher.VerifyPassword(hashedPassword.Data, password), test.NotFailureMessage)
+	}
+}
+
+func TestBcryptHash(t *testing.T) {
+	t.Parallel()
+	passwordHasher := hasher.NewPasswordHasher(mock.NewMockLogger(), setupBcrypt(t, bcrypt.MinCost))
+
+	hashedPassword := passwordHasher.HashPassword(location+"TestBcryptHash", password)
+
+	assert.NoError(t, hashedPassword.Error, test.ErrorNilMessage)
+	assert.Regexp(t, `^\$2a\$04\$`, hashedPassword.Data, test.EqualMessage)
+	assert.True(t, passwordHasher.VerifyPassword(hashedPassword.Data, password), test.NotFailureMessage)
+	assert.False(t, passwordHasher.VerifyPassword(hashedPassword.Data, otherPassword), test.FailureMessage)
+	assert.False(t, passwordHasher.NeedsRehash(hashedPassword.Data), test.FailureMessage)
+}
+
+func TestBcryptDefaultCost(t *testing.T) {
+	t.Parallel()
+	bcryptAlgorithm := setupBcrypt(t, 0)
+
+	assert.Equal(t, bcrypt.DefaultCost, bcryptAlgorithm.Cost, test.EqualMessage)
+}
+
+func TestBcryptInvalidCost(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+
+	bcryptAlgorithm := hasher.NewBcrypt(mockLogger, location+"TestBcryptInvalidCost", config.Bcrypt{Cost: bcrypt.MaxCost + 1})
+
+	assert.Error(t, bcryptAlgorithm.Error, test.ErrorNotNilMessage)
+	assert.Error(t, mockLogger.LastError, test.ErrorNotNilMessage)
+}
+
+func TestBcryptNeedsRehashOnChangedCost(t *testing.T) {
+	t.Parallel()
+	hashedPassword, generateFromPasswordError := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	assert.NoError(t, generateFromPasswordError, test.ErrorNilMessage)
+	passwordHasher := hasher.NewPasswordHasher(mock.NewMockLogger(), setupBcrypt(t, bcrypt.MinCost+1))
+
+	assert.True(t, passwordHasher.NeedsRehash(string(hashedPassword)), test.NotFailureMessage)
+	assert.True(t, passwordHasher.VerifyPassword(string(hashedPassword), password), test.NotFailureMessage)
+}
+
+func TestMigrationFromBcryptToArgon2id(t *testing.T) {
+	t.Parallel()
+	// Existing users have bcrypt hashes with the default cost.
+	hashedPassword, generateFromPasswordError := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	assert.NoError(t, generateFromPasswordError, test.ErrorNilMessage)
+	passwordHasher := hasher.NewPasswordHasher(mock.NewMockLogger(), setupArgon2id(t, argon2idConfig), setupBcrypt(t, bcrypt.MinCost))
+
+	assert.True(t, passwordHasher.VerifyPassword(string(hashedPassword), password), test.NotFailureMessage)
+	assert.False(t, passwordHasher.VerifyPassword(string(hashedPassword), otherPassword), test.FailureMessage)
+	assert.True(t, passwordHasher.NeedsRehash(string(hashedPassword)), test.NotFailureMessage)
+
+	rehashedPassword := passwordHasher.HashPassword(location+"TestMigrationFromBcryptToArgon2id", password)
+	assert.NoError(t, rehashedPassword.Error, test.ErrorNilMessage)
+	assert.Regexp(t, phcArgon2idRegex, rehashedPassword.Data, test.EqualMessage)
+	assert.False(t, passwordHasher.NeedsRehash(rehashedPassword.Data), test.FailureMessage)
+}
+
+func TestUnknownAlgorithm(t *testing.T) {
+	t.Parallel()
+	passwordHasher := hasher.NewPasswordHasher(mock.NewMockLogger(), setupArgon2id(t, argon2idConfig))
+	hashedPassword, generateFromPasswordError := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	assert.NoError(t, generateFromPasswordError, test.ErrorNilMessage)
+
+	// A bcrypt hash is not accepted without the bcrypt algorithm.
+	assert.False(t, passwordHasher.VerifyPassword(string(hashedPassword), password), test.FailureMessage)
+	assert.False(t, passwordHasher.VerifyPassword(password, password), test.FailureMessage)
+	assert.True(t, passwordHasher.NeedsRehash(password), test.NotFailureMessage)
+}
+
+func TestMalformedArgon2idHash(t *testing.T) {
+	t.Parallel()
+	passwordHasher := hasher.NewPasswordHasher(mock.NewMockLogger(), setupArgon2id(t, argon2idConfig))
+	tests := []string{
+		"$argon2id$",
+		"$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$",
+		"$argon2id$v=18$m=1024,t=1,p=1$c2FsdHNhbHRzYWx0$a2V5a2V5a2V5a2V5a2V5a2V5",
+		"$argon2id$v=19$m=1024,t=0,p=1$c2FsdHNhbHRzYWx0$a2V5a2V5a2V5a2V5a2V5a2V5",
+		"$argon2id$v=19$m=1024,t=1,p=1$!!!$a2V5a2V5a2V5a2V5a2V5a2V5",
+	}
+
+	for _, hashedPassword := range tests {
+		assert.False(t, passwordHasher.VerifyPassword(hashedPassword, password), test.FailureMessage)
+		assert.True(t, passwordHasher.NeedsRehash(hashedPassword), test.NotFailureMessage)
+	}
+}
@@ -0,0 +1,64 @@
+package oauth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	oauth "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/oauth"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+	mock "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/common"
+)
+
+// setupGitHub returns a GitHub provider that talks to the fake provider instead of GitHub.
+func setupGitHub(provider *fakeProvider) oauth.GitHub {
+	providerConfig := provider.config()
+	providerConfig.Type = constants.GitHub
+	gitHub := oauth.NewGitHub(mock.NewMockLogger(), providerConfig)
+	gitHub.AuthorizationEndpoint = provider.Server.URL + "/authorize"
+	gitHub.TokenEndpoint = provider.Server.URL + "/token"
+	gitHub.APIURL = provider.Server.URL
+	return gitHub
+}
+
+func TestGitHubAuthorizationURL(t *testing.T) {
+	t.Parallel()
+	provider := newFakeProvider(t)
+	gitHub := setupGitHub(provider)
+
+	authorizationURL := gitHub.AuthorizationURL(context.Background(), state, utility.CodeChallenge(codeVerifier))
+
+	assert.NoError(t, authorizationURL.Error, test.ErrorNilMessage)
+	assertAuthorizationURL(t, authorizationURL.Data, provider.Server.URL+"/authorize", "read:user user:email")
+}
+
+func TestGitHubExchange(t *testing.T) {
+	t.Parallel()
+	provider := newFakeProvider(t)
+	gitHub := setupGitHub(provider)
+	authorizationURL := gitHub.AuthorizationURL(context.Background(), state, utility.CodeChallenge(codeVerifier))
+	assert.NoError(t, authorizationURL.Error, test.ErrorNilMessage)
+
+	identity := gitHub.Exchange(context.Background(), provider.authorize(t, authorizationURL.Data), codeVerifier)
+
+	assert.NoError(t, identity.Error, test.ErrorNilMessage)
+	assert.Equal(t, "fake", identity.Data.Provider, test.EqualMessage)
+	assert.Equal(t, subject, identity.Data.Subject, test.EqualMessage)
+	assert.Equal(t, email, identity.Data.Email, test.EqualMessage)
+	assert.True(t, identity.Data.EmailVerified, test.NotFailureMessage)
+	assert.Equal(t, username, identity.Data.Username, test.EqualMessage)
+}
+
+func TestGitHubExchangeWrongCodeVerifier(t *testing.T) {
+	t.Parallel()
+	provider := newFakeProvider(t)
+	gitHub := setupGitHub(provider)
+	authorizationURL := gitHub.AuthorizationURL(context.Background(), state, utility.CodeChallenge(codeVerifier))
+	assert.NoError(t, authorizationURL.Error, test.ErrorNilMessage)
+
+	identity := gitHub.Exchange(context.Background(), provider.authorize(t, authorizationURL.Data), codeVerifier+"-other")
+
+	assertInvalidToken(t, identity.Error)
+}
@@ -0,0 +1,171 @@
+package oauth
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+)
+
+const (
+	location = "test.unit.pkg.dependency.factory.oauth."
+
+	clientID     = "client-id"
+	clientSecret = "client-secret"
+	redirectURL  = "http://localhost:8080/api/users/oauth/fake/callback"
+	state        = "state"
+	codeVerifier = "code-verifier-code-verifier-code-verifier-code-verifier"
+	accessToken  = "access-token"
+	subject      = "42"
+	email        = "user@example.com"
+	username     = "user"
+)
+
+// fakeProvider is a local OpenID Connect provider. The user is signed in right away, the provider remembers
+// the code challenge of the authorization and only issues the access token for the matching code verifier.
+type fakeProvider struct {
+	Server         *httptest.Server
+	Issuer         string
+	EmailVerified  bool
+	mutex          sync.Mutex
+	codeChallenges map[string]string
+}
+
+func newFakeProvider(t *testing.T) *fakeProvider {
+	provider := &fakeProvider{EmailVerified: true, codeChallenges: make(map[string]string)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", provider.discovery)
+	mux.HandleFunc("/token", provider.token)
+	mux.HandleFunc("/userinfo", provider.userinfo)
+	mux.HandleFunc("/user", provider.gitHubUser)
+	mux.HandleFunc("/user/emails", provider.gitHubEmails)
+	provider.Server = httptest.NewServer(mux)
+	provider.Issuer = provider.Server.URL
+	t.Cleanup(provider.Server.Close)
+	return provider
+}
+
+func (provider *fakeProvider) config() config.OAuthProvider {
+	return config.OAuthProvider{
+		Name:         "fake",
+		Type:         constants.OIDC,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RedirectURL:  redirectURL,
+		IssuerURL:    provider.Server.URL,
+	}
+}
+
+// authorize imitates the user signing in at the provider and returns the code the provider redirects back with.
+func (provider *fakeProvider) authorize(t *testing.T, authorizationURL string) string {
+	parsedURL, parseError := url.Parse(authorizationURL)
+	assert.NoError(t, parseError, test.ErrorNilMessage)
+
+	code := "code-" + parsedURL.Query().Get("state")
+	provider.mutex.Lock()
+	provider.codeChallenges[code] = parsedURL.Query().Get("code_challenge")
+	provider.mutex.Unlock()
+	return code
+}
+
+func (provider *fakeProvider) discovery(responseWriter http.ResponseWriter, request *http.Request) {
+	writeJSON(responseWriter, http.StatusOK, map[string]string{
+		"issuer":                 provider.Issuer,
+		"authorization_endpoint": provider.Server.URL + "/authorize",
+		"token_endpoint":         provider.Server.URL + "/token",
+		"userinfo_endpoint":      provider.Server.URL + "/userinfo",
+	})
+}
+
+func (provider *fakeProvider) token(responseWriter http.ResponseWriter, request *http.Request) {
+	provider.mutex.Lock()
+	codeChallenge, ok := provider.codeChallenges[request.PostFormValue("code")]
+	delete(provider.codeChallenges, request.PostFormValue("code"))
+	provider.mutex.Unlock()
+
+	hash := sha256.Sum256([]byte(request.PostFormValue("code_verifier")))
+	if !ok ||
+		request.PostFormValue("grant_type") != "authorization_code" ||
+		request.PostFormValue("client_id") != clientID ||
+		request.PostFormValue("client_secret") != clientSecret ||
+		request.PostFormValue("redirect_uri") != redirectURL ||
+		base64.RawURLEncoding.EncodeToString(hash[:]) != codeChallenge {
+		writeJSON(responseWriter, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	writeJSON(responseWriter, http.StatusOK, map[string]string{"access_token": accessToken, "token_type": "Bearer"})
+}
+
+func (provider *fakeProvider) userinfo(responseWriter http.ResponseWriter, request *http.Request) {
+	if request.Header.Get(constants.Authorization) != constants.Bearer+accessToken {
+		writeJSON(responseWriter, http.StatusUnauthorized, map[string]string{"error": "invalid_token"})
+		return
+	}
+
+	writeJSON(responseWriter, http.StatusOK, map[string]any{
+		"sub":                subject,
+		"email":              email,
+		"email_verified":     provider.EmailVerified,
+		"preferred_username": username,
+	})
+}
+
+func (provider *fakeProvider) gitHubUser(responseWriter http.ResponseWriter, request *http.Request) {
+	if request.Header.Get(constants.Authorization) != constants.Bearer+accessToken {
+		writeJSON(responseWriter, http.StatusUnauthorized, map[string]string{"message": "Bad credentials"})
+		return
+	}
+
+	writeJSON(responseWriter, http.StatusOK, map[string]any{"id": 42, "login": username})
+}
+
+func (provider *fakeProvider) gitHubEmails(responseWriter http.ResponseWriter, request *http.Request) {
+	if request.Header.Get(constants.Authorization) != constants.Bearer+accessToken {
+		writeJSON(responseWriter, http.StatusUnauthorized, map[string]string{"message": "Bad credentials"})
+		return
+	}
+
+	writeJSON(responseWriter, http.StatusOK, []map[string]any{
+		{"email": "secondary@example.com", "primary": false, "verified": true},
+		{"email": email, "primary": true, "verified": provider.EmailVerified},
+	})
+}
+
+func writeJSON(responseWriter http.ResponseWriter, statusCode int, data any) {
+	responseWriter.Header().Set(constants.ContentType, "application/json")
+	responseWriter.WriteHeader(statusCode)
+	json.NewEncoder(responseWriter).Encode(data)
+}
+
+func assertInvalidToken(t *testing.T, err error) {
+	assert.Error(t, err, test.ErrorNotNilMessage)
+	assert.IsType(t, domain.InvalidTokenError{}, err, test.EqualMessage)
+}
+
+func assertAuthorizationURL(t *testing.T, authorizationURL, authorizationEndpoint, scope string) {
+	assert.True(t, strings.HasPrefix(authorizationURL, authorizationEndpoint+"?"), test.NotFailureMessage)
+	parsedURL, parseError := url.Parse(authorizationURL)
+	assert.NoError(t, parseError, test.ErrorNilMessage)
+
+	query := parsedURL.Query()
+	assert.Equal(t, "code", query.Get("response_type"), test.EqualMessage)
+	assert.Equal(t, clientID, query.Get("client_id"), test.EqualMessage)
+	assert.Equal(t, redirectURL, query.Get("redirect_uri"), test.EqualMessage)
+	assert.Equal(t, scope, query.Get("scope"), test.EqualMessage)
+	assert.Equal(t, state, query.Get("state"), test.EqualMessage)
+	assert.Equal(t, "S256", query.Get("code_challenge_method"), test.EqualMessage)
+	assert.NotEmpty(t, query.Get("code_challenge"), test.DataNotNilMessage)
+	assert.Empty(t, query.Get("client_secret"), test.DataNilMessage)
+}
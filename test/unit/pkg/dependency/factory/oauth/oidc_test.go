@@ -0,0 +1,100 @@
+package oauth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	utility "github.com/yachnytskyi/golang-mongo-grpc/internal/user/domain/utility"
+	oauth "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/oauth"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+	mock "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/common"
+)
+
+func TestOIDCAuthorizationURL(t *testing.T) {
+	t.Parallel()
+	provider := newFakeProvider(t)
+	oidc := oauth.NewOIDC(mock.NewMockLogger(), provider.config())
+
+	authorizationURL := oidc.AuthorizationURL(context.Background(), state, utility.CodeChallenge(codeVerifier))
+
+	assert.NoError(t, authorizationURL.Error, test.ErrorNilMessage)
+	assertAuthorizationURL(t, authorizationURL.Data, provider.Server.URL+"/authorize", "openid email profile")
+}
+
+func TestOIDCExchange(t *testing.T) {
+	t.Parallel()
+	provider := newFakeProvider(t)
+	oidc := oauth.NewOIDC(mock.NewMockLogger(), provider.config())
+	authorizationURL := oidc.AuthorizationURL(context.Background(), state, utility.CodeChallenge(codeVerifier))
+	assert.NoError(t, authorizationURL.Error, test.ErrorNilMessage)
+
+	identity := oidc.Exchange(context.Background(), provider.authorize(t, authorizationURL.Data), codeVerifier)
+
+	assert.NoError(t, identity.Error, test.ErrorNilMessage)
+	assert.Equal(t, "fake", identity.Data.Provider, test.EqualMessage)
+	assert.Equal(t, subject, identity.Data.Subject, test.EqualMessage)
+	assert.Equal(t, email, identity.Data.Email, test.EqualMessage)
+	assert.True(t, identity.Data.EmailVerified, test.NotFailureMessage)
+	assert.Equal(t, username, identity.Data.Username, test.EqualMessage)
+}
+
+func TestOIDCExchangeUnverifiedEmail(t *testing.T) {
+	t.Parallel()
+	provider := newFakeProvider(t)
+	provider.EmailVerified = false
+	oidc := oauth.NewOIDC(mock.NewMockLogger(), provider.config())
+	authorizationURL := oidc.AuthorizationURL(context.Background(), state, utility.CodeChallenge(codeVerifier))
+	assert.NoError(t, authorizationURL.Error, test.ErrorNilMessage)
+
+	identity := oidc.Exchange(context.Background(), provider.authorize(t, authorizationURL.Data), codeVerifier)
+
+	// The provider reports the email as it is, the use case decides whether an unverified email can be linked.
+	assert.NoError(t, identity.Error, test.ErrorNilMessage)
+	assert.False(t, identity.Data.EmailVerified, test.FailureMessage)
+}
+
+func TestOIDCExchangeWrongCodeVerifier(t *testing.T) {
+	t.Parallel()
+	provider := newFakeProvider(t)
+	oidc := oauth.NewOIDC(mock.NewMockLogger(), provider.config())
+	authorizationURL := oidc.AuthorizationURL(context.Background(), state, utility.CodeChallenge(codeVerifier))
+	assert.NoError(t, authorizationURL.Error, test.ErrorNilMessage)
+
+	identity := oidc.Exchange(context.Background(), provider.authorize(t, authorizationURL.Data), codeVerifier+"-other")
+
+	assertInvalidToken(t, identity.Error)
+}
+
+func TestOIDCExchangeUnknownCode(t *testing.T) {
+	t.Parallel()
+	provider := newFakeProvider(t)
+	oidc := oauth.NewOIDC(mock.NewMockLogger(), provider.config())
+
+	identity := oidc.Exchange(context.Background(), "unknown", codeVerifier)
+
+	assertInvalidToken(t, identity.Error)
+}
+
+func TestOIDCIssuerMismatch(t *testing.T) {
+	t.Parallel()
+	provider := newFakeProvider(t)
+	provider.Issuer = "https://other.example.com"
+	oidc := oauth.NewOIDC(mock.NewMockLogger(), provider.config())
+
+	authorizationURL := oidc.AuthorizationURL(context.Background(), state, utility.CodeChallenge(codeVerifier))
+
+	assert.Error(t, authorizationURL.Error, test.ErrorNotNilMessage)
+}
+
+func TestOIDCUnreachableIssuer(t *testing.T) {
+	t.Parallel()
+	provider := newFakeProvider(t)
+	providerConfig := provider.config()
+	provider.Server.Close()
+	oidc := oauth.NewOIDC(mock.NewMockLogger(), providerConfig)
+
+	authorizationURL := oidc.AuthorizationURL(context.Background(), state, utility.CodeChallenge(codeVerifier))
+
+	assert.Error(t, authorizationURL.Error, test.ErrorNotNilMessage)
+}
@@ -62,6 +62,58 @@ func TestHandleErrorItemNotFoundError(t *testing.T) {
 	assert.Equal(t, constants.ItemNotFoundErrorNotification, result.(domain.ItemNotFoundError).Notification, test.EqualMessage)
 }
 
+func TestHandleErrorConflictError(t *testing.T) {
+	t.Parallel()
+	conflictError := domain.NewConflictError(location+"TestHandleErrorConflictError", field, notification)
+	result := domain.HandleError(conflictError)
+
+	assert.IsType(t, domain.ConflictError{}, result, test.EqualMessage)
+	assert.Equal(t, conflictError, result, test.EqualMessage)
+}
+
+func TestHandleErrorEmailNotVerifiedError(t *testing.T) {
+	t.Parallel()
+	emailNotVerifiedError := domain.NewEmailNotVerifiedError(location+"TestHandleErrorEmailNotVerifiedError", notification)
+	result := domain.HandleError(emailNotVerifiedError)
+
+	assert.IsType(t, domain.EmailNotVerifiedError{}, result, test.EqualMessage)
+	assert.Equal(t, emailNotVerifiedError.Location, result.(domain.EmailNotVerifiedError).Location, test.EqualMessage)
+	assert.Equal(t, constants.EmailNotVerifiedNotification, result.(domain.EmailNotVerifiedError).Notification, test.EqualMessage)
+}
+
+func TestHandleErrorMustResetPasswordError(t *testing.T) {
+	t.Parallel()
+	mustResetPasswordError := domain.NewMustResetPasswordError(location+"TestHandleErrorMustResetPasswordError", notification)
+	result := domain.HandleError(mustResetPasswordError)
+
+	assert.IsType(t, domain.MustResetPasswordError{}, result, test.EqualMessage)
+	assert.Equal(t, mustResetPasswordError.Location, result.(domain.MustResetPasswordError).Location, test.EqualMessage)
+	assert.Equal(t, constants.MustResetPasswordNotification, result.(domain.MustResetPasswordError).Notification, test.EqualMessage)
+}
+
+func TestHandleErrorUserSuspendedError(t *testing.T) {
+	t.Parallel()
+	suspendedUntil := "2030-01-01T00:00:00Z"
+	userSuspendedError := domain.NewUserSuspendedError(location+"TestHandleErrorUserSuspendedError", test.SuspensionReason, suspendedUntil, notification)
+	result := domain.HandleError(userSuspendedError)
+
+	assert.IsType(t, domain.UserSuspendedError{}, result, test.EqualMessage)
+	assert.Equal(t, userSuspendedError.Location, result.(domain.UserSuspendedError).Location, test.EqualMessage)
+	assert.Equal(t, userSuspendedError.Reason, result.(domain.UserSuspendedError).Reason, test.EqualMessage)
+	assert.Equal(t, userSuspendedError.SuspendedUntil, result.(domain.UserSuspendedError).SuspendedUntil, test.EqualMessage)
+	assert.Equal(t, constants.UserSuspendedNotification, result.(domain.UserSuspendedError).Notification, test.EqualMessage)
+}
+
+func TestHandleErrorTooManyRequestsError(t *testing.T) {
+	t.Parallel()
+	retryAfter := "30"
+	tooManyRequestsError := domain.NewTooManyRequestsError(location+"TestHandleErrorTooManyRequestsError", retryAfter, notification)
+	result := domain.HandleError(tooManyRequestsError)
+
+	assert.IsType(t, domain.TooManyRequestsError{}, result, test.EqualMessage)
+	assert.Equal(t, tooManyRequestsError, result, test.EqualMessage)
+}
+
 func TestHandleErrorPaginationError(t *testing.T) {
 	t.Parallel()
 	currentPage := "52"
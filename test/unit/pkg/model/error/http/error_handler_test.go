@@ -2,6 +2,7 @@ package http_test
 
 import (
 	"fmt"
+	netHTTP "net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -67,6 +68,17 @@ func TestHandleErrorItemNotFoundError(t *testing.T) {
 	assert.Equal(t, itemNotFoundError.Notification, httpItemNotFoundError.Notification, test.EqualMessage)
 }
 
+func TestHandleErrorConflictError(t *testing.T) {
+	t.Parallel()
+	conflictError := domain.NewConflictError(location+"TestHandleErrorConflictError", field, notification)
+	result := http.HandleError(conflictError)
+
+	httpConflictError, ok := result.(http.HTTPConflictError)
+	assert.True(t, ok, test.EqualMessage)
+	assert.Equal(t, conflictError.Field, httpConflictError.Field, test.EqualMessage)
+	assert.Equal(t, conflictError.Notification, httpConflictError.Notification, test.EqualMessage)
+}
+
 func TestHandleErrorInvalidTokenError(t *testing.T) {
 	t.Parallel()
 	invalidTokenError := domain.NewInvalidTokenError(location+"TestHandleErrorInvalidTokenError", notification)
@@ -87,6 +99,51 @@ func TestHandleErrorTimeExpiredError(t *testing.T) {
 	assert.Equal(t, timeExpiredError.Notification, httpError.Notification, test.EqualMessage)
 }
 
+func TestHandleErrorEmailNotVerifiedError(t *testing.T) {
+	t.Parallel()
+	emailNotVerifiedError := domain.NewEmailNotVerifiedError(location+"TestHandleErrorEmailNotVerifiedError", notification)
+	result := http.HandleError(emailNotVerifiedError)
+
+	httpError, ok := result.(http.HTTPEmailNotVerifiedError)
+	assert.True(t, ok, test.EqualMessage)
+	assert.Equal(t, emailNotVerifiedError.Notification, httpError.Notification, test.EqualMessage)
+}
+
+func TestHandleErrorMustResetPasswordError(t *testing.T) {
+	t.Parallel()
+	mustResetPasswordError := domain.NewMustResetPasswordError(location+"TestHandleErrorMustResetPasswordError", notification)
+	result := http.HandleError(mustResetPasswordError)
+
+	httpError, ok := result.(http.HTTPMustResetPasswordError)
+	assert.True(t, ok, test.EqualMessage)
+	assert.Equal(t, mustResetPasswordError.Notification, httpError.Notification, test.EqualMessage)
+}
+
+func TestHandleErrorUserSuspendedError(t *testing.T) {
+	t.Parallel()
+	suspendedUntil := "2030-01-01T00:00:00Z"
+	userSuspendedError := domain.NewUserSuspendedError(location+"TestHandleErrorUserSuspendedError", test.SuspensionReason, suspendedUntil, notification)
+	result := http.HandleError(userSuspendedError)
+
+	httpError, ok := result.(http.HTTPUserSuspendedError)
+	assert.True(t, ok, test.EqualMessage)
+	assert.Equal(t, userSuspendedError.Reason, httpError.Reason, test.EqualMessage)
+	assert.Equal(t, userSuspendedError.SuspendedUntil, httpError.SuspendedUntil, test.EqualMessage)
+	assert.Equal(t, userSuspendedError.Notification, httpError.Notification, test.EqualMessage)
+}
+
+func TestHandleErrorTooManyRequestsError(t *testing.T) {
+	t.Parallel()
+	retryAfter := "30"
+	tooManyRequestsError := domain.NewTooManyRequestsError(location+"TestHandleErrorTooManyRequestsError", retryAfter, notification)
+	result := http.HandleError(tooManyRequestsError)
+
+	httpError, ok := result.(http.HTTPTooManyRequestsError)
+	assert.True(t, ok, test.EqualMessage)
+	assert.Equal(t, tooManyRequestsError.RetryAfter, httpError.RetryAfter, test.EqualMessage)
+	assert.Equal(t, tooManyRequestsError.Notification, httpError.Notification, test.EqualMessage)
+}
+
 func TestHandleErrorPaginationError(t *testing.T) {
 	t.Parallel()
 	currentPage := "5"
@@ -138,3 +195,13 @@ func TestHandleErrorNil(t *testing.T) {
 	result := http.HandleError(nil)
 	assert.Nil(t, result, test.ErrorNilMessage, test.ErrorNilMessage)
 }
+
+func TestStatusCode(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, netHTTP.StatusBadRequest, http.StatusCode(http.HandleError(domain.NewValidationError(location, field, constants.FieldRequired, notification))), test.EqualMessage)
+	assert.Equal(t, netHTTP.StatusBadRequest, http.StatusCode(http.HandleError(domain.NewValidationErrors([]error{}))), test.EqualMessage)
+	assert.Equal(t, netHTTP.StatusForbidden, http.StatusCode(http.HandleError(domain.NewAuthorizationError(location, notification))), test.EqualMessage)
+	assert.Equal(t, netHTTP.StatusNotFound, http.StatusCode(http.HandleError(domain.NewItemNotFoundError(location, "", notification))), test.EqualMessage)
+	assert.Equal(t, netHTTP.StatusConflict, http.StatusCode(http.HandleError(domain.NewConflictError(location, field, notification))), test.EqualMessage)
+	assert.Equal(t, netHTTP.StatusInternalServerError, http.StatusCode(http.HandleError(domain.NewInternalError(location, notification))), test.EqualMessage)
+}
@@ -0,0 +1,28 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	utility "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/common"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+)
+
+const (
+	hashedString = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+)
+
+func TestHashTokenValidString(t *testing.T) {
+	t.Parallel()
+
+	result := utility.HashToken(originalString)
+	assert.Equal(t, hashedString, result, test.EqualMessage)
+}
+
+func TestHashTokenIsDeterministic(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, utility.HashToken(originalString), utility.HashToken(originalString), test.EqualMessage)
+	assert.NotEqual(t, utility.HashToken(originalString), utility.HashToken(encodedString), test.EqualMessage)
+}
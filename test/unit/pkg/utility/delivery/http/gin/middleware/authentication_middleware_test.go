@@ -13,6 +13,7 @@ import (
 	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
 	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
 	delivery "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/delivery/http"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
 	middleware "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/delivery/http/gin/middleware"
 	test "github.com/yachnytskyi/golang-mongo-grpc/test"
 	mock "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/common"
@@ -31,12 +32,14 @@ var (
 
 func setupAuthenticationMiddlewareConfig() *config.ApplicationConfig {
 	mockConfig := mock.NewMockConfig()
-	mockConfig.AccessToken.PublicKey = test.PublicKey
-	mockConfig.AccessToken.PrivateKey = test.PrivateKey
 	mockConfig.AccessToken.ExpiredIn = constants.PasswordResetTokenExpirationTime
 	return mockConfig
 }
 
+func setupKeyRing() utility.KeyRing {
+	return utility.NewKeyRing(mock.NewMockLogger(), location+"setupKeyRing", constants.RS256, "", test.PrivateKey, test.PublicKey, nil, utility.TokenClaims{}).Data
+}
+
 func getValidToken(location string) common.Result[string] {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupAuthenticationMiddlewareConfig()
@@ -44,7 +47,7 @@ func getValidToken(location string) common.Result[string] {
 	validToken := utility.GenerateJWTToken(
 		mockLogger,
 		location,
-		mockConfig.AccessToken.PrivateKey,
+		setupKeyRing(),
 		mockConfig.AccessToken.ExpiredIn,
 		tokenPayload,
 	)
@@ -59,7 +62,7 @@ func getExpiredTokenForAuthenticationMiddleware(location string) common.Result[s
 	expiredToken := utility.GenerateJWTToken(
 		mockLogger,
 		location+"TestAuthenticationMiddlewareExpiredToken",
-		mockConfig.AccessToken.PrivateKey,
+		setupKeyRing(),
 		-mockConfig.AccessToken.ExpiredIn,
 		tokenPayload,
 	)
@@ -72,7 +75,7 @@ func TestAuthenticationMiddlewareCookieValidToken(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupAuthenticationMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -93,7 +96,7 @@ func TestAuthenticationMiddlewareHeaderValidToken(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupAuthenticationMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -109,12 +112,60 @@ func TestAuthenticationMiddlewareHeaderValidToken(t *testing.T) {
 	assert.Equal(t, constants.Success, recorder.Body.String(), test.EqualMessage)
 }
 
+func TestAuthenticationMiddlewareRevokedSession(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	mockConfig := setupAuthenticationMiddlewareConfig()
+	mockSessionValidator := mock.NewMockSessionValidator()
+	mockSessionValidator.ValidateSessionError = domain.NewInvalidTokenError(location+"TestAuthenticationMiddlewareRevokedSession", constants.InvalidTokenErrorMessage)
+	router := gin.Default()
+	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger, setupKeyRing(), mockSessionValidator))
+	router.GET(test.TestURL, func(ginContext *gin.Context) {
+		ginContext.String(http.StatusOK, constants.Success)
+	})
+
+	validToken := getValidToken(location + "TestAuthenticationMiddlewareRevokedSession")
+	request := httptest.NewRequest(http.MethodGet, test.TestURL, nil)
+	request.AddCookie(&http.Cookie{Name: constants.AccessTokenValue, Value: validToken.Data})
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	assert.NoError(t, validToken.Error, test.NotFailureMessage)
+	assert.IsType(t, delivery.HTTPAuthorizationError{}, mockLogger.LastError, test.EqualMessage)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code, test.EqualMessage)
+	assert.JSONEq(t, test.NotLoggedInMessage, recorder.Body.String(), test.EqualMessage)
+}
+
+func TestAuthenticationMiddlewareSuspendedUser(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	mockConfig := setupAuthenticationMiddlewareConfig()
+	mockSessionValidator := mock.NewMockSessionValidator()
+	mockSessionValidator.ValidateSessionError = domain.HandleError(domain.NewUserSuspendedError(location+"TestAuthenticationMiddlewareSuspendedUser", test.SuspensionReason, "", constants.UserSuspendedNotification))
+	router := gin.Default()
+	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger, setupKeyRing(), mockSessionValidator))
+	router.GET(test.TestURL, func(ginContext *gin.Context) {
+		ginContext.String(http.StatusOK, constants.Success)
+	})
+
+	validToken := getValidToken(location + "TestAuthenticationMiddlewareSuspendedUser")
+	request := httptest.NewRequest(http.MethodGet, test.TestURL, nil)
+	request.AddCookie(&http.Cookie{Name: constants.AccessTokenValue, Value: validToken.Data})
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	assert.NoError(t, validToken.Error, test.NotFailureMessage)
+	assert.IsType(t, domain.UserSuspendedError{}, mockLogger.LastError, test.EqualMessage)
+	assert.Equal(t, http.StatusForbidden, recorder.Code, test.EqualMessage)
+	assert.JSONEq(t, test.UserSuspendedMessage, recorder.Body.String(), test.EqualMessage)
+}
+
 func TestAuthenticationMiddlewareCookieInvalidTokenValue(t *testing.T) {
 	t.Parallel()
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupAuthenticationMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -134,7 +185,7 @@ func TestAuthenticationMiddlewareCookieEmptyTokenValue(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupAuthenticationMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -154,7 +205,7 @@ func TestAuthenticationMiddlewareCookieMultipleTokens(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupAuthenticationMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -175,7 +226,7 @@ func TestAuthenticationMiddlewareCookieExpiredToken(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupAuthenticationMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -196,7 +247,7 @@ func TestAuthenticationMiddlewareHeaderInvalidTokenValue(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupAuthenticationMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -216,7 +267,7 @@ func TestAuthenticationMiddlewareHeaderEmptyTokenValue(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupAuthenticationMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -236,7 +287,7 @@ func TestAuthenticationMiddlewareHeaderMultipleTokens(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupAuthenticationMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -257,7 +308,7 @@ func TestAuthenticationMiddlewareHeaderExpiredToken(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupAuthenticationMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -278,12 +329,63 @@ func TestAuthenticationMiddlewareNoToken(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupAuthenticationMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
+	router.GET(test.TestURL, func(ginContext *gin.Context) {
+		ginContext.String(http.StatusOK, constants.Success)
+	})
+
+	request := httptest.NewRequest(http.MethodGet, test.TestURL, nil)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	assert.IsType(t, delivery.HTTPAuthorizationError{}, mockLogger.LastError, test.EqualMessage)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code, test.EqualMessage)
+	assert.JSONEq(t, test.NotLoggedInMessage, recorder.Body.String(), test.EqualMessage)
+}
+
+func TestAuthenticationMiddlewarePersonalAccessToken(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	mockConfig := setupAuthenticationMiddlewareConfig()
+	mockSessionValidator := mock.NewMockSessionValidator()
+	personalAccessTokenPayload := tokenPayload
+	personalAccessTokenPayload.Scopes = []string{constants.PostCreatePermission}
+	mockSessionValidator.ValidatePersonalAccessTokenResult = common.NewResultOnSuccess[user.UserTokenPayload](personalAccessTokenPayload)
+	router := gin.Default()
+	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger, setupKeyRing(), mockSessionValidator))
+	router.GET(test.TestURL, func(ginContext *gin.Context) {
+		ctx := ginContext.Request.Context()
+		assert.Equal(t, tokenPayload.UserID, ctx.Value(constants.ID), test.EqualMessage)
+		assert.Equal(t, tokenPayload.Role, ctx.Value(constants.UserRole), test.EqualMessage)
+		assert.Equal(t, personalAccessTokenPayload.Scopes, ctx.Value(constants.Scopes), test.EqualMessage)
+		assert.Nil(t, ctx.Value(constants.SessionID), test.DataNilMessage)
+		ginContext.String(http.StatusOK, constants.Success)
+	})
+
+	request := httptest.NewRequest(http.MethodGet, test.TestURL, nil)
+	request.Header.Set(constants.Authorization, constants.Bearer+constants.PersonalAccessTokenPrefix+"token")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code, test.EqualMessage)
+	assert.Equal(t, constants.Success, recorder.Body.String(), test.EqualMessage)
+}
+
+func TestAuthenticationMiddlewareInvalidPersonalAccessToken(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	mockConfig := setupAuthenticationMiddlewareConfig()
+	mockSessionValidator := mock.NewMockSessionValidator()
+	mockSessionValidator.ValidatePersonalAccessTokenResult = common.NewResultOnFailure[user.UserTokenPayload](
+		domain.NewInvalidTokenError(location+"TestAuthenticationMiddlewareInvalidPersonalAccessToken", constants.InvalidTokenErrorMessage))
+	router := gin.Default()
+	router.Use(middleware.AuthenticationMiddleware(mockConfig, mockLogger, setupKeyRing(), mockSessionValidator))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
 
 	request := httptest.NewRequest(http.MethodGet, test.TestURL, nil)
+	request.Header.Set(constants.Authorization, constants.Bearer+constants.PersonalAccessTokenPrefix+"token")
 	recorder := httptest.NewRecorder()
 	router.ServeHTTP(recorder, request)
 
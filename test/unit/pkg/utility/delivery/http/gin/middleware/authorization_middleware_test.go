@@ -0,0 +1,159 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	delivery "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/delivery/http"
+	middleware "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/delivery/http/gin/middleware"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+	mock "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/common"
+)
+
+// setUserRole imitates the authentication middleware by storing the role in the request context.
+func setUserRole(role string) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		ctx := context.WithValue(ginContext.Request.Context(), constants.UserRole, role)
+		ginContext.Request = ginContext.Request.WithContext(ctx)
+		ginContext.Next()
+	}
+}
+
+func serveAuthorizationRequest(handlers ...gin.HandlerFunc) *httptest.ResponseRecorder {
+	router := gin.Default()
+	router.Use(handlers...)
+	router.GET(test.TestURL, func(ginContext *gin.Context) {
+		ginContext.String(http.StatusOK, constants.Success)
+	})
+
+	request := httptest.NewRequest(http.MethodGet, test.TestURL, nil)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestRequireRoleAllowed(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	recorder := serveAuthorizationRequest(
+		setUserRole(constants.AdminRoleValue),
+		middleware.RequireRole(mockLogger, constants.AdminRoleValue),
+	)
+
+	assert.Equal(t, http.StatusOK, recorder.Code, test.EqualMessage)
+	assert.Equal(t, constants.Success, recorder.Body.String(), test.EqualMessage)
+}
+
+func TestRequireRoleDenied(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	recorder := serveAuthorizationRequest(
+		setUserRole(constants.UserRoleValue),
+		middleware.RequireRole(mockLogger, constants.AdminRoleValue),
+	)
+
+	assert.IsType(t, delivery.HTTPAuthorizationError{}, mockLogger.LastError, test.EqualMessage)
+	assert.Equal(t, http.StatusForbidden, recorder.Code, test.EqualMessage)
+	assert.JSONEq(t, test.AccessDeniedMessage, recorder.Body.String(), test.EqualMessage)
+}
+
+func TestRequireRoleNoRole(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	recorder := serveAuthorizationRequest(middleware.RequireRole(mockLogger, constants.AdminRoleValue))
+
+	assert.IsType(t, delivery.HTTPAuthorizationError{}, mockLogger.LastError, test.EqualMessage)
+	assert.Equal(t, http.StatusForbidden, recorder.Code, test.EqualMessage)
+}
+
+func TestRequirePermissionAllowed(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	recorder := serveAuthorizationRequest(
+		setUserRole(constants.ModeratorRoleValue),
+		middleware.RequirePermission(mockLogger, constants.PostDeleteAnyPermission),
+	)
+
+	assert.Equal(t, http.StatusOK, recorder.Code, test.EqualMessage)
+	assert.Equal(t, constants.Success, recorder.Body.String(), test.EqualMessage)
+}
+
+func TestRequirePermissionDenied(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	recorder := serveAuthorizationRequest(
+		setUserRole(constants.UserRoleValue),
+		middleware.RequirePermission(mockLogger, constants.PostDeleteAnyPermission),
+	)
+
+	assert.IsType(t, delivery.HTTPAuthorizationError{}, mockLogger.LastError, test.EqualMessage)
+	assert.Equal(t, http.StatusForbidden, recorder.Code, test.EqualMessage)
+	assert.JSONEq(t, test.AccessDeniedMessage, recorder.Body.String(), test.EqualMessage)
+}
+
+// setScopes imitates the authentication middleware for a personal access token by storing its scopes in the request context.
+func setScopes(scopes ...string) gin.HandlerFunc {
+	return func(ginContext *gin.Context) {
+		ctx := context.WithValue(ginContext.Request.Context(), constants.Scopes, scopes)
+		ginContext.Request = ginContext.Request.WithContext(ctx)
+		ginContext.Next()
+	}
+}
+
+func TestRequirePermissionScopeAllowed(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	recorder := serveAuthorizationRequest(
+		setUserRole(constants.UserRoleValue),
+		setScopes(constants.PostCreatePermission),
+		middleware.RequirePermission(mockLogger, constants.PostCreatePermission),
+	)
+
+	assert.Equal(t, http.StatusOK, recorder.Code, test.EqualMessage)
+	assert.Equal(t, constants.Success, recorder.Body.String(), test.EqualMessage)
+}
+
+func TestRequirePermissionScopeDenied(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	recorder := serveAuthorizationRequest(
+		setUserRole(constants.UserRoleValue),
+		setScopes(constants.PostCreatePermission),
+		middleware.RequirePermission(mockLogger, constants.PostDeleteOwnPermission),
+	)
+
+	assert.IsType(t, delivery.HTTPAuthorizationError{}, mockLogger.LastError, test.EqualMessage)
+	assert.Equal(t, http.StatusForbidden, recorder.Code, test.EqualMessage)
+	assert.JSONEq(t, test.AccessDeniedMessage, recorder.Body.String(), test.EqualMessage)
+}
+
+func TestRequireSessionAllowed(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	recorder := serveAuthorizationRequest(
+		setUserRole(constants.UserRoleValue),
+		middleware.RequireSession(mockLogger),
+	)
+
+	assert.Equal(t, http.StatusOK, recorder.Code, test.EqualMessage)
+	assert.Equal(t, constants.Success, recorder.Body.String(), test.EqualMessage)
+}
+
+func TestRequireSessionPersonalAccessToken(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	recorder := serveAuthorizationRequest(
+		setUserRole(constants.UserRoleValue),
+		setScopes(constants.PostCreatePermission),
+		middleware.RequireSession(mockLogger),
+	)
+
+	assert.IsType(t, delivery.HTTPAuthorizationError{}, mockLogger.LastError, test.EqualMessage)
+	assert.Equal(t, http.StatusForbidden, recorder.Code, test.EqualMessage)
+	assert.JSONEq(t, test.AccessDeniedMessage, recorder.Body.String(), test.EqualMessage)
+}
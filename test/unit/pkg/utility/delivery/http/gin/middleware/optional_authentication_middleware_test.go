@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	delivery "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/delivery/http"
+	middleware "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/delivery/http/gin/middleware"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+	mock "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/common"
+)
+
+func setupOptionalAuthenticationRouter(mockLogger *mock.MockLogger) *gin.Engine {
+	router := gin.Default()
+	router.Use(middleware.OptionalAuthenticationMiddleware(setupAuthenticationMiddlewareConfig(), mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
+	router.GET(test.TestURL, func(ginContext *gin.Context) {
+		currentUserID, _ := ginContext.Request.Context().Value(constants.ID).(string)
+		ginContext.String(http.StatusOK, currentUserID)
+	})
+
+	return router
+}
+
+func TestOptionalAuthenticationMiddlewareAnonymous(t *testing.T) {
+	t.Parallel()
+	router := setupOptionalAuthenticationRouter(mock.NewMockLogger())
+
+	request := httptest.NewRequest(http.MethodGet, test.TestURL, nil)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code, test.EqualMessage)
+	assert.Empty(t, recorder.Body.String(), test.EqualMessage)
+}
+
+func TestOptionalAuthenticationMiddlewareValidToken(t *testing.T) {
+	t.Parallel()
+	router := setupOptionalAuthenticationRouter(mock.NewMockLogger())
+
+	validToken := getValidToken(location + "TestOptionalAuthenticationMiddlewareValidToken")
+	request := httptest.NewRequest(http.MethodGet, test.TestURL, nil)
+	request.Header.Set(constants.Authorization, constants.Bearer+validToken.Data)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	assert.NoError(t, validToken.Error, test.NotFailureMessage)
+	assert.Equal(t, http.StatusOK, recorder.Code, test.EqualMessage)
+	assert.Equal(t, tokenPayload.UserID, recorder.Body.String(), test.EqualMessage)
+}
+
+func TestOptionalAuthenticationMiddlewareInvalidToken(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	router := setupOptionalAuthenticationRouter(mockLogger)
+
+	request := httptest.NewRequest(http.MethodGet, test.TestURL, nil)
+	request.AddCookie(&http.Cookie{Name: constants.AccessTokenValue, Value: "invalid token"})
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	assert.IsType(t, delivery.HTTPAuthorizationError{}, mockLogger.LastWarn, test.EqualMessage)
+	assert.Equal(t, http.StatusOK, recorder.Code, test.EqualMessage)
+	assert.Empty(t, recorder.Body.String(), test.EqualMessage)
+}
+
+func TestOptionalAuthenticationMiddlewareExpiredToken(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	router := setupOptionalAuthenticationRouter(mockLogger)
+
+	expiredToken := getExpiredTokenForAuthenticationMiddleware(location + "TestOptionalAuthenticationMiddlewareExpiredToken")
+	request := httptest.NewRequest(http.MethodGet, test.TestURL, nil)
+	request.AddCookie(&http.Cookie{Name: constants.AccessTokenValue, Value: expiredToken.Data})
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	assert.NoError(t, expiredToken.Error, test.NotFailureMessage)
+	assert.IsType(t, delivery.HTTPAuthorizationError{}, mockLogger.LastWarn, test.EqualMessage)
+	assert.Equal(t, http.StatusOK, recorder.Code, test.EqualMessage)
+	assert.Empty(t, recorder.Body.String(), test.EqualMessage)
+}
@@ -12,6 +12,7 @@ import (
 	config "github.com/yachnytskyi/golang-mongo-grpc/pkg/dependency/factory/config/model"
 	common "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/common"
 	delivery "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/delivery/http"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
 	middleware "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/delivery/http/gin/middleware"
 	test "github.com/yachnytskyi/golang-mongo-grpc/test"
 	mock "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/common"
@@ -19,20 +20,18 @@ import (
 
 func setupRefreshTokenMiddlewareConfig() *config.ApplicationConfig {
 	mockConfig := mock.NewMockConfig()
-	mockConfig.RefreshToken.PublicKey = test.PublicKey
-	mockConfig.RefreshToken.PrivateKey = test.PrivateKey
 	mockConfig.RefreshToken.ExpiredIn = constants.PasswordResetTokenExpirationTime
 	return mockConfig
 }
 
 func getExpiredTokenForRefreshTokenMiddleware(location string) common.Result[string] {
 	mockLogger := mock.NewMockLogger()
-	mockConfig := setupAuthenticationMiddlewareConfig()
+	mockConfig := setupRefreshTokenMiddlewareConfig()
 
 	expiredToken := utility.GenerateJWTToken(
 		mockLogger,
 		location+"TestAuthenticationMiddlewareExpiredToken",
-		mockConfig.RefreshToken.PrivateKey,
+		setupKeyRing(),
 		-mockConfig.RefreshToken.ExpiredIn,
 		tokenPayload,
 	)
@@ -45,7 +44,7 @@ func TestRefreshTokenMiddlewareCookieValidToken(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupRefreshTokenMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -66,7 +65,7 @@ func TestRefreshTokenMiddlewareHeaderValidToken(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupRefreshTokenMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -82,12 +81,91 @@ func TestRefreshTokenMiddlewareHeaderValidToken(t *testing.T) {
 	assert.Equal(t, constants.Success, recorder.Body.String(), test.EqualMessage)
 }
 
+func TestRefreshTokenMiddlewareTokenAndSessionIDContext(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	mockConfig := setupRefreshTokenMiddlewareConfig()
+	router := gin.Default()
+	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
+	router.GET(test.TestURL, func(ginContext *gin.Context) {
+		ctx := ginContext.Request.Context()
+		ginContext.String(http.StatusOK, ctx.Value(constants.TokenID).(string)+ctx.Value(constants.SessionID).(string))
+	})
+
+	sessionTokenPayload := tokenPayload
+	sessionTokenPayload.TokenID = "tokenID"
+	sessionTokenPayload.SessionID = "sessionID"
+	validToken := utility.GenerateJWTToken(
+		mockLogger,
+		location+"TestRefreshTokenMiddlewareTokenAndSessionIDContext",
+		setupKeyRing(),
+		mockConfig.RefreshToken.ExpiredIn,
+		sessionTokenPayload,
+	)
+	request := httptest.NewRequest(http.MethodGet, test.TestURL, nil)
+	request.AddCookie(&http.Cookie{Name: constants.RefreshTokenValue, Value: validToken.Data})
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	assert.NoError(t, validToken.Error, test.ErrorNilMessage)
+	assert.Equal(t, http.StatusOK, recorder.Code, test.EqualMessage)
+	assert.Equal(t, sessionTokenPayload.TokenID+sessionTokenPayload.SessionID, recorder.Body.String(), test.EqualMessage)
+}
+
+func TestRefreshTokenMiddlewareRevokedSession(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	mockConfig := setupRefreshTokenMiddlewareConfig()
+	mockSessionValidator := mock.NewMockSessionValidator()
+	mockSessionValidator.ValidateSessionError = domain.NewInvalidTokenError(location+"TestRefreshTokenMiddlewareRevokedSession", constants.InvalidTokenErrorMessage)
+	router := gin.Default()
+	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger, setupKeyRing(), mockSessionValidator))
+	router.GET(test.TestURL, func(ginContext *gin.Context) {
+		ginContext.String(http.StatusOK, constants.Success)
+	})
+
+	validToken := getValidToken(location + "TestRefreshTokenMiddlewareRevokedSession")
+	request := httptest.NewRequest(http.MethodGet, test.TestURL, nil)
+	request.AddCookie(&http.Cookie{Name: constants.RefreshTokenValue, Value: validToken.Data})
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	assert.NoError(t, validToken.Error, test.NotFailureMessage)
+	assert.IsType(t, delivery.HTTPAuthorizationError{}, mockLogger.LastError, test.EqualMessage)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code, test.EqualMessage)
+	assert.JSONEq(t, test.NotLoggedInMessage, recorder.Body.String(), test.EqualMessage)
+}
+
+func TestRefreshTokenMiddlewareSuspendedUser(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	mockConfig := setupRefreshTokenMiddlewareConfig()
+	mockSessionValidator := mock.NewMockSessionValidator()
+	mockSessionValidator.ValidateSessionError = domain.HandleError(domain.NewUserSuspendedError(location+"TestRefreshTokenMiddlewareSuspendedUser", test.SuspensionReason, "", constants.UserSuspendedNotification))
+	router := gin.Default()
+	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger, setupKeyRing(), mockSessionValidator))
+	router.GET(test.TestURL, func(ginContext *gin.Context) {
+		ginContext.String(http.StatusOK, constants.Success)
+	})
+
+	validToken := getValidToken(location + "TestRefreshTokenMiddlewareSuspendedUser")
+	request := httptest.NewRequest(http.MethodGet, test.TestURL, nil)
+	request.AddCookie(&http.Cookie{Name: constants.RefreshTokenValue, Value: validToken.Data})
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	assert.NoError(t, validToken.Error, test.NotFailureMessage)
+	assert.IsType(t, domain.UserSuspendedError{}, mockLogger.LastError, test.EqualMessage)
+	assert.Equal(t, http.StatusForbidden, recorder.Code, test.EqualMessage)
+	assert.JSONEq(t, test.UserSuspendedMessage, recorder.Body.String(), test.EqualMessage)
+}
+
 func TestRefreshTokenMiddlewareCookieInvalidTokenValue(t *testing.T) {
 	t.Parallel()
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupRefreshTokenMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -107,7 +185,7 @@ func TestRefreshTokenMiddlewareCookieEmptyTokenValue(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupRefreshTokenMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -127,7 +205,7 @@ func TestRefreshTokenMiddlewareCookieMultipleTokens(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupRefreshTokenMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -148,7 +226,7 @@ func TestRefreshTokenMiddlewareCookieExpiredToken(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupRefreshTokenMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -169,7 +247,7 @@ func TestRefreshTokenMiddlewareHeaderInvalidTokenValue(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupRefreshTokenMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -189,7 +267,7 @@ func TestRefreshTokenMiddlewareHeaderEmptyTokenValue(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupRefreshTokenMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -209,7 +287,7 @@ func TestRefreshTokenMiddlewareHeaderMultipleTokens(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupRefreshTokenMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -230,7 +308,7 @@ func TestRefreshTokenMiddlewareHeaderExpiredToken(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupRefreshTokenMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
@@ -251,7 +329,7 @@ func TestRefreshTokenMiddlewareHeaderNoToken(t *testing.T) {
 	mockLogger := mock.NewMockLogger()
 	mockConfig := setupRefreshTokenMiddlewareConfig()
 	router := gin.Default()
-	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger))
+	router.Use(middleware.RefreshTokenMiddleware(mockConfig, mockLogger, setupKeyRing(), mock.NewMockSessionValidator()))
 	router.GET(test.TestURL, func(ginContext *gin.Context) {
 		ginContext.String(http.StatusOK, constants.Success)
 	})
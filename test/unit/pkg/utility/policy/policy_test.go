@@ -0,0 +1,213 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	constants "github.com/yachnytskyi/golang-mongo-grpc/config/constants"
+	domain "github.com/yachnytskyi/golang-mongo-grpc/pkg/model/error/domain"
+	policy "github.com/yachnytskyi/golang-mongo-grpc/pkg/utility/policy"
+	test "github.com/yachnytskyi/golang-mongo-grpc/test"
+	mock "github.com/yachnytskyi/golang-mongo-grpc/test/unit/mock/common"
+)
+
+const (
+	location      = "test.unit.pkg.utility.policy."
+	currentUserID = "currentUserID"
+	otherUserID   = "otherUserID"
+	unknownRole   = "unknown"
+)
+
+func TestHasPermissionUserRole(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, policy.HasPermission(constants.UserRoleValue, constants.PostCreatePermission), test.NotFailureMessage)
+	assert.False(t, policy.HasPermission(constants.UserRoleValue, constants.PostDeleteAnyPermission), test.FailureMessage)
+	assert.False(t, policy.HasPermission(constants.UserRoleValue, constants.UserManagePermission), test.FailureMessage)
+}
+
+func TestHasPermissionAdminRole(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, policy.HasPermission(constants.AdminRoleValue, constants.PostCreatePermission), test.NotFailureMessage)
+	assert.True(t, policy.HasPermission(constants.AdminRoleValue, constants.PostDeleteAnyPermission), test.NotFailureMessage)
+	assert.True(t, policy.HasPermission(constants.AdminRoleValue, constants.UserManagePermission), test.NotFailureMessage)
+}
+
+func TestHasPermissionUnknownRole(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, policy.HasPermission(unknownRole, constants.PostCreatePermission), test.FailureMessage)
+	assert.False(t, policy.HasPermission("", constants.PostCreatePermission), test.FailureMessage)
+}
+
+func TestCheckRoleAllowed(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	result := policy.CheckRole(mockLogger, location+"TestCheckRoleAllowed", constants.AdminRoleValue, constants.ModeratorRoleValue, constants.AdminRoleValue)
+
+	assert.NoError(t, result, test.ErrorNilMessage)
+	assert.Nil(t, mockLogger.LastError, test.ErrorNilMessage)
+}
+
+func TestCheckRoleDenied(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	result := policy.CheckRole(mockLogger, location+"TestCheckRoleDenied", constants.UserRoleValue, constants.AdminRoleValue)
+
+	assert.IsType(t, domain.AuthorizationError{}, result, test.EqualMessage)
+	assert.IsType(t, domain.AuthorizationError{}, mockLogger.LastError, test.EqualMessage)
+	assert.Equal(t, constants.AuthorizationErrorNotification, result.(domain.AuthorizationError).Notification, test.EqualMessage)
+}
+
+func TestCheckPermissionDenied(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	result := policy.CheckPermission(mockLogger, location+"TestCheckPermissionDenied", constants.UserRoleValue, constants.UserManagePermission)
+
+	assert.IsType(t, domain.AuthorizationError{}, result, test.EqualMessage)
+	assert.Equal(t, constants.AuthorizationErrorNotification, result.(domain.AuthorizationError).Notification, test.EqualMessage)
+}
+
+func TestOutranks(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, policy.Outranks(constants.AdminRoleValue, constants.ModeratorRoleValue), test.NotFailureMessage)
+	assert.True(t, policy.Outranks(constants.ModeratorRoleValue, constants.UserRoleValue), test.NotFailureMessage)
+	assert.True(t, policy.Outranks(constants.UserRoleValue, unknownRole), test.NotFailureMessage)
+	assert.False(t, policy.Outranks(constants.AdminRoleValue, constants.AdminRoleValue), test.FailureMessage)
+	assert.False(t, policy.Outranks(constants.ModeratorRoleValue, constants.AdminRoleValue), test.FailureMessage)
+	assert.False(t, policy.Outranks(unknownRole, constants.UserRoleValue), test.FailureMessage)
+}
+
+func TestCheckOutranksSameRole(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	result := policy.CheckOutranks(mockLogger, location+"TestCheckOutranksSameRole", constants.ModeratorRoleValue, constants.ModeratorRoleValue)
+
+	assert.IsType(t, domain.AuthorizationError{}, result, test.EqualMessage)
+	assert.IsType(t, domain.AuthorizationError{}, mockLogger.LastError, test.EqualMessage)
+}
+
+func TestCheckRoleGrant(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	sameRole := policy.CheckRoleGrant(mockLogger, location+"TestCheckRoleGrant", constants.AdminRoleValue, constants.AdminRoleValue)
+	higherRole := policy.CheckRoleGrant(mockLogger, location+"TestCheckRoleGrant", constants.ModeratorRoleValue, constants.AdminRoleValue)
+
+	assert.NoError(t, sameRole, test.ErrorNilMessage)
+	assert.IsType(t, domain.AuthorizationError{}, higherRole, test.EqualMessage)
+}
+
+func TestCheckOwnershipPermissionOwner(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	result := policy.CheckOwnershipPermission(
+		mockLogger,
+		location+"TestCheckOwnershipPermissionOwner",
+		constants.UserRoleValue,
+		nil,
+		currentUserID,
+		currentUserID,
+		constants.PostDeleteOwnPermission,
+		constants.PostDeleteAnyPermission,
+	)
+
+	assert.NoError(t, result, test.ErrorNilMessage)
+}
+
+func TestCheckOwnershipPermissionNotOwner(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	result := policy.CheckOwnershipPermission(
+		mockLogger,
+		location+"TestCheckOwnershipPermissionNotOwner",
+		constants.UserRoleValue,
+		nil,
+		currentUserID,
+		otherUserID,
+		constants.PostDeleteOwnPermission,
+		constants.PostDeleteAnyPermission,
+	)
+
+	assert.IsType(t, domain.AuthorizationError{}, result, test.EqualMessage)
+}
+
+func TestCheckOwnershipPermissionAnyPermission(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	result := policy.CheckOwnershipPermission(
+		mockLogger,
+		location+"TestCheckOwnershipPermissionAnyPermission",
+		constants.ModeratorRoleValue,
+		nil,
+		currentUserID,
+		otherUserID,
+		constants.PostDeleteOwnPermission,
+		constants.PostDeleteAnyPermission,
+	)
+
+	assert.NoError(t, result, test.ErrorNilMessage)
+}
+
+func TestCheckOwnershipPermissionScopedToOwnPermission(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	result := policy.CheckOwnershipPermission(
+		mockLogger,
+		location+"TestCheckOwnershipPermissionScopedToOwnPermission",
+		constants.ModeratorRoleValue,
+		[]string{constants.PostDeleteOwnPermission},
+		currentUserID,
+		otherUserID,
+		constants.PostDeleteOwnPermission,
+		constants.PostDeleteAnyPermission,
+	)
+
+	assert.IsType(t, domain.AuthorizationError{}, mockLogger.LastError, test.EqualMessage)
+	assert.IsType(t, domain.AuthorizationError{}, result, test.EqualMessage)
+}
+
+func TestCheckOwnershipPermissionScopedToAnyPermission(t *testing.T) {
+	t.Parallel()
+	mockLogger := mock.NewMockLogger()
+	result := policy.CheckOwnershipPermission(
+		mockLogger,
+		location+"TestCheckOwnershipPermissionScopedToAnyPermission",
+		constants.ModeratorRoleValue,
+		[]string{constants.PostDeleteAnyPermission},
+		currentUserID,
+		otherUserID,
+		constants.PostDeleteOwnPermission,
+		constants.PostDeleteAnyPermission,
+	)
+
+	assert.NoError(t, result, test.ErrorNilMessage)
+}
+
+func TestIsRoleValid(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, policy.IsRoleValid(constants.UserRoleValue), test.NotFailureMessage)
+	assert.True(t, policy.IsRoleValid(constants.ModeratorRoleValue), test.NotFailureMessage)
+	assert.True(t, policy.IsRoleValid(constants.AdminRoleValue), test.NotFailureMessage)
+	assert.False(t, policy.IsRoleValid(unknownRole), test.FailureMessage)
+}
+
+func TestHasScope(t *testing.T) {
+	t.Parallel()
+	scopes := []string{constants.PostCreatePermission}
+
+	assert.True(t, policy.HasScope(scopes, constants.PostCreatePermission), test.NotFailureMessage)
+	assert.False(t, policy.HasScope(scopes, constants.PostDeleteOwnPermission), test.FailureMessage)
+	assert.False(t, policy.HasScope(nil, constants.PostCreatePermission), test.FailureMessage)
+}
+
+func TestGrantedPermissions(t *testing.T) {
+	t.Parallel()
+	permissions := []string{constants.PostDeleteAnyPermission, constants.PostCreatePermission, constants.UserManagePermission}
+
+	assert.Equal(t, []string{constants.PostCreatePermission}, policy.GrantedPermissions(constants.UserRoleValue, permissions), test.EqualMessage)
+	assert.Equal(t, permissions, policy.GrantedPermissions(constants.AdminRoleValue, permissions), test.EqualMessage)
+	assert.Empty(t, policy.GrantedPermissions(unknownRole, permissions), test.EqualMessage)
+}